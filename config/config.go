@@ -6,6 +6,8 @@ import (
 
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
@@ -19,7 +21,21 @@ type Config struct {
 	CCAPISecret string
 	CCFolder    string
 	OngkirKey   string
+	OngkirURL   string
 	OpenAiKey   string
+
+	PaymentProvider string
+	MidtransEnv     string
+	UnpaidOrderTTL  time.Duration
+
+	AutoCompleteAfter time.Duration
+
+	OrderNumberFormat string
+
+	TrackingProvider string
+
+	StoreName    string
+	StoreAddress string
 }
 
 func InitConfig() *Config {
@@ -30,6 +46,11 @@ func InitConfig() *Config {
 func loadConfig() *Config {
 
 	var res = new(Config)
+	res.OngkirURL = "https://api.rajaongkir.com/starter"
+	res.UnpaidOrderTTL = 24 * time.Hour
+	res.AutoCompleteAfter = 7 * 24 * time.Hour
+	res.StoreName = "Sander'Store"
+	res.StoreAddress = "Purbasari RT01/RW02"
 	_, err := os.Stat(".env")
 	if err == nil {
 		err := godotenv.Load()
@@ -79,8 +100,45 @@ func loadConfig() *Config {
 	if value, found := os.LookupEnv("ONGKIRKEY"); found {
 		res.OngkirKey = value
 	}
+	if value, found := os.LookupEnv("ONGKIRURL"); found {
+		res.OngkirURL = strings.TrimSuffix(value, "/")
+	}
 	if value, found := os.LookupEnv("OPENAIAPIKEY"); found {
 		res.OpenAiKey = value
 	}
+	if value, found := os.LookupEnv("PAYMENTPROVIDER"); found {
+		res.PaymentProvider = value
+	}
+	if value, found := os.LookupEnv("MIDTRANSENV"); found {
+		res.MidtransEnv = value
+	}
+	if value, found := os.LookupEnv("UNPAIDORDERTTL"); found {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatal("Config : invalid unpaid order ttl", err.Error())
+			return nil
+		}
+		res.UnpaidOrderTTL = ttl
+	}
+	if value, found := os.LookupEnv("AUTOCOMPLETEDAYS"); found {
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 1 {
+			log.Fatal("Config : invalid auto complete days", value)
+			return nil
+		}
+		res.AutoCompleteAfter = time.Duration(days) * 24 * time.Hour
+	}
+	if value, found := os.LookupEnv("ORDERNUMBERFORMAT"); found {
+		res.OrderNumberFormat = value
+	}
+	if value, found := os.LookupEnv("TRACKINGPROVIDER"); found {
+		res.TrackingProvider = value
+	}
+	if value, found := os.LookupEnv("STORENAME"); found {
+		res.StoreName = value
+	}
+	if value, found := os.LookupEnv("STOREADDRESS"); found {
+		res.StoreAddress = value
+	}
 	return res
 }
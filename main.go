@@ -2,33 +2,49 @@ package main
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
 	"os"
+	"os/signal"
 	"ruti-store/config"
 	"ruti-store/module/feature/middleware"
+	"ruti-store/module/feature/order"
+	"ruti-store/module/feature/product"
 	"ruti-store/module/feature/route"
 	"ruti-store/module/feature/user/repository"
 	"ruti-store/module/feature/user/service"
 	assistant "ruti-store/utils/assitant"
 	"ruti-store/utils/database"
+	"ruti-store/utils/generator"
 	"ruti-store/utils/payment"
+	"ruti-store/utils/scheduler"
 	"ruti-store/utils/token"
+	"syscall"
 )
 
 func main() {
 	app := fiber.New()
 	var initConfig = config.InitConfig()
+	if err := generator.ValidateOrderNumberFormat(initConfig.OrderNumberFormat); err != nil {
+		log.Fatal("Config : invalid order number format ", err.Error())
+	}
 	jwtService := token.NewJWT(initConfig.Secret)
 
 	middleware.SetupMiddlewares(app)
 	db := database.InitPGSDatabase(*initConfig)
-	snapClient := payment.InitSnapMidtrans(*initConfig)
-	coreClient := payment.InitCoreMidtrans(*initConfig)
+	paymentGateway := payment.NewPaymentGateway(*initConfig)
 	openAi := assistant.NewAssistantService()
 	userRepo := repository.NewUserRepository(db, openAi)
 	userService := service.NewUserService(userRepo)
 
-	database.Migrate(db)
-	route.SetupRoutes(app, db, jwtService, snapClient, userService, coreClient)
+	if err := database.Migrate(db); err != nil {
+		log.Fatal("Failed to migrate the database: ", err.Error())
+	}
+	route.SetupRoutes(app, db, jwtService, paymentGateway, userService, initConfig)
+
+	jobScheduler := scheduler.NewScheduler(db)
+	order.SetupOrderJobs(jobScheduler, initConfig.UnpaidOrderTTL, initConfig.AutoCompleteAfter)
+	product.SetupProductJobs(jobScheduler)
+	jobScheduler.Start()
 
 	app.Get("/", func(c *fiber.Ctx) error {
 		return c.SendString("Hello, Ruti Store")
@@ -38,7 +54,18 @@ func main() {
 	if port == "" {
 		port = "8000"
 	}
+
+	// Shut the server down on a signal so the jobs get to finish the run they are in before the
+	// process exits.
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+		_ = app.Shutdown()
+	}()
+
 	err := app.Listen(":" + port)
+	jobScheduler.Stop()
 	if err != nil {
 		panic("Failed to start the server: " + err.Error())
 	}
@@ -0,0 +1,51 @@
+package entities
+
+import "time"
+
+type FlashSaleModels struct {
+	ID          uint64                 `gorm:"column:id;primaryKey" json:"id"`
+	Name        string                 `gorm:"column:name;type:VARCHAR(255)" json:"name"`
+	Description string                 `gorm:"column:description;type:TEXT" json:"description"`
+	IsActive    bool                   `gorm:"column:is_active" json:"is_active"`
+	StartAt     time.Time              `gorm:"column:start_at;type:timestamp;index" json:"start_at"`
+	EndAt       time.Time              `gorm:"column:end_at;type:timestamp;index" json:"end_at"`
+	CreatedAt   time.Time              `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt   time.Time              `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	DeletedAt   *time.Time             `gorm:"column:deleted_at;type:TIMESTAMP NULL;index" json:"deleted_at"`
+	Items       []*FlashSaleItemModels `gorm:"foreignKey:FlashSaleID" json:"items"`
+}
+
+type FlashSaleItemModels struct {
+	ID          uint64               `gorm:"column:id;primaryKey" json:"id"`
+	FlashSaleID uint64               `gorm:"column:flash_sale_id;index" json:"flash_sale_id"`
+	ProductID   uint64               `gorm:"column:product_id;index" json:"product_id"`
+	VariantID   uint64               `gorm:"column:variant_id;index" json:"variant_id"`
+	SalePrice   uint64               `gorm:"column:sale_price" json:"sale_price"`
+	Quota       uint64               `gorm:"column:quota" json:"quota"`
+	Sold        uint64               `gorm:"column:sold;default:0" json:"sold"`
+	FlashSale   *FlashSaleModels     `gorm:"foreignKey:FlashSaleID" json:"flash_sale,omitempty"`
+	Product     ProductModels        `gorm:"foreignKey:ProductID" json:"product"`
+	Variant     ProductVariantModels `gorm:"foreignKey:VariantID" json:"variant"`
+}
+
+type FlashSaleClaimModels struct {
+	ID              uint64     `gorm:"column:id;primaryKey" json:"id"`
+	FlashSaleItemID uint64     `gorm:"column:flash_sale_item_id;index" json:"flash_sale_item_id"`
+	OrderID         string     `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	Quantity        uint64     `gorm:"column:quantity" json:"quantity"`
+	Status          string     `gorm:"column:status;type:VARCHAR(255)" json:"status"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	ReleasedAt      *time.Time `gorm:"column:released_at;type:TIMESTAMP NULL" json:"released_at"`
+}
+
+func (FlashSaleModels) TableName() string {
+	return "flash_sales"
+}
+
+func (FlashSaleItemModels) TableName() string {
+	return "flash_sale_items"
+}
+
+func (FlashSaleClaimModels) TableName() string {
+	return "flash_sale_claims"
+}
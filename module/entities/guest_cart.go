@@ -0,0 +1,21 @@
+package entities
+
+import "time"
+
+type GuestCartModels struct {
+	ID        uint64        `gorm:"column:id;primaryKey" json:"id"`
+	GuestID   string        `gorm:"column:guest_id;type:VARCHAR(255);index" json:"guest_id"`
+	ProductID uint64        `gorm:"column:product_id" json:"product_id"`
+	VariantID uint64        `gorm:"column:variant_id;index" json:"variant_id"`
+	Size      string        `gorm:"column:size;type:VARCHAR(255)" json:"size"`
+	Color     string        `gorm:"column:color;type:VARCHAR(255)" json:"color"`
+	Quantity  uint64        `gorm:"column:quantity" json:"quantity"`
+	Price     uint64        `gorm:"column:price;default:0" json:"price"`
+	CreatedAt time.Time     `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt time.Time     `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	Product   ProductModels `gorm:"foreignKey:ProductID" json:"product" `
+}
+
+func (GuestCartModels) TableName() string {
+	return "guest_carts"
+}
@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+// OptionTypeModels is a kind of choice variants are told apart by, e.g. Size, Color or Material.
+type OptionTypeModels struct {
+	ID        uint64              `gorm:"column:id;primaryKey" json:"id"`
+	Name      string              `gorm:"column:name;type:VARCHAR(255);uniqueIndex" json:"name"`
+	CreatedAt time.Time           `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt time.Time           `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	Values    []OptionValueModels `gorm:"foreignKey:OptionTypeID" json:"values"`
+}
+
+// OptionValueModels is one choice of an option type, e.g. XXL for Size. A variant has at most one
+// value of each option type.
+type OptionValueModels struct {
+	ID           uint64            `gorm:"column:id;primaryKey" json:"id"`
+	OptionTypeID uint64            `gorm:"column:option_type_id;uniqueIndex:uni_option_values_type_value" json:"option_type_id"`
+	Value        string            `gorm:"column:value;type:VARCHAR(255);uniqueIndex:uni_option_values_type_value" json:"value"`
+	CreatedAt    time.Time         `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt    time.Time         `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	OptionType   *OptionTypeModels `gorm:"foreignKey:OptionTypeID" json:"option_type,omitempty"`
+}
+
+func (OptionTypeModels) TableName() string {
+	return "option_types"
+}
+
+func (OptionValueModels) TableName() string {
+	return "option_values"
+}
@@ -4,16 +4,23 @@ import "time"
 
 type OrderModels struct {
 	ID                 string               `gorm:"column:id;type:VARCHAR(255);primaryKey" json:"id"`
-	IdOrder            string               `gorm:"column:id_order;type:VARCHAR(255)" json:"id_order"`
+	IdOrder            string               `gorm:"column:id_order;type:VARCHAR(255);uniqueIndex" json:"id_order"`
 	AddressID          uint64               `gorm:"column:address_id" json:"address_id"`
 	UserID             uint64               `gorm:"column:user_id" json:"user_id"`
 	Note               string               `gorm:"column:note;type:VARCHAR(255)" json:"note"`
 	GrandTotalQuantity uint64               `gorm:"column:grand_total_quantity" json:"grand_total_quantity"`
 	GrandTotalPrice    uint64               `gorm:"column:grand_total_price" json:"grand_total_price"`
 	ShipmentFee        uint64               `gorm:"column:shipment_fee" json:"shipment_fee"`
+	Courier            string               `gorm:"column:courier;type:VARCHAR(255)" json:"courier"`
+	CourierService     string               `gorm:"column:courier_service;type:VARCHAR(255)" json:"courier_service"`
+	ShipmentETD        string               `gorm:"column:shipment_etd;type:VARCHAR(255)" json:"shipment_etd"`
+	TotalWeight        uint64               `gorm:"column:total_weight" json:"total_weight"`
 	AdminFees          uint64               `gorm:"column:admin_fees" json:"admin_fees"`
 	GrandTotalDiscount uint64               `gorm:"column:grand_total_discount" json:"grand_total_discount"`
+	VoucherCode        string               `gorm:"column:voucher_code;type:VARCHAR(255)" json:"voucher_code"`
+	VoucherDiscount    uint64               `gorm:"column:voucher_discount;default:0" json:"voucher_discount"`
 	TotalAmountPaid    uint64               `gorm:"column:total_amount_paid" json:"total_amount_paid"`
+	TotalRefunded      uint64               `gorm:"column:total_refunded;default:0" json:"total_refunded"`
 	OrderStatus        string               `gorm:"column:order_status;type:VARCHAR(255)" json:"order_status"`
 	PaymentStatus      string               `gorm:"column:payment_status;type:VARCHAR(255)" json:"payment_status"`
 	PaymentMethod      string               `gorm:"column:payment_method;type:VARCHAR(255)" json:"payment_method"`
@@ -26,16 +33,20 @@ type OrderModels struct {
 }
 
 type OrderDetailsModels struct {
-	ID            uint64        `gorm:"column:id;primaryKey" json:"id"`
-	OrderID       string        `gorm:"column:order_id;type:VARCHAR(255)" json:"order_id"`
-	ProductID     uint64        `gorm:"column:product_id" json:"product_id"`
-	Size          string        `gorm:"column:size;type:VARCHAR(255)" json:"size"`
-	Color         string        `gorm:"column:color;type:VARCHAR(255)" json:"color"`
-	Quantity      uint64        `gorm:"column:quantity" json:"quantity"`
-	IsReviewed    bool          `gorm:"column:is_reviewed" json:"is_reviewed"`
-	TotalDiscount uint64        `gorm:"column:total_discount" json:"total_discount"`
-	TotalPrice    uint64        `gorm:"column:total_price" json:"total_price"`
-	Product       ProductModels `json:"product,omitempty" gorm:"foreignKey:ProductID"`
+	ID               uint64        `gorm:"column:id;primaryKey" json:"id"`
+	OrderID          string        `gorm:"column:order_id;type:VARCHAR(255)" json:"order_id"`
+	ProductID        uint64        `gorm:"column:product_id" json:"product_id"`
+	VariantID        uint64        `gorm:"column:variant_id;index" json:"variant_id"`
+	Size             string        `gorm:"column:size;type:VARCHAR(255)" json:"size"`
+	Color            string        `gorm:"column:color;type:VARCHAR(255)" json:"color"`
+	Quantity         uint64        `gorm:"column:quantity" json:"quantity"`
+	ReturnedQuantity uint64        `gorm:"column:returned_quantity;default:0" json:"returned_quantity"`
+	IsReviewed       bool          `gorm:"column:is_reviewed" json:"is_reviewed"`
+	ReviewableAt     *time.Time    `gorm:"column:reviewable_at;type:TIMESTAMP NULL" json:"reviewable_at"`
+	TotalDiscount    uint64        `gorm:"column:total_discount" json:"total_discount"`
+	VoucherDiscount  uint64        `gorm:"column:voucher_discount" json:"voucher_discount"`
+	TotalPrice       uint64        `gorm:"column:total_price" json:"total_price"`
+	Product          ProductModels `json:"product,omitempty" gorm:"foreignKey:ProductID"`
 }
 
 func (OrderModels) TableName() string {
@@ -45,3 +56,28 @@ func (OrderModels) TableName() string {
 func (OrderDetailsModels) TableName() string {
 	return "order_details"
 }
+
+type OrderStatusHistoryModels struct {
+	ID         uint64    `gorm:"column:id;primaryKey" json:"id"`
+	OrderID    string    `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	FromStatus string    `gorm:"column:from_status;type:VARCHAR(255)" json:"from_status"`
+	ToStatus   string    `gorm:"column:to_status;type:VARCHAR(255)" json:"to_status"`
+	ActorID    uint64    `gorm:"column:actor_id" json:"actor_id"`
+	ActorRole  string    `gorm:"column:actor_role;type:VARCHAR(255)" json:"actor_role"`
+	Note       string    `gorm:"column:note;type:TEXT" json:"note"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
+}
+
+func (OrderStatusHistoryModels) TableName() string {
+	return "order_status_history"
+}
+
+type OrderCounterModels struct {
+	Period    string    `gorm:"column:period;type:VARCHAR(255);primaryKey" json:"period"`
+	Value     uint64    `gorm:"column:value" json:"value"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+}
+
+func (OrderCounterModels) TableName() string {
+	return "order_counters"
+}
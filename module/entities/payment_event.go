@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+type PaymentEventModels struct {
+	ID                uint64    `gorm:"column:id;primaryKey" json:"id"`
+	OrderID           string    `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	TransactionID     string    `gorm:"column:transaction_id;type:VARCHAR(255)" json:"transaction_id"`
+	TransactionStatus string    `gorm:"column:transaction_status;type:VARCHAR(255)" json:"transaction_status"`
+	StatusCode        string    `gorm:"column:status_code;type:VARCHAR(255)" json:"status_code"`
+	FraudStatus       string    `gorm:"column:fraud_status;type:VARCHAR(255)" json:"fraud_status"`
+	GrossAmount       string    `gorm:"column:gross_amount;type:VARCHAR(255)" json:"gross_amount"`
+	SignatureValid    bool      `gorm:"column:signature_valid" json:"signature_valid"`
+	Outcome           string    `gorm:"column:outcome;type:VARCHAR(255)" json:"outcome"`
+	Error             string    `gorm:"column:error;type:TEXT" json:"error"`
+	Payload           string    `gorm:"column:payload;type:TEXT" json:"payload"`
+	CreatedAt         time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
+}
+
+func (PaymentEventModels) TableName() string {
+	return "payment_events"
+}
@@ -20,15 +20,47 @@ type ProductModels struct {
 }
 
 type ProductVariantModels struct {
-	ID        uint64     `gorm:"column:id;primaryKey" json:"id"`
-	ProductID uint64     `gorm:"column:product_id" json:"product_id"`
-	Size      string     `gorm:"column:size;type:VARCHAR(255)" json:"size"`
-	Color     string     `gorm:"column:color;type:VARCHAR(255)" json:"color"`
-	Stock     uint64     `gorm:"column:stock" json:"stock"`
-	Weight    uint64     `gorm:"column:weight" json:"weight"`
-	CreatedAt time.Time  `gorm:"column:created_at;type:timestamp" json:"created_at"`
-	UpdatedAt time.Time  `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
-	DeletedAt *time.Time `gorm:"column:deleted_at;type:TIMESTAMP NULL;index" json:"deleted_at"`
+	ID                 uint64               `gorm:"column:id;primaryKey" json:"id"`
+	ProductID          uint64               `gorm:"column:product_id" json:"product_id"`
+	SKU                string               `gorm:"column:sku;type:VARCHAR(64);uniqueIndex:uni_variants_sku,where:deleted_at IS NULL" json:"sku"`
+	Barcode            *string              `gorm:"column:barcode;type:VARCHAR(13);uniqueIndex:uni_variants_barcode,where:deleted_at IS NULL" json:"barcode"`
+	Size               string               `gorm:"column:size;type:VARCHAR(255)" json:"size"`
+	Color              string               `gorm:"column:color;type:VARCHAR(255)" json:"color"`
+	Price              *uint64              `gorm:"column:price" json:"price"`
+	Stock              uint64               `gorm:"column:stock" json:"stock"`
+	ReservedStock      uint64               `gorm:"column:reserved_stock;default:0" json:"reserved_stock"`
+	Weight             uint64               `gorm:"column:weight" json:"weight"`
+	LowStockThreshold  uint64               `gorm:"column:low_stock_threshold" json:"low_stock_threshold"`
+	LowStockNotifiedAt *time.Time           `gorm:"column:low_stock_notified_at;type:TIMESTAMP NULL" json:"low_stock_notified_at"`
+	CreatedAt          time.Time            `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt          time.Time            `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	DeletedAt          *time.Time           `gorm:"column:deleted_at;type:TIMESTAMP NULL;index" json:"deleted_at"`
+	OptionValues       []*OptionValueModels `gorm:"many2many:variant_option_values;joinForeignKey:VariantID;joinReferences:OptionValueID" json:"option_values"`
+}
+
+type StockReservationModels struct {
+	ID        uint64    `gorm:"column:id;primaryKey" json:"id"`
+	OrderID   string    `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	VariantID uint64    `gorm:"column:variant_id;index" json:"variant_id"`
+	Quantity  uint64    `gorm:"column:quantity" json:"quantity"`
+	Status    string    `gorm:"column:status;type:VARCHAR(255)" json:"status"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+}
+
+// InventoryMovementModels is an entry of the inventory ledger: one change of a variant's stock
+// and why it happened. The deltas of a variant add up to its stock.
+type InventoryMovementModels struct {
+	ID         uint64    `gorm:"column:id;primaryKey" json:"id"`
+	VariantID  uint64    `gorm:"column:variant_id;index" json:"variant_id"`
+	Delta      int64     `gorm:"column:delta" json:"delta"`
+	StockAfter uint64    `gorm:"column:stock_after" json:"stock_after"`
+	Reason     string    `gorm:"column:reason;type:VARCHAR(255)" json:"reason"`
+	OrderID    string    `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	ActorID    uint64    `gorm:"column:actor_id" json:"actor_id"`
+	ActorRole  string    `gorm:"column:actor_role;type:VARCHAR(255)" json:"actor_role"`
+	Note       string    `gorm:"column:note;type:TEXT" json:"note"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
 }
 
 type ProductPhotoModels struct {
@@ -48,3 +80,11 @@ func (ProductPhotoModels) TableName() string {
 func (ProductVariantModels) TableName() string {
 	return "variants"
 }
+
+func (StockReservationModels) TableName() string {
+	return "stock_reservations"
+}
+
+func (InventoryMovementModels) TableName() string {
+	return "inventory_movements"
+}
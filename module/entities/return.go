@@ -0,0 +1,52 @@
+package entities
+
+import "time"
+
+type ReturnRequestModels struct {
+	ID           uint64              `gorm:"column:id;primaryKey" json:"id"`
+	OrderID      string              `gorm:"column:order_id;type:VARCHAR(255);index" json:"order_id"`
+	UserID       uint64              `gorm:"column:user_id;index" json:"user_id"`
+	Reason       string              `gorm:"column:reason;type:TEXT" json:"reason"`
+	Status       string              `gorm:"column:status;type:VARCHAR(255)" json:"status"`
+	AdminNote    string              `gorm:"column:admin_note;type:TEXT" json:"admin_note"`
+	ReviewedBy   uint64              `gorm:"column:reviewed_by" json:"reviewed_by"`
+	Restock      bool                `gorm:"column:restock" json:"restock"`
+	RefundAmount uint64              `gorm:"column:refund_amount" json:"refund_amount"`
+	RefundKey    string              `gorm:"column:refund_key;type:VARCHAR(255)" json:"refund_key"`
+	RefundError  string              `gorm:"column:refund_error;type:TEXT" json:"refund_error"`
+	RefundedAt   *time.Time          `gorm:"column:refunded_at;type:TIMESTAMP NULL" json:"refunded_at"`
+	CreatedAt    time.Time           `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt    time.Time           `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	Order        OrderModels         `gorm:"foreignKey:OrderID" json:"order"`
+	User         UserModels          `gorm:"foreignKey:UserID" json:"user"`
+	Items        []ReturnItemModels  `gorm:"foreignKey:ReturnID" json:"items"`
+	Photos       []ReturnPhotoModels `gorm:"foreignKey:ReturnID" json:"photos"`
+}
+
+type ReturnItemModels struct {
+	ID            uint64             `gorm:"column:id;primaryKey" json:"id"`
+	ReturnID      uint64             `gorm:"column:return_id;index" json:"return_id"`
+	OrderDetailID uint64             `gorm:"column:order_detail_id" json:"order_detail_id"`
+	Quantity      uint64             `gorm:"column:quantity" json:"quantity"`
+	Amount        uint64             `gorm:"column:amount" json:"amount"`
+	OrderDetail   OrderDetailsModels `gorm:"foreignKey:OrderDetailID" json:"order_detail"`
+}
+
+type ReturnPhotoModels struct {
+	ID        uint64    `gorm:"column:id;primaryKey" json:"id"`
+	ReturnID  uint64    `gorm:"column:return_id;index" json:"return_id"`
+	URL       string    `gorm:"column:url;type:VARCHAR(255)" json:"url"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
+}
+
+func (ReturnRequestModels) TableName() string {
+	return "return_requests"
+}
+
+func (ReturnItemModels) TableName() string {
+	return "return_items"
+}
+
+func (ReturnPhotoModels) TableName() string {
+	return "return_photos"
+}
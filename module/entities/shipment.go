@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+type ShipmentModels struct {
+	ID            uint64                `gorm:"column:id;primaryKey" json:"id"`
+	OrderID       string                `gorm:"column:order_id;type:VARCHAR(255);uniqueIndex" json:"order_id"`
+	Courier       string                `gorm:"column:courier;type:VARCHAR(255)" json:"courier"`
+	Service       string                `gorm:"column:service;type:VARCHAR(255)" json:"service"`
+	AirwayBill    string                `gorm:"column:airway_bill;type:VARCHAR(255)" json:"airway_bill"`
+	Status        string                `gorm:"column:status;type:VARCHAR(255)" json:"status"`
+	Receiver      string                `gorm:"column:receiver;type:VARCHAR(255)" json:"receiver"`
+	TrackingError string                `gorm:"column:tracking_error;type:TEXT" json:"tracking_error"`
+	ShippedAt     time.Time             `gorm:"column:shipped_at;type:timestamp" json:"shipped_at"`
+	DeliveredAt   *time.Time            `gorm:"column:delivered_at;type:TIMESTAMP NULL;index" json:"delivered_at"`
+	LastCheckedAt *time.Time            `gorm:"column:last_checked_at;type:TIMESTAMP NULL" json:"last_checked_at"`
+	CreatedAt     time.Time             `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt     time.Time             `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	Order         OrderModels           `gorm:"foreignKey:OrderID" json:"order"`
+	Events        []ShipmentEventModels `gorm:"foreignKey:ShipmentID" json:"events"`
+}
+
+type ShipmentEventModels struct {
+	ID          uint64    `gorm:"column:id;primaryKey" json:"id"`
+	ShipmentID  uint64    `gorm:"column:shipment_id;uniqueIndex:idx_shipment_event" json:"shipment_id"`
+	Description string    `gorm:"column:description;type:VARCHAR(255);uniqueIndex:idx_shipment_event" json:"description"`
+	Location    string    `gorm:"column:location;type:VARCHAR(255)" json:"location"`
+	OccurredAt  time.Time `gorm:"column:occurred_at;type:timestamp;uniqueIndex:idx_shipment_event" json:"occurred_at"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:timestamp" json:"created_at"`
+}
+
+func (ShipmentModels) TableName() string {
+	return "shipments"
+}
+
+func (ShipmentEventModels) TableName() string {
+	return "shipment_events"
+}
@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+type VoucherModels struct {
+	ID                uint64            `gorm:"column:id;primaryKey" json:"id"`
+	Code              string            `gorm:"column:code;type:VARCHAR(255);uniqueIndex:idx_vouchers_code,where:deleted_at IS NULL" json:"code"`
+	Name              string            `gorm:"column:name;type:VARCHAR(255)" json:"name"`
+	Description       string            `gorm:"column:description;type:TEXT" json:"description"`
+	DiscountType      string            `gorm:"column:discount_type;type:VARCHAR(255)" json:"discount_type"`
+	DiscountValue     uint64            `gorm:"column:discount_value" json:"discount_value"`
+	MinSpend          uint64            `gorm:"column:min_spend" json:"min_spend"`
+	MaxDiscount       uint64            `gorm:"column:max_discount" json:"max_discount"`
+	UsageLimit        uint64            `gorm:"column:usage_limit" json:"usage_limit"`
+	UsageLimitPerUser uint64            `gorm:"column:usage_limit_per_user" json:"usage_limit_per_user"`
+	UsedCount         uint64            `gorm:"column:used_count;default:0" json:"used_count"`
+	IsActive          bool              `gorm:"column:is_active" json:"is_active"`
+	StartAt           time.Time         `gorm:"column:start_at;type:timestamp" json:"start_at"`
+	EndAt             time.Time         `gorm:"column:end_at;type:timestamp" json:"end_at"`
+	CreatedAt         time.Time         `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	UpdatedAt         time.Time         `gorm:"column:updated_at;type:timestamp" json:"updated_at"`
+	DeletedAt         *time.Time        `gorm:"column:deleted_at;type:TIMESTAMP NULL;index" json:"deleted_at"`
+	Categories        []*CategoryModels `gorm:"many2many:voucher_categories;" json:"categories"`
+	Products          []*ProductModels  `gorm:"many2many:voucher_products;" json:"products"`
+}
+
+type VoucherUsageModels struct {
+	ID         uint64     `gorm:"column:id;primaryKey" json:"id"`
+	VoucherID  uint64     `gorm:"column:voucher_id;index" json:"voucher_id"`
+	UserID     uint64     `gorm:"column:user_id;index" json:"user_id"`
+	OrderID    string     `gorm:"column:order_id;type:VARCHAR(255);uniqueIndex" json:"order_id"`
+	Discount   uint64     `gorm:"column:discount" json:"discount"`
+	Status     string     `gorm:"column:status;type:VARCHAR(255)" json:"status"`
+	CreatedAt  time.Time  `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	ReleasedAt *time.Time `gorm:"column:released_at;type:TIMESTAMP NULL" json:"released_at"`
+}
+
+func (VoucherModels) TableName() string {
+	return "vouchers"
+}
+
+func (VoucherUsageModels) TableName() string {
+	return "voucher_usages"
+}
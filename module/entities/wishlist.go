@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+type WishlistModels struct {
+	ID        uint64        `gorm:"column:id;primaryKey" json:"id"`
+	UserID    uint64        `gorm:"column:user_id;index" json:"user_id"`
+	ProductID uint64        `gorm:"column:product_id;index" json:"product_id"`
+	VariantID uint64        `gorm:"column:variant_id;default:0" json:"variant_id"`
+	CreatedAt time.Time     `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	Product   ProductModels `gorm:"foreignKey:ProductID" json:"product"`
+}
+
+type StockAlertModels struct {
+	ID         uint64               `gorm:"column:id;primaryKey" json:"id"`
+	UserID     uint64               `gorm:"column:user_id;index" json:"user_id"`
+	ProductID  uint64               `gorm:"column:product_id" json:"product_id"`
+	VariantID  uint64               `gorm:"column:variant_id;index" json:"variant_id"`
+	CreatedAt  time.Time            `gorm:"column:created_at;type:timestamp" json:"created_at"`
+	NotifiedAt *time.Time           `gorm:"column:notified_at;type:TIMESTAMP NULL" json:"notified_at"`
+	Product    ProductModels        `gorm:"foreignKey:ProductID" json:"product"`
+	Variant    ProductVariantModels `gorm:"foreignKey:VariantID" json:"variant"`
+}
+
+func (WishlistModels) TableName() string {
+	return "wishlists"
+}
+
+func (StockAlertModels) TableName() string {
+	return "stock_alerts"
+}
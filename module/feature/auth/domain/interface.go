@@ -13,6 +13,12 @@ type AuthRepositoryInterface interface {
 type AuthServiceInterface interface {
 	Login(email, password string) (*entities.UserModels, string, error)
 	Register(req *RegisterRequest) (*entities.UserModels, error)
+	MergeGuestCart(userID uint64, cartToken string) error
+}
+
+// GuestCartMergerInterface moves the cart a guest built before logging in into their user cart.
+type GuestCartMergerInterface interface {
+	MergeGuestCart(userID uint64, cartToken string) error
 }
 
 type AuthHandlerInterface interface {
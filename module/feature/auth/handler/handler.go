@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
 	"ruti-store/module/feature/auth/domain"
 	"ruti-store/utils/response"
 	"ruti-store/utils/validator"
@@ -32,6 +33,10 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 
+	if err := h.service.MergeGuestCart(user.ID, req.CartToken); err != nil {
+		log.Errorf("failed to merge guest cart into the cart of user %d: %v", user.ID, err)
+	}
+
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Login successfully", domain.LoginFormatter(user, token))
 }
 
@@ -49,5 +54,9 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
+
+	if err := h.service.MergeGuestCart(result.ID, req.CartToken); err != nil {
+		log.Errorf("failed to merge guest cart into the cart of user %d: %v", result.ID, err)
+	}
 	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Registration successful", domain.RegisterFormatter(result))
 }
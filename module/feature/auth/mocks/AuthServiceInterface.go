@@ -18,6 +18,10 @@ type AuthServiceInterface struct {
 func (_m *AuthServiceInterface) Login(email string, password string) (*entities.UserModels, string, error) {
 	ret := _m.Called(email, password)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
 	var r0 *entities.UserModels
 	var r1 string
 	var r2 error
@@ -47,10 +51,32 @@ func (_m *AuthServiceInterface) Login(email string, password string) (*entities.
 	return r0, r1, r2
 }
 
+// MergeGuestCart provides a mock function with given fields: userID, cartToken
+func (_m *AuthServiceInterface) MergeGuestCart(userID uint64, cartToken string) error {
+	ret := _m.Called(userID, cartToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeGuestCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(userID, cartToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: req
 func (_m *AuthServiceInterface) Register(req *domain.RegisterRequest) (*entities.UserModels, error) {
 	ret := _m.Called(req)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
 	var r0 *entities.UserModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*domain.RegisterRequest) (*entities.UserModels, error)); ok {
@@ -0,0 +1,42 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// GuestCartMergerInterface is an autogenerated mock type for the GuestCartMergerInterface type
+type GuestCartMergerInterface struct {
+	mock.Mock
+}
+
+// MergeGuestCart provides a mock function with given fields: userID, cartToken
+func (_m *GuestCartMergerInterface) MergeGuestCart(userID uint64, cartToken string) error {
+	ret := _m.Called(userID, cartToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeGuestCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(userID, cartToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewGuestCartMergerInterface creates a new instance of GuestCartMergerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGuestCartMergerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GuestCartMergerInterface {
+	mock := &GuestCartMergerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,13 +20,13 @@ var (
 	jwt         token.JWTInterface
 )
 
-func InitializeAuth(db *gorm.DB) {
+func InitializeAuth(db *gorm.DB, cart domain.GuestCartMergerInterface) {
 	secret := os.Getenv("SECRET")
 	hash = utils.NewHash()
 	jwt = token.NewJWT(secret)
 
 	userRepo = repository.NewAuthRepository(db)
-	userService = service.NewAuthService(userRepo, hash, jwt)
+	userService = service.NewAuthService(userRepo, hash, jwt, cart)
 	userHandler = handler.NewAuthHandler(userService)
 }
 
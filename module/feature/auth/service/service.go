@@ -12,17 +12,20 @@ type AuthService struct {
 	repo domain.AuthRepositoryInterface
 	hash hash.HashInterface
 	jwt  token.JWTInterface
+	cart domain.GuestCartMergerInterface
 }
 
 func NewAuthService(
 	repo domain.AuthRepositoryInterface,
 	hash hash.HashInterface,
 	jwt token.JWTInterface,
+	cart domain.GuestCartMergerInterface,
 ) domain.AuthServiceInterface {
 	return &AuthService{
 		repo: repo,
 		hash: hash,
 		jwt:  jwt,
+		cart: cart,
 	}
 }
 
@@ -71,3 +74,10 @@ func (s *AuthService) Register(req *domain.RegisterRequest) (*entities.UserModel
 	}
 	return result, nil
 }
+
+func (s *AuthService) MergeGuestCart(userID uint64, cartToken string) error {
+	if cartToken == "" {
+		return nil
+	}
+	return s.cart.MergeGuestCart(userID, cartToken)
+}
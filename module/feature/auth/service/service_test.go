@@ -15,7 +15,7 @@ func setupTest(t *testing.T) (*mocks.AuthRepositoryInterface, domain.AuthService
 	repo := mocks.NewAuthRepositoryInterface(t)
 	hash := utils.NewHashInterface(t)
 	jwt := utils.NewJWTInterface(t)
-	service := NewAuthService(repo, hash, jwt)
+	service := NewAuthService(repo, hash, jwt, mocks.NewGuestCartMergerInterface(t))
 	return repo, service, hash, jwt
 }
 
@@ -114,3 +114,25 @@ func TestLogin(t *testing.T) {
 		jwt.AssertExpectations(t)
 	})
 }
+
+func TestMergeGuestCart(t *testing.T) {
+	repo := mocks.NewAuthRepositoryInterface(t)
+	cart := mocks.NewGuestCartMergerInterface(t)
+	service := NewAuthService(repo, utils.NewHashInterface(t), utils.NewJWTInterface(t), cart)
+
+	t.Run("Success Case - No Cart Token", func(t *testing.T) {
+		err := service.MergeGuestCart(1, "")
+
+		assert.Nil(t, err)
+		cart.AssertNotCalled(t, "MergeGuestCart", uint64(1), "")
+	})
+
+	t.Run("Success Case - Guest Cart Merged", func(t *testing.T) {
+		cart.On("MergeGuestCart", uint64(1), "cart-token").Return(nil).Once()
+
+		err := service.MergeGuestCart(1, "cart-token")
+
+		assert.Nil(t, err)
+		cart.AssertExpectations(t)
+	})
+}
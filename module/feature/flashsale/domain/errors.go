@@ -0,0 +1,9 @@
+package domain
+
+import "errors"
+
+var (
+	ErrFlashSaleNotFound = errors.New("flash sale not found")
+	ErrInvalidFlashSale  = errors.New("invalid flash sale")
+	ErrQuotaExhausted    = errors.New("flash sale quota exhausted")
+)
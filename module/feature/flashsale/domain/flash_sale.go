@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"ruti-store/module/entities"
+	"time"
+)
+
+const (
+	ClaimStatusClaimed  = "claimed"
+	ClaimStatusReleased = "released"
+)
+
+const (
+	FlashSaleUpcoming = "upcoming"
+	FlashSaleRunning  = "running"
+	FlashSaleEnded    = "ended"
+)
+
+// Remaining returns how many units of the item can still be sold at the sale price.
+func Remaining(item *entities.FlashSaleItemModels) uint64 {
+	if item.Sold >= item.Quota {
+		return 0
+	}
+	return item.Quota - item.Sold
+}
+
+// FindItem returns the cheapest of the running sale items of the variant that still has
+// quantity units left, or nil when the variant is sold at its regular price.
+func FindItem(items []*entities.FlashSaleItemModels, variantID, quantity uint64) *entities.FlashSaleItemModels {
+	var found *entities.FlashSaleItemModels
+	for _, item := range items {
+		if item.VariantID != variantID || Remaining(item) < quantity {
+			continue
+		}
+		if found == nil || item.SalePrice < found.SalePrice {
+			found = item
+		}
+	}
+	return found
+}
+
+// Status tells whether the flash sale is upcoming, running or has ended at time now.
+func Status(sale *entities.FlashSaleModels, now time.Time) string {
+	switch {
+	case now.Before(sale.StartAt):
+		return FlashSaleUpcoming
+	case now.Before(sale.EndAt):
+		return FlashSaleRunning
+	default:
+		return FlashSaleEnded
+	}
+}
+
+// Countdown returns the seconds left until the flash sale starts, when it is upcoming, or
+// until it ends, when it is running.
+func Countdown(sale *entities.FlashSaleModels, now time.Time) int64 {
+	switch Status(sale, now) {
+	case FlashSaleUpcoming:
+		return int64(sale.StartAt.Sub(now).Seconds())
+	case FlashSaleRunning:
+		return int64(sale.EndAt.Sub(now).Seconds())
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	"time"
+)
+
+type FlashSaleRepositoryInterface interface {
+	GetPaginatedFlashSales(page, pageSize int) ([]*entities.FlashSaleModels, int64, error)
+	GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error)
+	GetActiveFlashSales(now time.Time) ([]*entities.FlashSaleModels, error)
+	GetActiveItems(productIDs []uint64, now time.Time) ([]*entities.FlashSaleItemModels, error)
+	GetProductByID(productID uint64) (*entities.ProductModels, error)
+	CreateFlashSale(flashSale *entities.FlashSaleModels) (*entities.FlashSaleModels, error)
+	UpdateFlashSale(flashSale *entities.FlashSaleModels, items []*entities.FlashSaleItemModels) error
+	DeleteFlashSale(flashSaleID uint64) error
+	ClaimQuota(orderID string, itemID, quantity uint64) error
+	ReleaseQuota(orderID string) error
+}
+
+type FlashSaleServiceInterface interface {
+	GetAllFlashSales(page, pageSize int) ([]*entities.FlashSaleModels, int64, error)
+	GetFlashSalePage(currentPage, pageSize, totalItems int) (int, int, int, error)
+	GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error)
+	GetActiveFlashSales() ([]*entities.FlashSaleModels, error)
+	GetActiveItems(productIDs []uint64) ([]*entities.FlashSaleItemModels, error)
+	CreateFlashSale(req *CreateFlashSaleRequest) (*entities.FlashSaleModels, error)
+	UpdateFlashSale(flashSaleID uint64, req *UpdateFlashSaleRequest) (*entities.FlashSaleModels, error)
+	DeleteFlashSale(flashSaleID uint64) error
+}
+
+type FlashSaleHandlerInterface interface {
+	GetAllFlashSales(c *fiber.Ctx) error
+	GetFlashSaleByID(c *fiber.Ctx) error
+	GetActiveFlashSales(c *fiber.Ctx) error
+	CreateFlashSale(c *fiber.Ctx) error
+	UpdateFlashSale(c *fiber.Ctx) error
+	DeleteFlashSale(c *fiber.Ctx) error
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type FlashSaleItemRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	VariantID uint64 `json:"variant_id" validate:"required"`
+	SalePrice uint64 `json:"sale_price" validate:"required"`
+	Quota     uint64 `json:"quota" validate:"required"`
+}
+
+type CreateFlashSaleRequest struct {
+	Name        string                 `json:"name" validate:"required"`
+	Description string                 `json:"description"`
+	IsActive    bool                   `json:"is_active"`
+	StartAt     time.Time              `json:"start_at" validate:"required"`
+	EndAt       time.Time              `json:"end_at" validate:"required,gtfield=StartAt"`
+	Items       []FlashSaleItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type UpdateFlashSaleRequest struct {
+	Name        string                 `json:"name" validate:"required"`
+	Description string                 `json:"description"`
+	IsActive    bool                   `json:"is_active"`
+	StartAt     time.Time              `json:"start_at" validate:"required"`
+	EndAt       time.Time              `json:"end_at" validate:"required,gtfield=StartAt"`
+	Items       []FlashSaleItemRequest `json:"items" validate:"required,min=1,dive"`
+}
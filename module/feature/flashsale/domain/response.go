@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"ruti-store/module/entities"
+	product "ruti-store/module/feature/product/domain"
+	"time"
+)
+
+type FlashSaleResponse struct {
+	ID          uint64                  `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	IsActive    bool                    `json:"is_active"`
+	Status      string                  `json:"status"`
+	StartAt     time.Time               `json:"start_at"`
+	EndAt       time.Time               `json:"end_at"`
+	Countdown   int64                   `json:"countdown"`
+	CreatedAt   time.Time               `json:"created_at"`
+	Items       []FlashSaleItemResponse `json:"items"`
+}
+
+type FlashSaleItemResponse struct {
+	ID          uint64 `json:"id"`
+	ProductID   uint64 `json:"product_id"`
+	ProductName string `json:"product_name"`
+	VariantID   uint64 `json:"variant_id"`
+	Size        string `json:"size"`
+	Color       string `json:"color"`
+	Price       uint64 `json:"price"`
+	SalePrice   uint64 `json:"sale_price"`
+	Quota       uint64 `json:"quota"`
+	Sold        uint64 `json:"sold"`
+	Remaining   uint64 `json:"remaining"`
+}
+
+func FlashSaleFormatter(flashSale *entities.FlashSaleModels) *FlashSaleResponse {
+	now := time.Now()
+	flashSaleResponse := &FlashSaleResponse{
+		ID:          flashSale.ID,
+		Name:        flashSale.Name,
+		Description: flashSale.Description,
+		IsActive:    flashSale.IsActive,
+		Status:      Status(flashSale, now),
+		StartAt:     flashSale.StartAt,
+		EndAt:       flashSale.EndAt,
+		Countdown:   Countdown(flashSale, now),
+		CreatedAt:   flashSale.CreatedAt,
+		Items:       make([]FlashSaleItemResponse, 0),
+	}
+
+	for _, item := range flashSale.Items {
+		flashSaleResponse.Items = append(flashSaleResponse.Items, FlashSaleItemResponse{
+			ID:          item.ID,
+			ProductID:   item.ProductID,
+			ProductName: item.Product.Name,
+			VariantID:   item.VariantID,
+			Size:        item.Variant.Size,
+			Color:       item.Variant.Color,
+			Price:       product.VariantPrice(&item.Product, &item.Variant),
+			SalePrice:   item.SalePrice,
+			Quota:       item.Quota,
+			Sold:        item.Sold,
+			Remaining:   Remaining(item),
+		})
+	}
+
+	return flashSaleResponse
+}
+
+func ResponseArrayFlashSales(data []*entities.FlashSaleModels) []*FlashSaleResponse {
+	res := make([]*FlashSaleResponse, 0)
+
+	for _, flashSale := range data {
+		res = append(res, FlashSaleFormatter(flashSale))
+	}
+
+	return res
+}
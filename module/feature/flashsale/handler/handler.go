@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/flashsale/domain"
+	"ruti-store/utils/response"
+	"ruti-store/utils/validator"
+	"strconv"
+)
+
+type FlashSaleHandler struct {
+	service domain.FlashSaleServiceInterface
+}
+
+func NewFlashSaleHandler(service domain.FlashSaleServiceInterface) domain.FlashSaleHandlerInterface {
+	return &FlashSaleHandler{
+		service: service,
+	}
+}
+
+func (h *FlashSaleHandler) GetAllFlashSales(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	result, totalItems, err := h.service.GetAllFlashSales(currentPage, pageSize)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetFlashSalePage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get pagination",
+		domain.ResponseArrayFlashSales(result), currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
+func (h *FlashSaleHandler) GetFlashSaleByID(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	flashSaleID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	result, err := h.service.GetFlashSaleByID(flashSaleID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get flash sale", domain.FlashSaleFormatter(result))
+}
+
+func (h *FlashSaleHandler) GetActiveFlashSales(c *fiber.Ctx) error {
+	result, err := h.service.GetActiveFlashSales()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get active flash sales", domain.ResponseArrayFlashSales(result))
+}
+
+func (h *FlashSaleHandler) CreateFlashSale(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.CreateFlashSaleRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateFlashSale(req)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create flash sale", domain.FlashSaleFormatter(result))
+}
+
+func (h *FlashSaleHandler) UpdateFlashSale(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	flashSaleID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.UpdateFlashSaleRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateFlashSale(flashSaleID, req)
+	if errors.Is(err, domain.ErrFlashSaleNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success update flash sale", domain.FlashSaleFormatter(result))
+}
+
+func (h *FlashSaleHandler) DeleteFlashSale(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	flashSaleID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	if err := h.service.DeleteFlashSale(flashSaleID); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success delete flash sale")
+}
@@ -0,0 +1,289 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FlashSaleRepositoryInterface is an autogenerated mock type for the FlashSaleRepositoryInterface type
+type FlashSaleRepositoryInterface struct {
+	mock.Mock
+}
+
+// ClaimQuota provides a mock function with given fields: orderID, itemID, quantity
+func (_m *FlashSaleRepositoryInterface) ClaimQuota(orderID string, itemID uint64, quantity uint64) error {
+	ret := _m.Called(orderID, itemID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64, uint64) error); ok {
+		r0 = rf(orderID, itemID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateFlashSale provides a mock function with given fields: flashSale
+func (_m *FlashSaleRepositoryInterface) CreateFlashSale(flashSale *entities.FlashSaleModels) (*entities.FlashSaleModels, error) {
+	ret := _m.Called(flashSale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlashSale")
+	}
+
+	var r0 *entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.FlashSaleModels) (*entities.FlashSaleModels, error)); ok {
+		return rf(flashSale)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.FlashSaleModels) *entities.FlashSaleModels); ok {
+		r0 = rf(flashSale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.FlashSaleModels) error); ok {
+		r1 = rf(flashSale)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFlashSale provides a mock function with given fields: flashSaleID
+func (_m *FlashSaleRepositoryInterface) DeleteFlashSale(flashSaleID uint64) error {
+	ret := _m.Called(flashSaleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlashSale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(flashSaleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveFlashSales provides a mock function with given fields: now
+func (_m *FlashSaleRepositoryInterface) GetActiveFlashSales(now time.Time) ([]*entities.FlashSaleModels, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveFlashSales")
+	}
+
+	var r0 []*entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*entities.FlashSaleModels, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*entities.FlashSaleModels); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveItems provides a mock function with given fields: productIDs, now
+func (_m *FlashSaleRepositoryInterface) GetActiveItems(productIDs []uint64, now time.Time) ([]*entities.FlashSaleItemModels, error) {
+	ret := _m.Called(productIDs, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveItems")
+	}
+
+	var r0 []*entities.FlashSaleItemModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint64, time.Time) ([]*entities.FlashSaleItemModels, error)); ok {
+		return rf(productIDs, now)
+	}
+	if rf, ok := ret.Get(0).(func([]uint64, time.Time) []*entities.FlashSaleItemModels); ok {
+		r0 = rf(productIDs, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleItemModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint64, time.Time) error); ok {
+		r1 = rf(productIDs, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFlashSaleByID provides a mock function with given fields: flashSaleID
+func (_m *FlashSaleRepositoryInterface) GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error) {
+	ret := _m.Called(flashSaleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlashSaleByID")
+	}
+
+	var r0 *entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.FlashSaleModels, error)); ok {
+		return rf(flashSaleID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.FlashSaleModels); ok {
+		r0 = rf(flashSaleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(flashSaleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedFlashSales provides a mock function with given fields: page, pageSize
+func (_m *FlashSaleRepositoryInterface) GetPaginatedFlashSales(page int, pageSize int) ([]*entities.FlashSaleModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedFlashSales")
+	}
+
+	var r0 []*entities.FlashSaleModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.FlashSaleModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.FlashSaleModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProductByID provides a mock function with given fields: productID
+func (_m *FlashSaleRepositoryInterface) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductModels, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductModels); ok {
+		r0 = rf(productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseQuota provides a mock function with given fields: orderID
+func (_m *FlashSaleRepositoryInterface) ReleaseQuota(orderID string) error {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFlashSale provides a mock function with given fields: flashSale, items
+func (_m *FlashSaleRepositoryInterface) UpdateFlashSale(flashSale *entities.FlashSaleModels, items []*entities.FlashSaleItemModels) error {
+	ret := _m.Called(flashSale, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlashSale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.FlashSaleModels, []*entities.FlashSaleItemModels) error); ok {
+		r0 = rf(flashSale, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewFlashSaleRepositoryInterface creates a new instance of FlashSaleRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlashSaleRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FlashSaleRepositoryInterface {
+	mock := &FlashSaleRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,276 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/flashsale/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FlashSaleServiceInterface is an autogenerated mock type for the FlashSaleServiceInterface type
+type FlashSaleServiceInterface struct {
+	mock.Mock
+}
+
+// CreateFlashSale provides a mock function with given fields: req
+func (_m *FlashSaleServiceInterface) CreateFlashSale(req *domain.CreateFlashSaleRequest) (*entities.FlashSaleModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFlashSale")
+	}
+
+	var r0 *entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateFlashSaleRequest) (*entities.FlashSaleModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateFlashSaleRequest) *entities.FlashSaleModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateFlashSaleRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFlashSale provides a mock function with given fields: flashSaleID
+func (_m *FlashSaleServiceInterface) DeleteFlashSale(flashSaleID uint64) error {
+	ret := _m.Called(flashSaleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFlashSale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(flashSaleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveFlashSales provides a mock function with no fields
+func (_m *FlashSaleServiceInterface) GetActiveFlashSales() ([]*entities.FlashSaleModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveFlashSales")
+	}
+
+	var r0 []*entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.FlashSaleModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.FlashSaleModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveItems provides a mock function with given fields: productIDs
+func (_m *FlashSaleServiceInterface) GetActiveItems(productIDs []uint64) ([]*entities.FlashSaleItemModels, error) {
+	ret := _m.Called(productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveItems")
+	}
+
+	var r0 []*entities.FlashSaleItemModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint64) ([]*entities.FlashSaleItemModels, error)); ok {
+		return rf(productIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uint64) []*entities.FlashSaleItemModels); ok {
+		r0 = rf(productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleItemModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint64) error); ok {
+		r1 = rf(productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllFlashSales provides a mock function with given fields: page, pageSize
+func (_m *FlashSaleServiceInterface) GetAllFlashSales(page int, pageSize int) ([]*entities.FlashSaleModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFlashSales")
+	}
+
+	var r0 []*entities.FlashSaleModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.FlashSaleModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.FlashSaleModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetFlashSaleByID provides a mock function with given fields: flashSaleID
+func (_m *FlashSaleServiceInterface) GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error) {
+	ret := _m.Called(flashSaleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlashSaleByID")
+	}
+
+	var r0 *entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.FlashSaleModels, error)); ok {
+		return rf(flashSaleID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.FlashSaleModels); ok {
+		r0 = rf(flashSaleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(flashSaleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFlashSalePage provides a mock function with given fields: currentPage, pageSize, totalItems
+func (_m *FlashSaleServiceInterface) GetFlashSalePage(currentPage int, pageSize int, totalItems int) (int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize, totalItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlashSalePage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(int, int, int) (int, int, int, error)); ok {
+		return rf(currentPage, pageSize, totalItems)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) int); ok {
+		r0 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) int); ok {
+		r1 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, int) int); ok {
+		r2 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int, int) error); ok {
+		r3 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// UpdateFlashSale provides a mock function with given fields: flashSaleID, req
+func (_m *FlashSaleServiceInterface) UpdateFlashSale(flashSaleID uint64, req *domain.UpdateFlashSaleRequest) (*entities.FlashSaleModels, error) {
+	ret := _m.Called(flashSaleID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFlashSale")
+	}
+
+	var r0 *entities.FlashSaleModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateFlashSaleRequest) (*entities.FlashSaleModels, error)); ok {
+		return rf(flashSaleID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateFlashSaleRequest) *entities.FlashSaleModels); ok {
+		r0 = rf(flashSaleID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.FlashSaleModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.UpdateFlashSaleRequest) error); ok {
+		r1 = rf(flashSaleID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFlashSaleServiceInterface creates a new instance of FlashSaleServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlashSaleServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FlashSaleServiceInterface {
+	mock := &FlashSaleServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,35 @@
+package flashsale
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"ruti-store/module/feature/flashsale/domain"
+	"ruti-store/module/feature/flashsale/handler"
+	"ruti-store/module/feature/flashsale/repository"
+	"ruti-store/module/feature/flashsale/service"
+	"ruti-store/module/feature/middleware"
+	user "ruti-store/module/feature/user/domain"
+	"ruti-store/utils/token"
+)
+
+var (
+	repo domain.FlashSaleRepositoryInterface
+	serv domain.FlashSaleServiceInterface
+	hand domain.FlashSaleHandlerInterface
+)
+
+func InitializeFlashSale(db *gorm.DB) {
+	repo = repository.NewFlashSaleRepository(db)
+	serv = service.NewFlashSaleService(repo)
+	hand = handler.NewFlashSaleHandler(serv)
+}
+
+func SetupRoutesFlashSale(app *fiber.App, jwt token.JWTInterface, userService user.UserServiceInterface) {
+	api := app.Group("/api/v1/flash-sale")
+	api.Get("/active", hand.GetActiveFlashSales)
+	api.Get("/list", middleware.AuthMiddleware(jwt, userService), hand.GetAllFlashSales)
+	api.Get("/details/:id", middleware.AuthMiddleware(jwt, userService), hand.GetFlashSaleByID)
+	api.Post("/create", middleware.AuthMiddleware(jwt, userService), hand.CreateFlashSale)
+	api.Put("/update/:id", middleware.AuthMiddleware(jwt, userService), hand.UpdateFlashSale)
+	api.Delete("/delete/:id", middleware.AuthMiddleware(jwt, userService), hand.DeleteFlashSale)
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/flashsale/domain"
+	"time"
+)
+
+type FlashSaleRepository struct {
+	db *gorm.DB
+}
+
+func NewFlashSaleRepository(db *gorm.DB) domain.FlashSaleRepositoryInterface {
+	return &FlashSaleRepository{
+		db: db,
+	}
+}
+
+func (r *FlashSaleRepository) GetPaginatedFlashSales(page, pageSize int) ([]*entities.FlashSaleModels, int64, error) {
+	var flashSales []*entities.FlashSaleModels
+	var totalItems int64
+
+	offset := (page - 1) * pageSize
+
+	query := r.db.Model(&entities.FlashSaleModels{}).Where("deleted_at IS NULL")
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("start_at DESC").
+		Offset(offset).Limit(pageSize).
+		Preload("Items.Product").Preload("Items.Variant").
+		Find(&flashSales).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return flashSales, totalItems, nil
+}
+
+func (r *FlashSaleRepository) GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error) {
+	var flashSale *entities.FlashSaleModels
+
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", flashSaleID).
+		Preload("Items.Product").Preload("Items.Variant").
+		First(&flashSale).Error; err != nil {
+		return nil, err
+	}
+	return flashSale, nil
+}
+
+func (r *FlashSaleRepository) GetActiveFlashSales(now time.Time) ([]*entities.FlashSaleModels, error) {
+	var flashSales []*entities.FlashSaleModels
+
+	if err := r.db.Where("deleted_at IS NULL AND is_active = ? AND end_at > ?", true, now).
+		Order("start_at ASC").
+		Preload("Items.Product").Preload("Items.Variant").
+		Find(&flashSales).Error; err != nil {
+		return nil, err
+	}
+	return flashSales, nil
+}
+
+// GetActiveItems returns the items of the flash sales running at time now that cover any of
+// the products.
+func (r *FlashSaleRepository) GetActiveItems(productIDs []uint64, now time.Time) ([]*entities.FlashSaleItemModels, error) {
+	items := make([]*entities.FlashSaleItemModels, 0)
+	if len(productIDs) == 0 {
+		return items, nil
+	}
+
+	if err := r.db.Where("flash_sale_items.product_id IN ?", productIDs).
+		Where("flash_sale_items.flash_sale_id IN (?)", runningFlashSales(r.db, now)).
+		Preload("FlashSale").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *FlashSaleRepository) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	var product *entities.ProductModels
+
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", productID).
+		Preload("Variants", "deleted_at IS NULL").
+		First(&product).Error; err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (r *FlashSaleRepository) CreateFlashSale(flashSale *entities.FlashSaleModels) (*entities.FlashSaleModels, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(flashSale).Error; err != nil {
+			return err
+		}
+		for _, item := range flashSale.Items {
+			item.FlashSaleID = flashSale.ID
+			if err := tx.Omit(clause.Associations).Create(item).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flashSale, nil
+}
+
+// UpdateFlashSale saves the flash sale and replaces its items with items. Items that already
+// exist keep their sold count, which is only ever changed by ClaimQuota and ReleaseQuota.
+func (r *FlashSaleRepository) UpdateFlashSale(flashSale *entities.FlashSaleModels, items []*entities.FlashSaleItemModels) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(flashSale).Select("*").
+			Omit(clause.Associations, "id", "created_at", "deleted_at").
+			Updates(flashSale).Error; err != nil {
+			return err
+		}
+
+		var keep []uint64
+		for _, item := range items {
+			if item.ID != 0 {
+				keep = append(keep, item.ID)
+			}
+		}
+		query := tx.Where("flash_sale_id = ?", flashSale.ID)
+		if len(keep) > 0 {
+			query = query.Where("id NOT IN ?", keep)
+		}
+		if err := query.Delete(&entities.FlashSaleItemModels{}).Error; err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			item.FlashSaleID = flashSale.ID
+			if item.ID == 0 {
+				if err := tx.Omit(clause.Associations).Create(item).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Model(item).Updates(map[string]interface{}{
+				"sale_price": item.SalePrice,
+				"quota":      item.Quota,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *FlashSaleRepository) DeleteFlashSale(flashSaleID uint64) error {
+	flashSale := &entities.FlashSaleModels{}
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", flashSaleID).First(flashSale).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(flashSale).Update("deleted_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimQuota takes quantity units of the item's quota for an order. The quota is checked and
+// taken in a single update, so concurrent checkouts can't sell more than the quota, and the
+// claim fails with ErrQuotaExhausted once the quota is used up or the sale is over.
+func (r *FlashSaleRepository) ClaimQuota(orderID string, itemID, quantity uint64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.FlashSaleItemModels{}).
+			Where("id = ? AND sold + ? <= quota", itemID, quantity).
+			Where("flash_sale_id IN (?)", runningFlashSales(tx, time.Now())).
+			Update("sold", gorm.Expr("sold + ?", quantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrQuotaExhausted
+		}
+
+		claim := &entities.FlashSaleClaimModels{
+			FlashSaleItemID: itemID,
+			OrderID:         orderID,
+			Quantity:        quantity,
+			Status:          domain.ClaimStatusClaimed,
+			CreatedAt:       time.Now(),
+		}
+		return tx.Create(claim).Error
+	})
+}
+
+// ReleaseQuota gives back the quota claimed by an order that didn't go through. Claims that
+// were already released are left alone, so calling it twice doesn't release twice.
+func (r *FlashSaleRepository) ReleaseQuota(orderID string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var claims []*entities.FlashSaleClaimModels
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ? AND status = ?", orderID, domain.ClaimStatusClaimed).
+			Find(&claims).Error; err != nil {
+			return err
+		}
+
+		for _, claim := range claims {
+			if err := tx.Model(&entities.FlashSaleItemModels{}).
+				Where("id = ? AND sold >= ?", claim.FlashSaleItemID, claim.Quantity).
+				Update("sold", gorm.Expr("sold - ?", claim.Quantity)).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(claim).Updates(map[string]interface{}{
+				"status":      domain.ClaimStatusReleased,
+				"released_at": time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// runningFlashSales selects the ids of the flash sales running at time now.
+func runningFlashSales(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Model(&entities.FlashSaleModels{}).Select("id").
+		Where("deleted_at IS NULL AND is_active = ? AND start_at <= ? AND end_at > ?", true, now, now)
+}
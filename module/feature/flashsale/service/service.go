@@ -0,0 +1,186 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/flashsale/domain"
+	product "ruti-store/module/feature/product/domain"
+	"time"
+)
+
+type FlashSaleService struct {
+	repo domain.FlashSaleRepositoryInterface
+}
+
+func NewFlashSaleService(repo domain.FlashSaleRepositoryInterface) domain.FlashSaleServiceInterface {
+	return &FlashSaleService{
+		repo: repo,
+	}
+}
+
+func (s *FlashSaleService) GetAllFlashSales(page, pageSize int) ([]*entities.FlashSaleModels, int64, error) {
+	result, totalItems, err := s.repo.GetPaginatedFlashSales(page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, totalItems, nil
+}
+
+func (s *FlashSaleService) GetFlashSalePage(currentPage, pageSize, totalItems int) (int, int, int, error) {
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+	nextPage := currentPage + 1
+	prevPage := currentPage - 1
+
+	if nextPage > totalPages {
+		nextPage = 0
+	}
+
+	if prevPage < 1 {
+		prevPage = 0
+	}
+
+	return totalPages, nextPage, prevPage, nil
+}
+
+func (s *FlashSaleService) GetFlashSaleByID(flashSaleID uint64) (*entities.FlashSaleModels, error) {
+	result, err := s.repo.GetFlashSaleByID(flashSaleID)
+	if err != nil {
+		return nil, domain.ErrFlashSaleNotFound
+	}
+	return result, nil
+}
+
+func (s *FlashSaleService) GetActiveFlashSales() ([]*entities.FlashSaleModels, error) {
+	result, err := s.repo.GetActiveFlashSales(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *FlashSaleService) GetActiveItems(productIDs []uint64) ([]*entities.FlashSaleItemModels, error) {
+	result, err := s.repo.GetActiveItems(productIDs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *FlashSaleService) CreateFlashSale(req *domain.CreateFlashSaleRequest) (*entities.FlashSaleModels, error) {
+	items, err := s.newItems(req.Items, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newData := &entities.FlashSaleModels{
+		Name:        req.Name,
+		Description: req.Description,
+		IsActive:    req.IsActive,
+		StartAt:     req.StartAt,
+		EndAt:       req.EndAt,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		Items:       items,
+	}
+
+	result, err := s.repo.CreateFlashSale(newData)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetFlashSaleByID(result.ID)
+}
+
+func (s *FlashSaleService) UpdateFlashSale(flashSaleID uint64, req *domain.UpdateFlashSaleRequest) (*entities.FlashSaleModels, error) {
+	flashSale, err := s.repo.GetFlashSaleByID(flashSaleID)
+	if err != nil {
+		return nil, domain.ErrFlashSaleNotFound
+	}
+
+	items, err := s.newItems(req.Items, flashSale.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	flashSale.Name = req.Name
+	flashSale.Description = req.Description
+	flashSale.IsActive = req.IsActive
+	flashSale.StartAt = req.StartAt
+	flashSale.EndAt = req.EndAt
+	flashSale.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateFlashSale(flashSale, items); err != nil {
+		return nil, err
+	}
+	return s.repo.GetFlashSaleByID(flashSaleID)
+}
+
+func (s *FlashSaleService) DeleteFlashSale(flashSaleID uint64) error {
+	if err := s.repo.DeleteFlashSale(flashSaleID); err != nil {
+		return domain.ErrFlashSaleNotFound
+	}
+	return nil
+}
+
+// newItems builds the items of a flash sale from the request. Items of a variant that is
+// already on the sale keep their id and sold count, and a variant that has sold units can't
+// be dropped from the sale or get a quota below what it sold.
+func (s *FlashSaleService) newItems(requests []domain.FlashSaleItemRequest, existing []*entities.FlashSaleItemModels) ([]*entities.FlashSaleItemModels, error) {
+	current := make(map[uint64]*entities.FlashSaleItemModels)
+	for _, item := range existing {
+		current[item.VariantID] = item
+	}
+
+	items := make([]*entities.FlashSaleItemModels, 0, len(requests))
+	seen := make(map[uint64]bool)
+	for _, req := range requests {
+		if seen[req.VariantID] {
+			return nil, fmt.Errorf("%w: variant %d is listed more than once", domain.ErrInvalidFlashSale, req.VariantID)
+		}
+		seen[req.VariantID] = true
+
+		products, err := s.repo.GetProductByID(req.ProductID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: variant %d of product %d not found", domain.ErrInvalidFlashSale, req.VariantID, req.ProductID)
+		}
+		variant := findVariant(products, req.VariantID)
+		if variant == nil {
+			return nil, fmt.Errorf("%w: variant %d of product %d not found", domain.ErrInvalidFlashSale, req.VariantID, req.ProductID)
+		}
+		if req.SalePrice >= product.VariantPrice(products, variant) {
+			return nil, fmt.Errorf("%w: sale price of variant %d must be below the variant price", domain.ErrInvalidFlashSale, req.VariantID)
+		}
+
+		item := &entities.FlashSaleItemModels{
+			ProductID: req.ProductID,
+			VariantID: req.VariantID,
+			SalePrice: req.SalePrice,
+			Quota:     req.Quota,
+		}
+		if old, ok := current[req.VariantID]; ok {
+			if req.Quota < old.Sold {
+				return nil, fmt.Errorf("%w: quota of variant %d can't be below the %d units already sold", domain.ErrInvalidFlashSale, req.VariantID, old.Sold)
+			}
+			item.ID = old.ID
+			item.Sold = old.Sold
+		}
+		items = append(items, item)
+	}
+
+	for variantID, old := range current {
+		if !seen[variantID] && old.Sold > 0 {
+			return nil, fmt.Errorf("%w: variant %d already sold %d units and can't be removed", domain.ErrInvalidFlashSale, variantID, old.Sold)
+		}
+	}
+
+	return items, nil
+}
+
+func findVariant(products *entities.ProductModels, variantID uint64) *entities.ProductVariantModels {
+	for i := range products.Variants {
+		if products.Variants[i].ID == variantID {
+			return &products.Variants[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/flashsale/domain"
+	"ruti-store/module/feature/flashsale/mocks"
+)
+
+func TestFlashSaleService_UpdateFlashSale(t *testing.T) {
+	repo := mocks.NewFlashSaleRepositoryInterface(t)
+	service := NewFlashSaleService(repo)
+
+	product := &entities.ProductModels{
+		ID:       1,
+		Price:    150000,
+		Variants: []entities.ProductVariantModels{{ID: 11}, {ID: 12}},
+	}
+	existing := func() *entities.FlashSaleModels {
+		return &entities.FlashSaleModels{
+			ID:   5,
+			Name: "Payday Sale",
+			Items: []*entities.FlashSaleItemModels{
+				{ID: 51, FlashSaleID: 5, ProductID: 1, VariantID: 11, SalePrice: 99000, Quota: 10, Sold: 4},
+			},
+		}
+	}
+	req := &domain.UpdateFlashSaleRequest{
+		Name:     "Payday Sale",
+		IsActive: true,
+		StartAt:  time.Now(),
+		EndAt:    time.Now().Add(2 * time.Hour),
+		Items: []domain.FlashSaleItemRequest{
+			{ProductID: 1, VariantID: 11, SalePrice: 89000, Quota: 20},
+			{ProductID: 1, VariantID: 12, SalePrice: 89000, Quota: 5},
+		},
+	}
+
+	t.Run("Failed Case - Quota Below Sold", func(t *testing.T) {
+		invalid := *req
+		invalid.Items = []domain.FlashSaleItemRequest{{ProductID: 1, VariantID: 11, SalePrice: 89000, Quota: 3}}
+		repo.On("GetFlashSaleByID", uint64(5)).Return(existing(), nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		result, err := service.UpdateFlashSale(5, &invalid)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrInvalidFlashSale))
+		repo.AssertNotCalled(t, "UpdateFlashSale", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed Case - Sale Price Not Below Product Price", func(t *testing.T) {
+		invalid := *req
+		invalid.Items = []domain.FlashSaleItemRequest{{ProductID: 1, VariantID: 11, SalePrice: 150000, Quota: 10}}
+		repo.On("GetFlashSaleByID", uint64(5)).Return(existing(), nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		result, err := service.UpdateFlashSale(5, &invalid)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrInvalidFlashSale))
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		updated := existing()
+		repo.On("GetFlashSaleByID", uint64(5)).Return(existing(), nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Twice()
+		repo.On("UpdateFlashSale", mock.Anything, mock.MatchedBy(func(items []*entities.FlashSaleItemModels) bool {
+			return len(items) == 2 &&
+				items[0].ID == 51 && items[0].Sold == 4 && items[0].Quota == 20 &&
+				items[1].ID == 0 && items[1].VariantID == 12
+		})).Return(nil).Once()
+		repo.On("GetFlashSaleByID", uint64(5)).Return(updated, nil).Once()
+
+		result, err := service.UpdateFlashSale(5, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, updated, result)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestFindItem(t *testing.T) {
+	items := []*entities.FlashSaleItemModels{
+		{ID: 1, VariantID: 11, SalePrice: 90000, Quota: 10, Sold: 9},
+		{ID: 2, VariantID: 11, SalePrice: 95000, Quota: 10, Sold: 0},
+		{ID: 3, VariantID: 12, SalePrice: 80000, Quota: 5, Sold: 5},
+	}
+
+	assert.Equal(t, uint64(1), domain.FindItem(items, 11, 1).ID)
+	assert.Equal(t, uint64(2), domain.FindItem(items, 11, 2).ID)
+	assert.Nil(t, domain.FindItem(items, 12, 1))
+	assert.Nil(t, domain.FindItem(items, 13, 1))
+}
@@ -15,6 +15,7 @@ type HomeRepositoryInterface interface {
 	GetTotalProduct() (int64, error)
 	GetTotalUser() (int64, error)
 	GetTotalIncome() (uint64, error)
+	GetTotalLowStock() (int64, error)
 	GetAllOrders(page, pageSize int) ([]*entities.OrderModels, error)
 	GetTotalOrderItems() (int64, error)
 }
@@ -26,7 +27,7 @@ type HomeServiceInterface interface {
 	DeleteCarousel(carouselID uint64) error
 	GetCarouselPage(currentPage, pageSize int) (int, int, int, int, error)
 	GetAllCarouselItems(page, pageSize int) ([]*entities.CarouselModels, int64, error)
-	GetDashboardPage() (uint64, int64, int64, error)
+	GetDashboardPage() (uint64, int64, int64, int64, error)
 	GetAllOrders(page, pageSize int) ([]*entities.OrderModels, int64, error)
 	GetOrdersPage(currentPage, pageSize int) (int, int, int, int, error)
 }
@@ -39,16 +39,18 @@ func ResponseArrayCarousel(data []*entities.CarouselModels) []*CarouselResponse
 }
 
 type DashboardResponse struct {
-	TotalIncome  uint64 `json:"total_income"`
-	TotalProduct int64  `json:"total_product"`
-	TotalUser    int64  `json:"total_user"`
+	TotalIncome   uint64 `json:"total_income"`
+	TotalProduct  int64  `json:"total_product"`
+	TotalUser     int64  `json:"total_user"`
+	TotalLowStock int64  `json:"total_low_stock"`
 }
 
-func FormatDashboardResponse(totalIncome uint64, totalProduct, totalUser int64) *DashboardResponse {
+func FormatDashboardResponse(totalIncome uint64, totalProduct, totalUser, totalLowStock int64) *DashboardResponse {
 	result := &DashboardResponse{
-		TotalIncome:  totalIncome,
-		TotalProduct: totalProduct,
-		TotalUser:    totalUser,
+		TotalIncome:   totalIncome,
+		TotalProduct:  totalProduct,
+		TotalUser:     totalUser,
+		TotalLowStock: totalLowStock,
 	}
 	return result
 }
@@ -195,13 +195,13 @@ func (h *HomeHandler) GetDashboard(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
 	}
 
-	totalIncome, totalProduct, totalUser, err := h.service.GetDashboardPage()
+	totalIncome, totalProduct, totalUser, totalLowStock, err := h.service.GetDashboardPage()
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to retrieve dashboard: "+err.Error())
 	}
 
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved dashboard",
-		domain.FormatDashboardResponse(totalIncome, totalProduct, totalUser))
+		domain.FormatDashboardResponse(totalIncome, totalProduct, totalUser, totalLowStock))
 
 }
 
@@ -0,0 +1,352 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HomeRepositoryInterface is an autogenerated mock type for the HomeRepositoryInterface type
+type HomeRepositoryInterface struct {
+	mock.Mock
+}
+
+// CreateCarousel provides a mock function with given fields: carousel
+func (_m *HomeRepositoryInterface) CreateCarousel(carousel *entities.CarouselModels) (*entities.CarouselModels, error) {
+	ret := _m.Called(carousel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCarousel")
+	}
+
+	var r0 *entities.CarouselModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.CarouselModels) (*entities.CarouselModels, error)); ok {
+		return rf(carousel)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.CarouselModels) *entities.CarouselModels); ok {
+		r0 = rf(carousel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.CarouselModels) error); ok {
+		r1 = rf(carousel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCarousel provides a mock function with given fields: carouselID
+func (_m *HomeRepositoryInterface) DeleteCarousel(carouselID uint64) error {
+	ret := _m.Called(carouselID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCarousel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(carouselID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllOrders provides a mock function with given fields: page, pageSize
+func (_m *HomeRepositoryInterface) GetAllOrders(page int, pageSize int) ([]*entities.OrderModels, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.OrderModels, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCarouselById provides a mock function with given fields: carouselID
+func (_m *HomeRepositoryInterface) GetCarouselById(carouselID uint64) (*entities.CarouselModels, error) {
+	ret := _m.Called(carouselID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCarouselById")
+	}
+
+	var r0 *entities.CarouselModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.CarouselModels, error)); ok {
+		return rf(carouselID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.CarouselModels); ok {
+		r0 = rf(carouselID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(carouselID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedCarousel provides a mock function with given fields: page, pageSize
+func (_m *HomeRepositoryInterface) GetPaginatedCarousel(page int, pageSize int) ([]*entities.CarouselModels, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedCarousel")
+	}
+
+	var r0 []*entities.CarouselModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.CarouselModels, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.CarouselModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalCarouselItems provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalCarouselItems() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalCarouselItems")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalIncome provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalIncome() (uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalIncome")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalLowStock provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalLowStock() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalLowStock")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalOrderItems provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalOrderItems() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalOrderItems")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalProduct provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalProduct() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalProduct")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalUser provides a mock function with no fields
+func (_m *HomeRepositoryInterface) GetTotalUser() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalUser")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCarousel provides a mock function with given fields: carouselID, updatedCarousel
+func (_m *HomeRepositoryInterface) UpdateCarousel(carouselID uint64, updatedCarousel *entities.CarouselModels) error {
+	ret := _m.Called(carouselID, updatedCarousel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCarousel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *entities.CarouselModels) error); ok {
+		r0 = rf(carouselID, updatedCarousel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewHomeRepositoryInterface creates a new instance of HomeRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHomeRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HomeRepositoryInterface {
+	mock := &HomeRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,346 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/home/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HomeServiceInterface is an autogenerated mock type for the HomeServiceInterface type
+type HomeServiceInterface struct {
+	mock.Mock
+}
+
+// CreateCarousel provides a mock function with given fields: req
+func (_m *HomeServiceInterface) CreateCarousel(req *domain.CreateCarouselRequest) (*entities.CarouselModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCarousel")
+	}
+
+	var r0 *entities.CarouselModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateCarouselRequest) (*entities.CarouselModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateCarouselRequest) *entities.CarouselModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateCarouselRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCarousel provides a mock function with given fields: carouselID
+func (_m *HomeServiceInterface) DeleteCarousel(carouselID uint64) error {
+	ret := _m.Called(carouselID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCarousel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(carouselID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllCarouselItems provides a mock function with given fields: page, pageSize
+func (_m *HomeServiceInterface) GetAllCarouselItems(page int, pageSize int) ([]*entities.CarouselModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCarouselItems")
+	}
+
+	var r0 []*entities.CarouselModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.CarouselModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.CarouselModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllOrders provides a mock function with given fields: page, pageSize
+func (_m *HomeServiceInterface) GetAllOrders(page int, pageSize int) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCarouselById provides a mock function with given fields: carouselID
+func (_m *HomeServiceInterface) GetCarouselById(carouselID uint64) (*entities.CarouselModels, error) {
+	ret := _m.Called(carouselID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCarouselById")
+	}
+
+	var r0 *entities.CarouselModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.CarouselModels, error)); ok {
+		return rf(carouselID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.CarouselModels); ok {
+		r0 = rf(carouselID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CarouselModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(carouselID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCarouselPage provides a mock function with given fields: currentPage, pageSize
+func (_m *HomeServiceInterface) GetCarouselPage(currentPage int, pageSize int) (int, int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCarouselPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 int
+	var r4 error
+	if rf, ok := ret.Get(0).(func(int, int) (int, int, int, int, error)); ok {
+		return rf(currentPage, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) int); ok {
+		r0 = rf(currentPage, pageSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(currentPage, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) int); ok {
+		r2 = rf(currentPage, pageSize)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int) int); ok {
+		r3 = rf(currentPage, pageSize)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	if rf, ok := ret.Get(4).(func(int, int) error); ok {
+		r4 = rf(currentPage, pageSize)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// GetDashboardPage provides a mock function with no fields
+func (_m *HomeServiceInterface) GetDashboardPage() (uint64, int64, int64, int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDashboardPage")
+	}
+
+	var r0 uint64
+	var r1 int64
+	var r2 int64
+	var r3 int64
+	var r4 error
+	if rf, ok := ret.Get(0).(func() (uint64, int64, int64, int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() int64); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func() int64); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Get(2).(int64)
+	}
+
+	if rf, ok := ret.Get(3).(func() int64); ok {
+		r3 = rf()
+	} else {
+		r3 = ret.Get(3).(int64)
+	}
+
+	if rf, ok := ret.Get(4).(func() error); ok {
+		r4 = rf()
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// GetOrdersPage provides a mock function with given fields: currentPage, pageSize
+func (_m *HomeServiceInterface) GetOrdersPage(currentPage int, pageSize int) (int, int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 int
+	var r4 error
+	if rf, ok := ret.Get(0).(func(int, int) (int, int, int, int, error)); ok {
+		return rf(currentPage, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) int); ok {
+		r0 = rf(currentPage, pageSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(currentPage, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) int); ok {
+		r2 = rf(currentPage, pageSize)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int) int); ok {
+		r3 = rf(currentPage, pageSize)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	if rf, ok := ret.Get(4).(func(int, int) error); ok {
+		r4 = rf(currentPage, pageSize)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// UpdateCarousel provides a mock function with given fields: carouselID, req
+func (_m *HomeServiceInterface) UpdateCarousel(carouselID uint64, req *domain.UpdateCarouselRequest) error {
+	ret := _m.Called(carouselID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCarousel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateCarouselRequest) error); ok {
+		r0 = rf(carouselID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewHomeServiceInterface creates a new instance of HomeServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHomeServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HomeServiceInterface {
+	mock := &HomeServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
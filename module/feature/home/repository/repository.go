@@ -111,8 +111,8 @@ func (r *HomeRepository) GetTotalIncome() (uint64, error) {
 	var totalIncome uint64
 
 	err := r.db.Model(&entities.OrderModels{}).
-		Select("SUM(total_amount_paid) as total_income").
-		Where("payment_status = ?", "Konfirmasi").
+		Select("SUM(total_amount_paid - total_refunded) as total_income").
+		Where("payment_status IN ?", []string{"Konfirmasi", "Dikembalikan"}).
 		Pluck("total_income", &totalIncome).
 		Error
 
@@ -123,6 +123,21 @@ func (r *HomeRepository) GetTotalIncome() (uint64, error) {
 	return totalIncome, nil
 }
 
+// GetTotalLowStock counts the variants on sale whose stock is at or below their low-stock threshold.
+func (r *HomeRepository) GetTotalLowStock() (int64, error) {
+	var totalItems int64
+
+	if err := r.db.Table("variants v").
+		Joins("JOIN product p ON p.id = v.product_id").
+		Where("v.deleted_at IS NULL AND p.deleted_at IS NULL").
+		Where("v.low_stock_threshold > 0 AND v.stock <= v.low_stock_threshold").
+		Count(&totalItems).Error; err != nil {
+		return 0, err
+	}
+
+	return totalItems, nil
+}
+
 func (r *HomeRepository) GetAllOrders(page, pageSize int) ([]*entities.OrderModels, error) {
 	var orders []*entities.OrderModels
 
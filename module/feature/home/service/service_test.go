@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"ruti-store/module/feature/home/mocks"
+)
+
+func TestHomeService_GetDashboardPage(t *testing.T) {
+	repo := mocks.NewHomeRepositoryInterface(t)
+	service := NewHomeService(repo)
+
+	t.Run("Success Case - Total Low Stock", func(t *testing.T) {
+		repo.On("GetTotalProduct").Return(int64(12), nil).Once()
+		repo.On("GetTotalUser").Return(int64(40), nil).Once()
+		repo.On("GetTotalIncome").Return(uint64(2500000), nil).Once()
+		repo.On("GetTotalLowStock").Return(int64(3), nil).Once()
+
+		income, products, users, lowStock, err := service.GetDashboardPage()
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2500000), income)
+		assert.Equal(t, int64(12), products)
+		assert.Equal(t, int64(40), users)
+		assert.Equal(t, int64(3), lowStock)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Total Low Stock Error", func(t *testing.T) {
+		repo.On("GetTotalProduct").Return(int64(12), nil).Once()
+		repo.On("GetTotalUser").Return(int64(40), nil).Once()
+		repo.On("GetTotalIncome").Return(uint64(2500000), nil).Once()
+		repo.On("GetTotalLowStock").Return(int64(0), errors.New("connection refused")).Once()
+
+		_, _, _, lowStock, err := service.GetDashboardPage()
+
+		assert.EqualError(t, err, "connection refused")
+		assert.Equal(t, int64(0), lowStock)
+		repo.AssertExpectations(t)
+	})
+}
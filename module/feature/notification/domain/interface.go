@@ -8,10 +8,12 @@ import (
 type NotificationRepositoryInterface interface {
 	CreateNotification(notification *entities.NotificationModels) (*entities.NotificationModels, error)
 	GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error)
+	GetAdminIDs() ([]uint64, error)
 }
 
 type NotificationServiceInterface interface {
 	CreateNotification(req *CreateNotificationRequest) (*entities.NotificationModels, error)
+	NotifyAdmins(req *CreateAdminNotificationRequest) error
 	GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error)
 }
 
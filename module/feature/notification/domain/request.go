@@ -6,3 +6,10 @@ type CreateNotificationRequest struct {
 	OrderID string `json:"order_id"`
 	Message string `json:"message"`
 }
+
+// CreateAdminNotificationRequest is a notification sent to every admin.
+type CreateAdminNotificationRequest struct {
+	Title   string `json:"title"`
+	OrderID string `json:"order_id"`
+	Message string `json:"message"`
+}
@@ -23,8 +23,8 @@ func (h *NotificationHandler) GetNotification(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
 	}
 
-	if currentUser.Role != "customer" {
-		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	if currentUser.Role != "customer" && currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer and admin users can access this resource.")
 	}
 
 	result, err := h.service.GetNotificationUser(currentUser.ID)
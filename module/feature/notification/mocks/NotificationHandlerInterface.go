@@ -0,0 +1,45 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	fiber "github.com/gofiber/fiber/v2"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationHandlerInterface is an autogenerated mock type for the NotificationHandlerInterface type
+type NotificationHandlerInterface struct {
+	mock.Mock
+}
+
+// GetNotification provides a mock function with given fields: c
+func (_m *NotificationHandlerInterface) GetNotification(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationHandlerInterface creates a new instance of NotificationHandlerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationHandlerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationHandlerInterface {
+	mock := &NotificationHandlerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
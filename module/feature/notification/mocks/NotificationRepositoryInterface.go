@@ -0,0 +1,118 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepositoryInterface is an autogenerated mock type for the NotificationRepositoryInterface type
+type NotificationRepositoryInterface struct {
+	mock.Mock
+}
+
+// CreateNotification provides a mock function with given fields: notification
+func (_m *NotificationRepositoryInterface) CreateNotification(notification *entities.NotificationModels) (*entities.NotificationModels, error) {
+	ret := _m.Called(notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 *entities.NotificationModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.NotificationModels) (*entities.NotificationModels, error)); ok {
+		return rf(notification)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.NotificationModels) *entities.NotificationModels); ok {
+		r0 = rf(notification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NotificationModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.NotificationModels) error); ok {
+		r1 = rf(notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdminIDs provides a mock function with no fields
+func (_m *NotificationRepositoryInterface) GetAdminIDs() ([]uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdminIDs")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []uint64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationUser provides a mock function with given fields: userID
+func (_m *NotificationRepositoryInterface) GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationUser")
+	}
+
+	var r0 []*entities.NotificationModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.NotificationModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.NotificationModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NotificationModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationRepositoryInterface creates a new instance of NotificationRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepositoryInterface {
+	mock := &NotificationRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
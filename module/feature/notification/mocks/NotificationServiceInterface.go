@@ -0,0 +1,107 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/notification/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationServiceInterface is an autogenerated mock type for the NotificationServiceInterface type
+type NotificationServiceInterface struct {
+	mock.Mock
+}
+
+// CreateNotification provides a mock function with given fields: req
+func (_m *NotificationServiceInterface) CreateNotification(req *domain.CreateNotificationRequest) (*entities.NotificationModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 *entities.NotificationModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateNotificationRequest) (*entities.NotificationModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateNotificationRequest) *entities.NotificationModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NotificationModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateNotificationRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationUser provides a mock function with given fields: userID
+func (_m *NotificationServiceInterface) GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationUser")
+	}
+
+	var r0 []*entities.NotificationModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.NotificationModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.NotificationModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NotificationModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotifyAdmins provides a mock function with given fields: req
+func (_m *NotificationServiceInterface) NotifyAdmins(req *domain.CreateAdminNotificationRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyAdmins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateAdminNotificationRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationServiceInterface creates a new instance of NotificationServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationServiceInterface {
+	mock := &NotificationServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
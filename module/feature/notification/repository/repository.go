@@ -24,6 +24,16 @@ func (r *NotificationRepository) CreateNotification(notification *entities.Notif
 	return notification, nil
 }
 
+func (r *NotificationRepository) GetAdminIDs() ([]uint64, error) {
+	var adminIDs []uint64
+	if err := r.db.Model(&entities.UserModels{}).
+		Where("role = ? AND deleted_at IS NULL", "admin").
+		Pluck("id", &adminIDs).Error; err != nil {
+		return nil, err
+	}
+	return adminIDs, nil
+}
+
 func (r *NotificationRepository) GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error) {
 	var notify []*entities.NotificationModels
 	if err := r.db.Where("user_id = ? AND deleted_at IS NULL", userID).
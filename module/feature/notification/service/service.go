@@ -31,6 +31,28 @@ func (s *NotificationService) CreateNotification(req *domain.CreateNotificationR
 	return result, nil
 }
 
+// NotifyAdmins sends the same notification to every admin.
+func (s *NotificationService) NotifyAdmins(req *domain.CreateAdminNotificationRequest) error {
+	adminIDs, err := s.repo.GetAdminIDs()
+	if err != nil {
+		return err
+	}
+
+	for _, adminID := range adminIDs {
+		newData := &entities.NotificationModels{
+			UserID:    adminID,
+			OrderID:   req.OrderID,
+			Title:     req.Title,
+			Message:   req.Message,
+			CreatedAt: time.Now(),
+		}
+		if _, err := s.repo.CreateNotification(newData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *NotificationService) GetNotificationUser(userID uint64) ([]*entities.NotificationModels, error) {
 	result, err := s.repo.GetNotificationUser(userID)
 	if err != nil {
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/notification/domain"
+	"ruti-store/module/feature/notification/mocks"
+)
+
+func TestNotificationService_NotifyAdmins(t *testing.T) {
+	repo := mocks.NewNotificationRepositoryInterface(t)
+	service := NewNotificationService(repo)
+
+	req := &domain.CreateAdminNotificationRequest{Title: "Stok Menipis", Message: "Stok Kemeja Linen (M/Hitam) tinggal 2"}
+
+	t.Run("Success Case", func(t *testing.T) {
+		repo.On("GetAdminIDs").Return([]uint64{1, 4}, nil).Once()
+		for _, adminID := range []uint64{1, 4} {
+			adminID := adminID
+			repo.On("CreateNotification", mock.MatchedBy(func(n *entities.NotificationModels) bool {
+				return n.UserID == adminID && n.Title == req.Title && n.Message == req.Message
+			})).Return(&entities.NotificationModels{ID: adminID}, nil).Once()
+		}
+
+		err := service.NotifyAdmins(req)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Admins Not Found", func(t *testing.T) {
+		repo.On("GetAdminIDs").Return(nil, errors.New("connection refused")).Once()
+
+		err := service.NotifyAdmins(req)
+
+		assert.EqualError(t, err, "connection refused")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Create Notification Error", func(t *testing.T) {
+		repo.On("GetAdminIDs").Return([]uint64{1}, nil).Once()
+		repo.On("CreateNotification", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+		err := service.NotifyAdmins(req)
+
+		assert.EqualError(t, err, "connection refused")
+		repo.AssertExpectations(t)
+	})
+}
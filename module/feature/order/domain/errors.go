@@ -0,0 +1,17 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidOrderStatus  = errors.New("invalid order status")
+	ErrInvalidTransition   = errors.New("invalid order status transition")
+	ErrOrderNotOwned       = errors.New("order does not belong to this user")
+	ErrInvalidSignature    = errors.New("invalid payment notification signature")
+	ErrAmountMismatch      = errors.New("payment amount does not match the order total")
+	ErrInvalidReturn       = errors.New("invalid return request")
+	ErrReturnNotPending    = errors.New("return request is no longer pending")
+	ErrShippingNotFound    = errors.New("shipping service not available")
+	ErrCartNotOwned        = errors.New("cart item does not belong to this user")
+	ErrAddressNotOwned     = errors.New("address does not belong to this user")
+	ErrOrderNotCancellable = errors.New("order can no longer be cancelled")
+)
@@ -2,8 +2,10 @@ package domain
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/midtrans/midtrans-go/snap"
 	"ruti-store/module/entities"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/shipping"
+	"ruti-store/utils/tracking"
 	"time"
 )
 
@@ -11,16 +13,22 @@ type OrderRepositoryInterface interface {
 	GetTotalItems() (int64, error)
 	GetPaginatedOrders(page, pageSize int) ([]*entities.OrderModels, error)
 	CreateOrder(newOrder *entities.OrderModels) (*entities.OrderModels, error)
-	CreateSnap(orderID, name, email string, totalAmountPaid uint64) (*snap.Response, error)
+	CreatePayment(orderID, name, email string, totalAmountPaid uint64) (*payment.Charge, error)
 	CheckTransaction(orderID string) (Status, error)
 	GetOrderByID(orderID string) (*entities.OrderModels, error)
 	UpdatePayment(orderID, orderStatus, paymentStatus string) error
 	CreateCart(newCart *entities.CartModels) (*entities.CartModels, error)
-	GetCartItem(userID, productID uint64) (*entities.CartModels, error)
+	GetCartItem(userID, variantID uint64) (*entities.CartModels, error)
 	UpdateCartItem(cartItem *entities.CartModels) error
 	GetCartByID(cartID uint64) (*entities.CartModels, error)
 	DeleteCartItem(cartItemID uint64) error
 	GetCartByUserID(userID uint64) ([]*entities.CartModels, error)
+	CreateGuestCart(newCart *entities.GuestCartModels) (*entities.GuestCartModels, error)
+	GetGuestCartItem(guestID string, variantID uint64) (*entities.GuestCartModels, error)
+	UpdateGuestCartItem(cartItem *entities.GuestCartModels) error
+	DeleteGuestCartItem(cartItemID uint64) error
+	GetGuestCartByID(cartID uint64) (*entities.GuestCartModels, error)
+	GetCartByGuestID(guestID string) ([]*entities.GuestCartModels, error)
 	AcceptOrder(orderID, orderStatus string) error
 	UpdateOrderStatus(orderID, orderStatus string) error
 	GetAllOrdersByUserID(userID uint64, page, pageSize int) ([]*entities.OrderModels, int64, error)
@@ -32,6 +40,34 @@ type OrderRepositoryInterface interface {
 	GetAllPaymentFilterAndSearch(page, perPage int, name, filter string) ([]*entities.OrderModels, int64, error)
 	GetAllOrderFilter(page, perPage int, filter string) ([]*entities.OrderModels, int64, error)
 	GetAllOrderFilterAndSearch(page, perPage int, name, filter string) ([]*entities.OrderModels, int64, error)
+	LockOrder(orderID string) (*entities.OrderModels, error)
+	CreateStatusHistory(history *entities.OrderStatusHistoryModels) error
+	GetStatusHistory(orderID string) ([]*entities.OrderStatusHistoryModels, error)
+	ParseNotification(payload []byte) (*payment.Notification, error)
+	CreatePaymentEvent(event *entities.PaymentEventModels) error
+	CancelTransaction(orderID string) error
+	GetUnpaidOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error)
+	GetUncompletedOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error)
+	GetUnrefundedCancelledOrders(limit int) ([]*entities.OrderModels, error)
+	OpenReviews(orderID string, openedAt time.Time) error
+	RefundPayment(orderID, refundKey string, amount uint64, reason string) error
+	CreateReturn(newReturn *entities.ReturnRequestModels) (*entities.ReturnRequestModels, error)
+	GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error)
+	LockReturn(returnID uint64) (*entities.ReturnRequestModels, error)
+	UpdateReturn(returnRequest *entities.ReturnRequestModels) error
+	HasOpenReturn(orderID string) (bool, error)
+	CreateReturnPhoto(photo *entities.ReturnPhotoModels) (*entities.ReturnPhotoModels, error)
+	GetPaginatedReturns(page, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error)
+	GetReturnsByUserID(userID uint64, page, pageSize int) ([]*entities.ReturnRequestModels, int64, error)
+	AddReturnedQuantity(orderDetailID, quantity uint64) error
+	AddRefundedAmount(orderID string, amount uint64) error
+	GetShippingOptions(destination string, weight uint64, courier string) ([]shipping.ShippingOption, error)
+	TrackShipment(courier, airwayBill string) (*tracking.Result, error)
+	CreateShipment(shipment *entities.ShipmentModels) (*entities.ShipmentModels, error)
+	GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error)
+	UpdateShipment(shipment *entities.ShipmentModels) error
+	CreateShipmentEvents(events []*entities.ShipmentEventModels) (int64, error)
+	GetShipmentsInTransit(limit int) ([]*entities.ShipmentModels, error)
 }
 
 type OrderServiceInterface interface {
@@ -39,13 +75,21 @@ type OrderServiceInterface interface {
 	GetOrdersPage(currentPage, pageSize, totalItems int) (int, int, int, error)
 	CreateOrder(userID uint64, request *CreateOrderRequest) (*CreateOrderResponse, error)
 	GetOrderByID(orderID string) (*entities.OrderModels, error)
-	CallBack(req map[string]interface{}) error
+	CallBack(payload []byte) error
 	CreateCart(userID uint64, req *CreateCartRequest) (*entities.CartModels, error)
-	DeleteCartItems(cartID uint64) error
-	GetCartUser(userID uint64) ([]*entities.CartModels, error)
+	UpdateCartQuantity(userID, cartID uint64, req *UpdateCartRequest) (*entities.CartModels, error)
+	DeleteCartItems(userID, cartID uint64) error
+	GetCartUser(userID uint64) (*CartSummaryResponse, error)
+	CreateGuestCart(cartToken string, req *CreateCartRequest) (*entities.GuestCartModels, string, error)
+	UpdateGuestCartQuantity(cartToken string, cartID uint64, req *UpdateCartRequest) (*entities.GuestCartModels, error)
+	DeleteGuestCartItem(cartToken string, cartID uint64) error
+	GetGuestCart(cartToken string) (*CartSummaryResponse, error)
+	MergeGuestCart(userID uint64, cartToken string) error
+	Reorder(userID uint64, orderID string) (*ReorderResponse, error)
 	CreateOrderCart(userID uint64, request *CreateOrderCartRequest) (*CreateOrderResponse, error)
-	AcceptOrder(orderID string) error
-	UpdateOrderStatus(req *UpdateOrderStatus) error
+	AcceptOrder(userID uint64, orderID string) error
+	CancelOrder(userID uint64, req *CancelOrderRequest) error
+	UpdateOrderStatus(adminID uint64, req *UpdateOrderStatus) error
 	GetAllOrdersByUserID(userID uint64, page, pageSize int) ([]*entities.OrderModels, int64, error)
 	GetCartById(cartID uint64) (*entities.CartModels, error)
 	GetAllOrdersWithFilter(userID uint64, orderStatus string, page, pageSize int) ([]*entities.OrderModels, int64, error)
@@ -55,6 +99,21 @@ type OrderServiceInterface interface {
 	SearchFilterAndPaginatePayment(page, pageSize int, name, filter string) ([]*entities.OrderModels, int64, error)
 	FilterAndPaginateOrder(page, pageSize int, filter string) ([]*entities.OrderModels, int64, error)
 	SearchFilterAndPaginateOrder(page, pageSize int, name, filter string) ([]*entities.OrderModels, int64, error)
+	GetOrderTimeline(orderID string) ([]*entities.OrderStatusHistoryModels, error)
+	ExpireUnpaidOrders(ttl time.Duration) (int, error)
+	AutoCompleteOrders(after time.Duration) (int, error)
+	RetryCancelledRefunds() (int, error)
+	CreateReturn(userID uint64, req *CreateReturnRequest) (*entities.ReturnRequestModels, error)
+	CreateReturnPhoto(userID uint64, req *CreateReturnPhotoRequest) (*entities.ReturnPhotoModels, error)
+	GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error)
+	GetAllReturns(page, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error)
+	GetReturnsByUserID(userID uint64, page, pageSize int) ([]*entities.ReturnRequestModels, int64, error)
+	ApproveReturn(adminID, returnID uint64, req *ApproveReturnRequest) (*entities.ReturnRequestModels, error)
+	RejectReturn(adminID, returnID uint64, req *RejectReturnRequest) error
+	GetShippingOptions(userID uint64, req *ShippingOptionsRequest) (*ShippingOptionsResponse, error)
+	ShipOrder(adminID uint64, req *ShipOrderRequest) (*entities.ShipmentModels, error)
+	GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error)
+	TrackShipments() (int, error)
 }
 
 type OrderHandlerInterface interface {
@@ -63,13 +122,32 @@ type OrderHandlerInterface interface {
 	CreateOrder(c *fiber.Ctx) error
 	Callback(c *fiber.Ctx) error
 	CreateCart(c *fiber.Ctx) error
+	UpdateCart(c *fiber.Ctx) error
 	DeleteCart(c *fiber.Ctx) error
 	GetCartUser(c *fiber.Ctx) error
+	GetInvoice(c *fiber.Ctx) error
+	CreateGuestCart(c *fiber.Ctx) error
+	UpdateGuestCart(c *fiber.Ctx) error
+	DeleteGuestCart(c *fiber.Ctx) error
+	GetGuestCart(c *fiber.Ctx) error
+	Reorder(c *fiber.Ctx) error
 	CreateOrderCart(c *fiber.Ctx) error
 	AcceptOrder(c *fiber.Ctx) error
+	CancelOrder(c *fiber.Ctx) error
 	UpdateOrderStatus(c *fiber.Ctx) error
 	GetOrderByID(c *fiber.Ctx) error
 	GetOrderUser(c *fiber.Ctx) error
 	GetCartByID(c *fiber.Ctx) error
 	GetReportOrder(c *fiber.Ctx) error
+	GetOrderTimeline(c *fiber.Ctx) error
+	CreateReturn(c *fiber.Ctx) error
+	CreateReturnPhoto(c *fiber.Ctx) error
+	GetReturnByID(c *fiber.Ctx) error
+	GetAllReturns(c *fiber.Ctx) error
+	GetReturnsUser(c *fiber.Ctx) error
+	ApproveReturn(c *fiber.Ctx) error
+	RejectReturn(c *fiber.Ctx) error
+	GetShippingOptions(c *fiber.Ctx) error
+	ShipOrder(c *fiber.Ctx) error
+	GetShipment(c *fiber.Ctx) error
 }
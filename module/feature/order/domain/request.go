@@ -13,12 +13,16 @@ type CreatePaymentRequest struct {
 }
 
 type CreateOrderRequest struct {
-	AddressID uint64 `form:"address_id" json:"address_id" validate:"required"`
-	Size      string `form:"size" json:"size"`
-	Color     string `form:"color" json:"color"`
-	Note      string `form:"note" json:"note"`
-	ProductID uint64 `json:"product_id" validate:"required"`
-	Quantity  uint64 `json:"quantity" validate:"required"`
+	AddressID      uint64 `form:"address_id" json:"address_id" validate:"required"`
+	VariantID      uint64 `form:"variant_id" json:"variant_id"`
+	Size           string `form:"size" json:"size"`
+	Color          string `form:"color" json:"color"`
+	Note           string `form:"note" json:"note"`
+	ProductID      uint64 `json:"product_id" validate:"required"`
+	Quantity       uint64 `json:"quantity" validate:"required"`
+	Courier        string `json:"courier" validate:"required,oneof=jne pos tiki"`
+	CourierService string `json:"courier_service" validate:"required"`
+	VoucherCode    string `json:"voucher_code"`
 }
 
 type CreateNotificationOrderRequest struct {
@@ -39,15 +43,36 @@ type CreateNotificationPaymentRequest struct {
 
 type CreateCartRequest struct {
 	ProductID uint64 `json:"product_id" validate:"required"`
+	VariantID uint64 `form:"variant_id" json:"variant_id"`
 	Size      string `form:"size" json:"size"`
 	Color     string `form:"color" json:"color"`
 	Quantity  uint64 `json:"quantity" validate:"required,min=1"`
 }
 
+type UpdateCartRequest struct {
+	Quantity uint64 `json:"quantity" validate:"required,min=1"`
+}
+
 type CreateOrderCartRequest struct {
-	AddressID uint64            `form:"address_id" json:"address_id" validate:"required"`
-	Note      string            `form:"note" json:"note"`
-	CartItems []CartItemRequest `json:"cart_items" validate:"required"`
+	AddressID      uint64            `form:"address_id" json:"address_id" validate:"required"`
+	Note           string            `form:"note" json:"note"`
+	CartItems      []CartItemRequest `json:"cart_items" validate:"required"`
+	Courier        string            `json:"courier" validate:"required,oneof=jne pos tiki"`
+	CourierService string            `json:"courier_service" validate:"required"`
+	VoucherCode    string            `json:"voucher_code"`
+}
+
+// ShippingOptionsRequest asks for the shipping options of either the given cart items or a
+// single product bought directly.
+type ShippingOptionsRequest struct {
+	AddressID uint64            `json:"address_id" validate:"required"`
+	CartItems []CartItemRequest `json:"cart_items"`
+	ProductID uint64            `json:"product_id"`
+	VariantID uint64            `json:"variant_id"`
+	Size      string            `json:"size"`
+	Color     string            `json:"color"`
+	Quantity  uint64            `json:"quantity"`
+	Courier   string            `json:"courier" validate:"omitempty,oneof=jne pos tiki"`
 }
 
 type CartItemRequest struct {
@@ -57,4 +82,44 @@ type CartItemRequest struct {
 type UpdateOrderStatus struct {
 	ID          string `json:"id" validate:"required"`
 	OrderStatus string `json:"order_status" validate:"required"`
+	Note        string `json:"note"`
+}
+
+type CancelOrderRequest struct {
+	OrderID string `json:"order_id" validate:"required"`
+	Reason  string `json:"reason" validate:"required"`
+}
+
+type CreateReturnRequest struct {
+	OrderID string              `json:"order_id" validate:"required"`
+	Reason  string              `json:"reason" validate:"required"`
+	Items   []ReturnItemRequest `json:"items" validate:"required,min=1,dive"`
+}
+
+type ReturnItemRequest struct {
+	OrderDetailID uint64 `json:"order_detail_id" validate:"required"`
+	Quantity      uint64 `json:"quantity" validate:"required,min=1"`
+}
+
+type CreateReturnPhotoRequest struct {
+	ReturnID uint64 `form:"return_id" json:"return_id" validate:"required"`
+	Photo    string `form:"photo" json:"photo" validate:"required"`
+}
+
+type ApproveReturnRequest struct {
+	RefundAmount uint64 `json:"refund_amount"`
+	Restock      bool   `json:"restock"`
+	Note         string `json:"note"`
+}
+
+type RejectReturnRequest struct {
+	Note string `json:"note" validate:"required"`
+}
+
+type ShipOrderRequest struct {
+	OrderID    string `json:"order_id" validate:"required"`
+	AirwayBill string `json:"airway_bill" validate:"required"`
+	Courier    string `json:"courier" validate:"omitempty,oneof=jne pos tiki"`
+	Service    string `json:"service"`
+	Note       string `json:"note"`
 }
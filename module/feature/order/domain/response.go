@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"fmt"
 	"ruti-store/module/entities"
+	"ruti-store/utils/export"
+	"ruti-store/utils/shipping"
 	"time"
 )
 
@@ -14,9 +17,15 @@ type OrderResponse struct {
 	GrandTotalQuantity uint64                `json:"grand_total_quantity"`
 	GrandTotalPrice    uint64                `json:"grand_total_price"`
 	ShipmentFee        uint64                `json:"shipment_fee"`
+	Courier            string                `json:"courier"`
+	CourierService     string                `json:"courier_service"`
+	ShipmentETD        string                `json:"shipment_etd"`
 	AdminFees          uint64                `json:"admin_fees"`
 	GrandTotalDiscount uint64                `json:"grand_total_discount"`
+	VoucherCode        string                `json:"voucher_code"`
+	VoucherDiscount    uint64                `json:"voucher_discount"`
 	TotalAmountPaid    uint64                `json:"total_amount_paid"`
+	TotalRefunded      uint64                `json:"total_refunded"`
 	OrderStatus        string                `json:"order_status"`
 	PaymentStatus      string                `json:"payment_status"`
 	CreatedAt          time.Time             `json:"created_at"`
@@ -26,16 +35,18 @@ type OrderResponse struct {
 }
 
 type OrderDetailResponse struct {
-	ID            uint64          `json:"id"`
-	OrderID       string          `json:"order_id"`
-	ProductID     uint64          `json:"product_id"`
-	IsReviewed    bool            `json:"is_reviewed"`
-	Size          string          `json:"size"`
-	Color         string          `json:"color"`
-	Quantity      uint64          `json:"quantity"`
-	TotalPrice    uint64          `json:"total_price"`
-	TotalDiscount uint64          `json:"total_discount"`
-	Product       ProductResponse `json:"product,omitempty"`
+	ID               uint64          `json:"id"`
+	OrderID          string          `json:"order_id"`
+	ProductID        uint64          `json:"product_id"`
+	VariantID        uint64          `json:"variant_id"`
+	IsReviewed       bool            `json:"is_reviewed"`
+	Size             string          `json:"size"`
+	Color            string          `json:"color"`
+	Quantity         uint64          `json:"quantity"`
+	ReturnedQuantity uint64          `json:"returned_quantity"`
+	TotalPrice       uint64          `json:"total_price"`
+	TotalDiscount    uint64          `json:"total_discount"`
+	Product          ProductResponse `json:"product,omitempty"`
 }
 
 type ProductPhotoResponse struct {
@@ -81,9 +92,15 @@ func FormatOrderDetail(order *entities.OrderModels) OrderResponse {
 		GrandTotalQuantity: order.GrandTotalQuantity,
 		GrandTotalPrice:    order.GrandTotalPrice,
 		ShipmentFee:        order.ShipmentFee,
+		Courier:            order.Courier,
+		CourierService:     order.CourierService,
+		ShipmentETD:        order.ShipmentETD,
 		AdminFees:          order.AdminFees,
 		GrandTotalDiscount: order.GrandTotalDiscount,
+		VoucherCode:        order.VoucherCode,
+		VoucherDiscount:    order.VoucherDiscount,
 		TotalAmountPaid:    order.TotalAmountPaid,
+		TotalRefunded:      order.TotalRefunded,
 		OrderStatus:        order.OrderStatus,
 		PaymentStatus:      order.PaymentStatus,
 		CreatedAt:          order.CreatedAt,
@@ -118,15 +135,17 @@ func FormatOrderDetail(order *entities.OrderModels) OrderResponse {
 		}
 
 		orderDetail := OrderDetailResponse{
-			ID:            detail.ID,
-			OrderID:       detail.OrderID,
-			ProductID:     detail.ProductID,
-			IsReviewed:    detail.IsReviewed,
-			Size:          detail.Size,
-			Color:         detail.Color,
-			Quantity:      detail.Quantity,
-			TotalPrice:    detail.TotalPrice,
-			TotalDiscount: detail.TotalDiscount,
+			ID:               detail.ID,
+			OrderID:          detail.OrderID,
+			ProductID:        detail.ProductID,
+			VariantID:        detail.VariantID,
+			IsReviewed:       detail.IsReviewed,
+			Size:             detail.Size,
+			Color:            detail.Color,
+			Quantity:         detail.Quantity,
+			ReturnedQuantity: detail.ReturnedQuantity,
+			TotalPrice:       detail.TotalPrice,
+			TotalDiscount:    detail.TotalDiscount,
 			Product: ProductResponse{
 				ID:            detail.Product.ID,
 				Name:          detail.Product.Name,
@@ -253,16 +272,26 @@ type CreateOrderResponse struct {
 	OrderID         string `json:"order_id"`
 	IdOrder         string `json:"id_order"`
 	RedirectURL     string `json:"redirect_url"`
+	ShipmentFee     uint64 `json:"shipment_fee"`
+	VoucherDiscount uint64 `json:"voucher_discount"`
 	TotalAmountPaid uint64 `json:"total_amount_paid"`
 }
 
+type ShippingOptionsResponse struct {
+	Destination string                    `json:"destination"`
+	TotalWeight uint64                    `json:"total_weight"`
+	Options     []shipping.ShippingOption `json:"options"`
+}
+
 type CreateCartResponse struct {
 	ID        uint64 `json:"id"`
 	UserID    uint64 `json:"user_id"`
 	ProductID uint64 `json:"product_id"`
+	VariantID uint64 `json:"variant_id"`
 	Size      string `json:"size"`
 	Color     string `json:"color"`
 	Quantity  uint64 `json:"quantity"`
+	Price     uint64 `json:"price"`
 }
 
 func CreateCartFormatter(cart *entities.CartModels) *CreateCartResponse {
@@ -270,9 +299,35 @@ func CreateCartFormatter(cart *entities.CartModels) *CreateCartResponse {
 		ID:        cart.ID,
 		UserID:    cart.UserID,
 		ProductID: cart.ProductID,
+		VariantID: cart.VariantID,
 		Size:      cart.Size,
 		Color:     cart.Color,
 		Quantity:  cart.Quantity,
+		Price:     cart.Price,
+	}
+}
+
+type GuestCartResponse struct {
+	ID        uint64 `json:"id"`
+	ProductID uint64 `json:"product_id"`
+	VariantID uint64 `json:"variant_id"`
+	Size      string `json:"size"`
+	Color     string `json:"color"`
+	Quantity  uint64 `json:"quantity"`
+	Price     uint64 `json:"price"`
+	CartToken string `json:"cart_token"`
+}
+
+func GuestCartFormatter(cart *entities.GuestCartModels, cartToken string) *GuestCartResponse {
+	return &GuestCartResponse{
+		ID:        cart.ID,
+		ProductID: cart.ProductID,
+		VariantID: cart.VariantID,
+		Size:      cart.Size,
+		Color:     cart.Color,
+		Quantity:  cart.Quantity,
+		Price:     cart.Price,
+		CartToken: cartToken,
 	}
 }
 
@@ -280,12 +335,85 @@ type CartResponse struct {
 	ID        uint64           `json:"id"`
 	UserID    uint64           `json:"user_id"`
 	ProductID uint64           `json:"product_id"`
+	VariantID uint64           `json:"variant_id"`
 	Size      string           `json:"size"`
 	Color     string           `json:"color"`
 	Quantity  uint64           `json:"quantity"`
+	Price     uint64           `json:"price"`
 	Product   *ProductResponse `json:"product"`
 }
 
+// Issues a cart item can be flagged with when the cart is revalidated.
+const (
+	CartIssueInactive           = "inactive"
+	CartIssueVariantUnavailable = "variant_unavailable"
+	CartIssueOutOfStock         = "out_of_stock"
+	CartIssuePriceChanged       = "price_changed"
+)
+
+// CartItemResponse is a cart item checked against the current product, stock and price.
+// SnapshotPrice is the unit price when the item was added and Price the unit price now.
+type CartItemResponse struct {
+	ID            uint64           `json:"id"`
+	ProductID     uint64           `json:"product_id"`
+	VariantID     uint64           `json:"variant_id"`
+	Size          string           `json:"size"`
+	Color         string           `json:"color"`
+	Quantity      uint64           `json:"quantity"`
+	Stock         uint64           `json:"stock"`
+	SnapshotPrice uint64           `json:"snapshot_price"`
+	Price         uint64           `json:"price"`
+	Subtotal      uint64           `json:"subtotal"`
+	Discount      uint64           `json:"discount"`
+	Weight        uint64           `json:"weight"`
+	Available     bool             `json:"available"`
+	Issues        []string         `json:"issues"`
+	Product       *ProductResponse `json:"product"`
+}
+
+// ReorderItemResponse is an order line Reorder copied into the cart, or skipped. Quantity is how
+// many units were added, which is less than Requested when the stock ran short.
+type ReorderItemResponse struct {
+	ProductID uint64 `json:"product_id"`
+	VariantID uint64 `json:"variant_id"`
+	Name      string `json:"name"`
+	Size      string `json:"size"`
+	Color     string `json:"color"`
+	Requested uint64 `json:"requested"`
+	Quantity  uint64 `json:"quantity"`
+	Issue     string `json:"issue,omitempty"`
+}
+
+type ReorderResponse struct {
+	OrderID string                 `json:"order_id"`
+	Added   []*ReorderItemResponse `json:"added"`
+	Skipped []*ReorderItemResponse `json:"skipped"`
+}
+
+// CartSummaryResponse is a user's revalidated cart. The totals only count available items.
+type CartSummaryResponse struct {
+	Items         []*CartItemResponse `json:"items"`
+	TotalQuantity uint64              `json:"total_quantity"`
+	Subtotal      uint64              `json:"subtotal"`
+	TotalDiscount uint64              `json:"total_discount"`
+	TotalWeight   uint64              `json:"total_weight"`
+	HasIssues     bool                `json:"has_issues"`
+}
+
+func CartItemFormatter(cart *entities.CartModels) *CartItemResponse {
+	return &CartItemResponse{
+		ID:            cart.ID,
+		ProductID:     cart.ProductID,
+		VariantID:     cart.VariantID,
+		Size:          cart.Size,
+		Color:         cart.Color,
+		Quantity:      cart.Quantity,
+		SnapshotPrice: cart.Price,
+		Issues:        make([]string, 0),
+		Product:       buildProductResponse(&cart.Product),
+	}
+}
+
 func buildProductResponse(product *entities.ProductModels) *ProductResponse {
 	return &ProductResponse{
 		ID:            product.ID,
@@ -312,9 +440,11 @@ func CartFormatter(cart *entities.CartModels) *CartResponse {
 		ID:        cart.ID,
 		UserID:    cart.UserID,
 		ProductID: cart.ProductID,
+		VariantID: cart.VariantID,
 		Size:      cart.Size,
 		Color:     cart.Color,
 		Quantity:  cart.Quantity,
+		Price:     cart.Price,
 		Product:   buildProductResponse(&cart.Product),
 	}
 }
@@ -327,9 +457,11 @@ func ResponseArrayCart(data []*entities.CartModels) []*CartResponse {
 			ID:        cart.ID,
 			UserID:    cart.UserID,
 			ProductID: cart.ProductID,
+			VariantID: cart.VariantID,
 			Size:      cart.Size,
 			Color:     cart.Color,
 			Quantity:  cart.Quantity,
+			Price:     cart.Price,
 			Product:   buildProductResponse(&cart.Product),
 		}
 	}
@@ -374,15 +506,17 @@ func FormatGetAllOrderUser(order *entities.OrderModels) *GetAllOrderUserResponse
 		}
 
 		orderDetail := OrderDetailResponse{
-			ID:            detail.ID,
-			OrderID:       detail.OrderID,
-			ProductID:     detail.ProductID,
-			IsReviewed:    detail.IsReviewed,
-			Size:          detail.Size,
-			Color:         detail.Color,
-			Quantity:      detail.Quantity,
-			TotalPrice:    detail.TotalPrice,
-			TotalDiscount: detail.TotalDiscount,
+			ID:               detail.ID,
+			OrderID:          detail.OrderID,
+			ProductID:        detail.ProductID,
+			VariantID:        detail.VariantID,
+			IsReviewed:       detail.IsReviewed,
+			Size:             detail.Size,
+			Color:            detail.Color,
+			Quantity:         detail.Quantity,
+			ReturnedQuantity: detail.ReturnedQuantity,
+			TotalPrice:       detail.TotalPrice,
+			TotalDiscount:    detail.TotalDiscount,
 			Product: ProductResponse{
 				ID:            detail.Product.ID,
 				Name:          detail.Product.Name,
@@ -446,14 +580,16 @@ func getOrderDetailResponses(data []entities.OrderDetailsModels) []OrderDetailRe
 		productPhotos := buildProductPhotoResponses(detail.Product.Photos)
 
 		orderDetail := OrderDetailResponse{
-			ID:            detail.ID,
-			OrderID:       detail.OrderID,
-			ProductID:     detail.ProductID,
-			Size:          detail.Size,
-			Color:         detail.Color,
-			Quantity:      detail.Quantity,
-			TotalPrice:    detail.TotalPrice,
-			TotalDiscount: detail.TotalDiscount,
+			ID:               detail.ID,
+			OrderID:          detail.OrderID,
+			ProductID:        detail.ProductID,
+			VariantID:        detail.VariantID,
+			Size:             detail.Size,
+			Color:            detail.Color,
+			Quantity:         detail.Quantity,
+			ReturnedQuantity: detail.ReturnedQuantity,
+			TotalPrice:       detail.TotalPrice,
+			TotalDiscount:    detail.TotalDiscount,
 			Product: ProductResponse{
 				ID:            detail.Product.ID,
 				Name:          detail.Product.Name,
@@ -522,3 +658,227 @@ func ResponseArrayOrderReport(data []*entities.OrderModels) []*OrderReportRespon
 
 	return res
 }
+
+// OrderTimelineResponse Respon to Get Order Timeline
+type OrderTimelineResponse struct {
+	ID         uint64    `json:"id"`
+	OrderID    string    `json:"order_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    uint64    `json:"actor_id"`
+	ActorRole  string    `json:"actor_role"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func ResponseArrayOrderTimeline(data []*entities.OrderStatusHistoryModels) []*OrderTimelineResponse {
+	res := make([]*OrderTimelineResponse, 0)
+
+	for _, history := range data {
+		historyRes := &OrderTimelineResponse{
+			ID:         history.ID,
+			OrderID:    history.OrderID,
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			ActorID:    history.ActorID,
+			ActorRole:  history.ActorRole,
+			Note:       history.Note,
+			CreatedAt:  history.CreatedAt,
+		}
+		res = append(res, historyRes)
+	}
+
+	return res
+}
+
+// ReturnResponse Respon to Return Request
+type ReturnResponse struct {
+	ID           uint64                `json:"id"`
+	OrderID      string                `json:"order_id"`
+	IdOrder      string                `json:"id_order"`
+	UserID       uint64                `json:"user_id"`
+	Name         string                `json:"name"`
+	Reason       string                `json:"reason"`
+	Status       string                `json:"status"`
+	AdminNote    string                `json:"admin_note"`
+	Restock      bool                  `json:"restock"`
+	RefundAmount uint64                `json:"refund_amount"`
+	RefundError  string                `json:"refund_error"`
+	RefundedAt   *time.Time            `json:"refunded_at"`
+	CreatedAt    time.Time             `json:"created_at"`
+	Items        []ReturnItemResponse  `json:"items"`
+	Photos       []ReturnPhotoResponse `json:"photos"`
+}
+
+type ReturnItemResponse struct {
+	ID            uint64          `json:"id"`
+	OrderDetailID uint64          `json:"order_detail_id"`
+	ProductID     uint64          `json:"product_id"`
+	Size          string          `json:"size"`
+	Color         string          `json:"color"`
+	Quantity      uint64          `json:"quantity"`
+	Amount        uint64          `json:"amount"`
+	Product       ProductResponse `json:"product"`
+}
+
+type ReturnPhotoResponse struct {
+	ID       uint64 `json:"id"`
+	ReturnID uint64 `json:"return_id"`
+	URL      string `json:"url"`
+}
+
+func FormatReturn(returnRequest *entities.ReturnRequestModels) *ReturnResponse {
+	returnResponse := &ReturnResponse{
+		ID:           returnRequest.ID,
+		OrderID:      returnRequest.OrderID,
+		IdOrder:      returnRequest.Order.IdOrder,
+		UserID:       returnRequest.UserID,
+		Name:         returnRequest.User.Name,
+		Reason:       returnRequest.Reason,
+		Status:       returnRequest.Status,
+		AdminNote:    returnRequest.AdminNote,
+		Restock:      returnRequest.Restock,
+		RefundAmount: returnRequest.RefundAmount,
+		RefundError:  returnRequest.RefundError,
+		RefundedAt:   returnRequest.RefundedAt,
+		CreatedAt:    returnRequest.CreatedAt,
+		Items:        make([]ReturnItemResponse, 0),
+		Photos:       make([]ReturnPhotoResponse, 0),
+	}
+
+	for _, item := range returnRequest.Items {
+		returnResponse.Items = append(returnResponse.Items, ReturnItemResponse{
+			ID:            item.ID,
+			OrderDetailID: item.OrderDetailID,
+			ProductID:     item.OrderDetail.ProductID,
+			Size:          item.OrderDetail.Size,
+			Color:         item.OrderDetail.Color,
+			Quantity:      item.Quantity,
+			Amount:        item.Amount,
+			Product: ProductResponse{
+				ID:            item.OrderDetail.Product.ID,
+				Name:          item.OrderDetail.Product.Name,
+				Price:         item.OrderDetail.Product.Price,
+				Discount:      item.OrderDetail.Product.Discount,
+				ProductPhotos: buildProductPhotoResponses(item.OrderDetail.Product.Photos),
+			},
+		})
+	}
+
+	for _, photo := range returnRequest.Photos {
+		returnResponse.Photos = append(returnResponse.Photos, ReturnPhotoResponse{
+			ID:       photo.ID,
+			ReturnID: photo.ReturnID,
+			URL:      photo.URL,
+		})
+	}
+
+	return returnResponse
+}
+
+func ResponseArrayReturn(data []*entities.ReturnRequestModels) []*ReturnResponse {
+	res := make([]*ReturnResponse, 0)
+
+	for _, returnRequest := range data {
+		res = append(res, FormatReturn(returnRequest))
+	}
+
+	return res
+}
+
+func FormatReturnPhoto(photo *entities.ReturnPhotoModels) *ReturnPhotoResponse {
+	return &ReturnPhotoResponse{
+		ID:       photo.ID,
+		ReturnID: photo.ReturnID,
+		URL:      photo.URL,
+	}
+}
+
+type ShipmentResponse struct {
+	ID            uint64                  `json:"id"`
+	OrderID       string                  `json:"order_id"`
+	Courier       string                  `json:"courier"`
+	Service       string                  `json:"service"`
+	AirwayBill    string                  `json:"airway_bill"`
+	Status        string                  `json:"status"`
+	Receiver      string                  `json:"receiver"`
+	ShippedAt     time.Time               `json:"shipped_at"`
+	DeliveredAt   *time.Time              `json:"delivered_at"`
+	LastCheckedAt *time.Time              `json:"last_checked_at"`
+	Events        []ShipmentEventResponse `json:"events"`
+}
+
+type ShipmentEventResponse struct {
+	Description string    `json:"description"`
+	Location    string    `json:"location"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+func FormatShipment(shipment *entities.ShipmentModels) *ShipmentResponse {
+	shipmentResponse := &ShipmentResponse{
+		ID:            shipment.ID,
+		OrderID:       shipment.OrderID,
+		Courier:       shipment.Courier,
+		Service:       shipment.Service,
+		AirwayBill:    shipment.AirwayBill,
+		Status:        shipment.Status,
+		Receiver:      shipment.Receiver,
+		ShippedAt:     shipment.ShippedAt,
+		DeliveredAt:   shipment.DeliveredAt,
+		LastCheckedAt: shipment.LastCheckedAt,
+		Events:        make([]ShipmentEventResponse, 0),
+	}
+
+	for _, event := range shipment.Events {
+		shipmentResponse.Events = append(shipmentResponse.Events, ShipmentEventResponse{
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  event.OccurredAt,
+		})
+	}
+
+	return shipmentResponse
+}
+
+// InvoiceFormatter builds the invoice of an order. The amounts are the ones stored on the order
+// at checkout, so the invoice doesn't change when product prices do.
+func InvoiceFormatter(order *entities.OrderModels, storeName, storeAddress string) *export.Invoice {
+	invoice := &export.Invoice{
+		Number:          order.IdOrder,
+		Date:            order.CreatedAt,
+		StoreName:       storeName,
+		StoreAddress:    storeAddress,
+		BuyerName:       order.User.Name,
+		BuyerEmail:      order.User.Email,
+		BuyerPhone:      order.User.Phone,
+		RecipientName:   order.Address.AcceptedName,
+		RecipientPhone:  order.Address.Phone,
+		ShippingAddress: fmt.Sprintf("%s, %s, %s", order.Address.Address, order.Address.CityName, order.Address.ProvinceName),
+		Courier:         fmt.Sprintf("%s %s", order.Courier, order.CourierService),
+		Items:           make([]export.InvoiceItem, 0, len(order.OrderDetails)),
+		Subtotal:        order.GrandTotalPrice,
+		Discount:        order.GrandTotalDiscount,
+		VoucherCode:     order.VoucherCode,
+		VoucherDiscount: order.VoucherDiscount,
+		ShipmentFee:     order.ShipmentFee,
+		AdminFee:        order.AdminFees,
+		TotalPaid:       order.TotalAmountPaid,
+		PaymentStatus:   order.PaymentStatus,
+		PaymentMethod:   order.PaymentMethod,
+	}
+
+	for _, detail := range order.OrderDetails {
+		item := export.InvoiceItem{
+			Name:     detail.Product.Name,
+			Variant:  fmt.Sprintf("%s / %s", detail.Size, detail.Color),
+			Quantity: detail.Quantity,
+			Discount: detail.TotalDiscount,
+			Total:    detail.TotalPrice,
+		}
+		if detail.Quantity > 0 {
+			item.UnitPrice = (detail.TotalPrice + detail.TotalDiscount) / detail.Quantity
+		}
+		invoice.Items = append(invoice.Items, item)
+	}
+	return invoice
+}
@@ -0,0 +1,125 @@
+package domain
+
+import "fmt"
+
+// OrderStatus is the lifecycle state of an order. The values are the Indonesian labels that
+// are stored in orders.order_status and shown to customers.
+type OrderStatus string
+
+const (
+	OrderStatusPending    OrderStatus = "Menunggu Konfirmasi"
+	OrderStatusPaid       OrderStatus = "Dibayar"
+	OrderStatusProcessing OrderStatus = "Proses"
+	OrderStatusShipped    OrderStatus = "Pengiriman"
+	OrderStatusDelivered  OrderStatus = "Terkirim"
+	OrderStatusCompleted  OrderStatus = "Selesai"
+	OrderStatusCancelled  OrderStatus = "Dibatalkan"
+	OrderStatusFailed     OrderStatus = "Gagal"
+	OrderStatusRefunded   OrderStatus = "Dikembalikan"
+)
+
+// PaymentStatus is the state of the payment of an order, stored in orders.payment_status.
+type PaymentStatus string
+
+const (
+	PaymentStatusPending  PaymentStatus = "Menunggu Konfirmasi"
+	PaymentStatusPaid     PaymentStatus = "Konfirmasi"
+	PaymentStatusFailed   PaymentStatus = "Gagal"
+	PaymentStatusRefunded PaymentStatus = "Dikembalikan"
+)
+
+const (
+	ActorRoleAdmin    = "admin"
+	ActorRoleCustomer = "customer"
+	ActorRoleSystem   = "system"
+)
+
+// orderTransitions lists the statuses an order may move to from each status. Statuses without
+// an entry are final.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:    {OrderStatusPaid, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusPaid:       {OrderStatusProcessing, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusProcessing: {OrderStatusShipped, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusShipped:    {OrderStatusDelivered, OrderStatusCompleted},
+	OrderStatusDelivered:  {OrderStatusCompleted, OrderStatusRefunded},
+	OrderStatusCompleted:  {OrderStatusRefunded},
+}
+
+// adminSettableStatuses are the statuses an admin may set by hand through UpdateOrderStatus.
+// The other statuses are driven by the payment gateway, the customer or the returns flow, and an
+// order is only shipped through ShipOrder, which records the airway bill tracking needs.
+var adminSettableStatuses = map[OrderStatus]bool{
+	OrderStatusProcessing: true,
+	OrderStatusDelivered:  true,
+	OrderStatusCompleted:  true,
+}
+
+func (s OrderStatus) IsValid() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusPaid, OrderStatusProcessing, OrderStatusShipped, OrderStatusDelivered,
+		OrderStatusCompleted, OrderStatusCancelled, OrderStatusFailed, OrderStatusRefunded:
+		return true
+	}
+	return false
+}
+
+func (s OrderStatus) IsFinal() bool {
+	return len(orderTransitions[s]) == 0
+}
+
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, status := range orderTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s OrderStatus) IsAdminSettable() bool {
+	return adminSettableStatuses[s]
+}
+
+// ValidateTransition returns an error wrapping ErrInvalidTransition when an order in status
+// from can't be moved to status to.
+func ValidateTransition(from, to OrderStatus) error {
+	if !to.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidOrderStatus, to)
+	}
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
+
+// Actor is whoever triggered an order status change.
+type Actor struct {
+	ID   uint64
+	Role string
+}
+
+var SystemActor = Actor{Role: ActorRoleSystem}
+
+// Outcomes recorded on payment_events for every payment notification delivery.
+const (
+	PaymentEventProcessed = "processed"
+	PaymentEventDuplicate = "duplicate"
+	PaymentEventIgnored   = "ignored"
+	PaymentEventRejected  = "rejected"
+	PaymentEventFailed    = "failed"
+)
+
+// ReturnStatus is the state of a customer's return request.
+type ReturnStatus string
+
+const (
+	ReturnStatusRequested ReturnStatus = "Diajukan"
+	ReturnStatusApproved  ReturnStatus = "Disetujui"
+	ReturnStatusRejected  ReturnStatus = "Ditolak"
+	ReturnStatusRefunded  ReturnStatus = "Dana Dikembalikan"
+)
+
+// IsReturnable reports whether items of an order in this status can be returned.
+func (s OrderStatus) IsReturnable() bool {
+	return s == OrderStatusDelivered || s == OrderStatusCompleted
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	flashsale "ruti-store/module/feature/flashsale/domain"
+	product "ruti-store/module/feature/product/domain"
+	voucher "ruti-store/module/feature/voucher/domain"
+)
+
+// UnitOfWorkInterface runs a function inside a single database transaction.
+// The repositories handed to fn are bound to that transaction, so everything
+// written through them is committed together or rolled back together.
+type UnitOfWorkInterface interface {
+	Transaction(fn func(uow *UnitOfWork) error) error
+}
+
+// UnitOfWork groups the repositories that take part in a transaction.
+type UnitOfWork struct {
+	OrderRepo     OrderRepositoryInterface
+	ProductRepo   product.ProductRepositoryInterface
+	VoucherRepo   voucher.VoucherRepositoryInterface
+	FlashSaleRepo flashsale.FlashSaleRepositoryInterface
+}
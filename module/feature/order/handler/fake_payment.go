@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/feature/order/domain"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/response"
+)
+
+// FakePaymentHandler settles, expires or denies payments made through the fake payment gateway,
+// so the whole checkout can be exercised locally without Midtrans. It is only registered when
+// the fake provider is configured.
+type FakePaymentHandler struct {
+	service domain.OrderServiceInterface
+	gateway *payment.FakeGateway
+}
+
+func NewFakePaymentHandler(service domain.OrderServiceInterface, gateway *payment.FakeGateway) *FakePaymentHandler {
+	return &FakePaymentHandler{
+		service: service,
+		gateway: gateway,
+	}
+}
+
+func (h *FakePaymentHandler) SimulatePayment(c *fiber.Ctx) error {
+	orderID := c.Params("id")
+	if orderID == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	var payload []byte
+	var err error
+	switch c.Params("action") {
+	case "settle":
+		payload, err = h.gateway.Settle(orderID)
+	case "expire":
+		payload, err = h.gateway.Expire(orderID)
+	case "deny":
+		payload, err = h.gateway.Deny(orderID)
+	default:
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Action must be settle, expire or deny.")
+	}
+	if errors.Is(err, payment.ErrTransactionNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+
+	if err := h.service.CallBack(payload); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Payment simulated successfully")
+}
@@ -1,25 +1,35 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"ruti-store/module/entities"
+	flashsale "ruti-store/module/feature/flashsale/domain"
 	"ruti-store/module/feature/order/domain"
+	product "ruti-store/module/feature/product/domain"
+	voucher "ruti-store/module/feature/voucher/domain"
 	"ruti-store/utils/export"
 	"ruti-store/utils/response"
+	"ruti-store/utils/shipping"
+	"ruti-store/utils/token"
 	"ruti-store/utils/validator"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type OrderHandler struct {
-	service domain.OrderServiceInterface
+	service      domain.OrderServiceInterface
+	storeName    string
+	storeAddress string
 }
 
-func NewOrderHandler(service domain.OrderServiceInterface) domain.OrderHandlerInterface {
+func NewOrderHandler(service domain.OrderServiceInterface, storeName, storeAddress string) domain.OrderHandlerInterface {
 	return &OrderHandler{
-		service: service,
+		service:      service,
+		storeName:    storeName,
+		storeAddress: storeAddress,
 	}
 }
 
@@ -143,6 +153,15 @@ func (h *OrderHandler) CreateOrder(c *fiber.Ctx) error {
 	}
 
 	result, err := h.service.CreateOrder(currentUser.ID, req)
+	if errors.Is(err, domain.ErrAddressNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) || errors.Is(err, flashsale.ErrQuotaExhausted) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if errors.Is(err, domain.ErrShippingNotFound) || errors.Is(err, shipping.ErrShippingUnavailable) || errors.Is(err, voucher.ErrInvalidVoucher) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
@@ -150,13 +169,13 @@ func (h *OrderHandler) CreateOrder(c *fiber.Ctx) error {
 }
 
 func (h *OrderHandler) Callback(c *fiber.Ctx) error {
-	var notificationPayload map[string]interface{}
-
-	if err := json.Unmarshal(c.Body(), &notificationPayload); err != nil {
-		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to parse notification payload: "+err.Error())
+	err := h.service.CallBack(c.Body())
+	if errors.Is(err, domain.ErrInvalidSignature) {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, err.Error())
+	}
+	if errors.Is(err, domain.ErrAmountMismatch) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
 	}
-
-	err := h.service.CallBack(notificationPayload)
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
@@ -184,12 +203,52 @@ func (h *OrderHandler) CreateCart(c *fiber.Ctx) error {
 	}
 
 	result, err := h.service.CreateCart(currentUser.ID, req)
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Cart created successfully", domain.CreateCartFormatter(result))
 }
 
+func (h *OrderHandler) UpdateCart(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	cartID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.UpdateCartRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateCartQuantity(currentUser.ID, cartID, req)
+	if errors.Is(err, domain.ErrCartNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Cart updated successfully", domain.CreateCartFormatter(result))
+}
+
 func (h *OrderHandler) DeleteCart(c *fiber.Ctx) error {
 	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
 	if !ok || currentUser == nil {
@@ -206,7 +265,10 @@ func (h *OrderHandler) DeleteCart(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
 	}
 
-	err = h.service.DeleteCartItems(cartID)
+	err = h.service.DeleteCartItems(currentUser.ID, cartID)
+	if errors.Is(err, domain.ErrCartNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
@@ -227,7 +289,95 @@ func (h *OrderHandler) GetCartUser(c *fiber.Ctx) error {
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
-	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved get cart", domain.ResponseArrayCart(result))
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved get cart", result)
+}
+
+// cartTokenHeader carries the cart token of a guest, returned when their first item is added.
+const cartTokenHeader = "X-Cart-Token"
+
+func (h *OrderHandler) CreateGuestCart(c *fiber.Ctx) error {
+	req := new(domain.CreateCartRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, cartToken, err := h.service.CreateGuestCart(c.Get(cartTokenHeader), req)
+	if errors.Is(err, token.ErrInvalidCartToken) {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: "+err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Cart created successfully", domain.GuestCartFormatter(result, cartToken))
+}
+
+func (h *OrderHandler) UpdateGuestCart(c *fiber.Ctx) error {
+	cartID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.UpdateCartRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	cartToken := c.Get(cartTokenHeader)
+	result, err := h.service.UpdateGuestCartQuantity(cartToken, cartID, req)
+	if errors.Is(err, token.ErrInvalidCartToken) {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrCartNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Cart updated successfully", domain.GuestCartFormatter(result, cartToken))
+}
+
+func (h *OrderHandler) DeleteGuestCart(c *fiber.Ctx) error {
+	cartID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	err = h.service.DeleteGuestCartItem(c.Get(cartTokenHeader), cartID)
+	if errors.Is(err, token.ErrInvalidCartToken) {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrCartNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Cart deleted successfully")
+}
+
+func (h *OrderHandler) GetGuestCart(c *fiber.Ctx) error {
+	result, err := h.service.GetGuestCart(c.Get(cartTokenHeader))
+	if errors.Is(err, token.ErrInvalidCartToken) {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: "+err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved get cart", result)
 }
 
 func (h *OrderHandler) CreateOrderCart(c *fiber.Ctx) error {
@@ -250,12 +400,53 @@ func (h *OrderHandler) CreateOrderCart(c *fiber.Ctx) error {
 	}
 
 	result, err := h.service.CreateOrderCart(currentUser.ID, req)
+	if errors.Is(err, domain.ErrCartNotOwned) || errors.Is(err, domain.ErrAddressNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) || errors.Is(err, flashsale.ErrQuotaExhausted) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if errors.Is(err, domain.ErrShippingNotFound) || errors.Is(err, shipping.ErrShippingUnavailable) || errors.Is(err, voucher.ErrInvalidVoucher) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Order created successfully", result)
 }
 
+func (h *OrderHandler) GetShippingOptions(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.ShippingOptionsRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.GetShippingOptions(currentUser.ID, req)
+	if errors.Is(err, domain.ErrCartNotOwned) || errors.Is(err, domain.ErrAddressNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, shipping.ErrShippingUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get shipping options", result)
+}
+
 func (h *OrderHandler) AcceptOrder(c *fiber.Ctx) error {
 	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
 	if !ok || currentUser == nil {
@@ -271,13 +462,76 @@ func (h *OrderHandler) AcceptOrder(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
 	}
 
-	err := h.service.AcceptOrder(orderID)
+	err := h.service.AcceptOrder(currentUser.ID, orderID)
+	if errors.Is(err, domain.ErrOrderNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Accept order successfully")
 }
 
+func (h *OrderHandler) Reorder(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	result, err := h.service.Reorder(currentUser.ID, orderID)
+	if errors.Is(err, domain.ErrOrderNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success reorder to cart", result)
+}
+
+func (h *OrderHandler) CancelOrder(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.CancelOrderRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	err := h.service.CancelOrder(currentUser.ID, req)
+	if errors.Is(err, domain.ErrOrderNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrOrderNotCancellable) || errors.Is(err, domain.ErrInvalidTransition) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Cancel order successfully")
+}
+
 func (h *OrderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
 	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
 	if !ok || currentUser == nil {
@@ -297,7 +551,13 @@ func (h *OrderHandler) UpdateOrderStatus(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
 	}
 
-	err := h.service.UpdateOrderStatus(req)
+	err := h.service.UpdateOrderStatus(currentUser.ID, req)
+	if errors.Is(err, domain.ErrInvalidOrderStatus) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
@@ -381,6 +641,9 @@ func (h *OrderHandler) GetCartByID(c *fiber.Ctx) error {
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
+	if result.UserID != currentUser.ID {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+domain.ErrCartNotOwned.Error())
+	}
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get cart by id", domain.CartFormatter(result))
 }
 
@@ -415,13 +678,14 @@ func (h *OrderHandler) GetReportOrder(c *fiber.Ctx) error {
 	headers := []string{
 		"ID Pesanan", "Nama", "Email",
 		"Catatan", "Total Kuantitas", "Total Harga",
-		"Total Diskon", "Total Bayar", "Status Pesanan",
+		"Total Diskon", "Total Bayar", "Total Refund",
+		"Pendapatan Bersih", "Status Pesanan",
 		"Status Pembayaran", "Dibuat Pada",
 	}
 
 	title := "Laporan Penjualan"
-	companyName := "Sander'Store, "
-	companyAddress := "Purbasari RT01/RW02"
+	companyName := h.storeName + ", "
+	companyAddress := h.storeAddress
 	dateRange := fmt.Sprintf("%s - %s", startDate.Format("2 January 2006"), endDate.Format("2 January 2006"))
 
 	var data [][]interface{}
@@ -435,6 +699,8 @@ func (h *OrderHandler) GetReportOrder(c *fiber.Ctx) error {
 			order.GrandTotalPrice,
 			order.GrandTotalDiscount,
 			order.TotalAmountPaid,
+			order.TotalRefunded,
+			order.TotalAmountPaid - order.TotalRefunded,
 			order.OrderStatus,
 			order.PaymentStatus,
 			order.CreatedAt.Format("2006-01-02 15:04:05"),
@@ -449,3 +715,114 @@ func (h *OrderHandler) GetReportOrder(c *fiber.Ctx) error {
 
 	return nil
 }
+
+func (h *OrderHandler) GetOrderTimeline(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	order, err := h.service.GetOrderByID(orderID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, "Order not found")
+	}
+
+	if currentUser.Role != "admin" && order.UserID != currentUser.ID {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: You don't have access to this order.")
+	}
+
+	result, err := h.service.GetOrderTimeline(order.ID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get order timeline", domain.ResponseArrayOrderTimeline(result))
+}
+
+func (h *OrderHandler) ShipOrder(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.ShipOrderRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.ShipOrder(currentUser.ID, req)
+	if errors.Is(err, domain.ErrInvalidTransition) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Ship order successfully", domain.FormatShipment(result))
+}
+
+func (h *OrderHandler) GetShipment(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	order, err := h.service.GetOrderByID(orderID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, "Order not found")
+	}
+
+	if currentUser.Role != "admin" && order.UserID != currentUser.ID {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: You don't have access to this order.")
+	}
+
+	result, err := h.service.GetShipmentByOrderID(order.ID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, "Shipment not found")
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get shipment", domain.FormatShipment(result))
+}
+
+func (h *OrderHandler) GetInvoice(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	orderID := c.Params("id")
+	if orderID == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	order, err := h.service.GetOrderByID(orderID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, "Order not found")
+	}
+
+	if currentUser.Role != "admin" && order.UserID != currentUser.ID {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: You don't have access to this order.")
+	}
+
+	invoice := domain.InvoiceFormatter(order, h.storeName, h.storeAddress)
+	fileName := fmt.Sprintf("Invoice_%s.pdf", strings.ReplaceAll(order.IdOrder, "/", "-"))
+	if err := export.ExportInvoicePdf(c, invoice, fileName); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"mime/multipart"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/order/domain"
+	"ruti-store/utils/response"
+	"ruti-store/utils/upload"
+	"ruti-store/utils/validator"
+	"strconv"
+)
+
+func (h *OrderHandler) CreateReturn(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.CreateReturnRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateReturn(currentUser.ID, req)
+	if err != nil {
+		return returnErrorResponse(c, err)
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create return request", domain.FormatReturn(result))
+}
+
+func (h *OrderHandler) CreateReturnPhoto(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.CreateReturnPhotoRequest)
+	file, err := c.FormFile("photo")
+	var uploadedURL string
+	if err == nil {
+		fileToUpload, err := file.Open()
+		if err != nil {
+			return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Error opening file: "+err.Error())
+		}
+		defer func(fileToUpload multipart.File) {
+			_ = fileToUpload.Close()
+		}(fileToUpload)
+
+		uploadedURL, err = upload.ImageUploadHelper(fileToUpload)
+		if err != nil {
+			return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Error uploading file: "+err.Error())
+		}
+	}
+
+	req.Photo = uploadedURL
+
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateReturnPhoto(currentUser.ID, req)
+	if err != nil {
+		return returnErrorResponse(c, err)
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create return photo", domain.FormatReturnPhoto(result))
+}
+
+func (h *OrderHandler) GetReturnByID(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	returnID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	result, err := h.service.GetReturnByID(returnID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, "Return request not found")
+	}
+
+	if currentUser.Role != "admin" && result.UserID != currentUser.ID {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: You don't have access to this return request.")
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get return request", domain.FormatReturn(result))
+}
+
+func (h *OrderHandler) GetAllReturns(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	result, totalItems, err := h.service.GetAllReturns(currentPage, pageSize, c.Query("status"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetOrdersPage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get pagination",
+		domain.ResponseArrayReturn(result), currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
+func (h *OrderHandler) GetReturnsUser(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	result, totalItems, err := h.service.GetReturnsByUserID(currentUser.ID, currentPage, pageSize)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetOrdersPage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get pagination",
+		domain.ResponseArrayReturn(result), currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
+func (h *OrderHandler) ApproveReturn(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	returnID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.ApproveReturnRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	result, err := h.service.ApproveReturn(currentUser.ID, returnID, req)
+	if err != nil {
+		return returnErrorResponse(c, err)
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success approve return request", domain.FormatReturn(result))
+}
+
+func (h *OrderHandler) RejectReturn(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	returnID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.RejectReturnRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.service.RejectReturn(currentUser.ID, returnID, req); err != nil {
+		return returnErrorResponse(c, err)
+	}
+
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success reject return request")
+}
+
+func returnErrorResponse(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotOwned):
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	case errors.Is(err, domain.ErrInvalidReturn):
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrReturnNotPending):
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	default:
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+}
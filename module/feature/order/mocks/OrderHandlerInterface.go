@@ -16,6 +16,28 @@ type OrderHandlerInterface struct {
 func (_m *OrderHandlerInterface) AcceptOrder(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApproveReturn provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) ApproveReturn(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveReturn")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -30,6 +52,28 @@ func (_m *OrderHandlerInterface) AcceptOrder(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) Callback(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Callback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelOrder provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) CancelOrder(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -44,6 +88,28 @@ func (_m *OrderHandlerInterface) Callback(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) CreateCart(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateGuestCart provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) CreateGuestCart(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuestCart")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -58,6 +124,10 @@ func (_m *OrderHandlerInterface) CreateCart(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) CreateOrder(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -72,6 +142,46 @@ func (_m *OrderHandlerInterface) CreateOrder(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) CreateOrderCart(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrderCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateReturn provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) CreateReturn(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateReturnPhoto provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) CreateReturnPhoto(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturnPhoto")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -86,6 +196,28 @@ func (_m *OrderHandlerInterface) CreateOrderCart(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) DeleteCart(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGuestCart provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) DeleteGuestCart(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGuestCart")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -100,6 +232,10 @@ func (_m *OrderHandlerInterface) DeleteCart(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetAllOrders(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -114,6 +250,28 @@ func (_m *OrderHandlerInterface) GetAllOrders(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetAllPayment(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllReturns provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetAllReturns(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllReturns")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -128,6 +286,10 @@ func (_m *OrderHandlerInterface) GetAllPayment(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetCartByID(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartByID")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -142,6 +304,46 @@ func (_m *OrderHandlerInterface) GetCartByID(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetCartUser(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetGuestCart provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetGuestCart(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuestCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetInvoice provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetInvoice(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvoice")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -156,6 +358,28 @@ func (_m *OrderHandlerInterface) GetCartUser(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetOrderByID(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetOrderTimeline provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetOrderTimeline(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderTimeline")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -170,6 +394,190 @@ func (_m *OrderHandlerInterface) GetOrderByID(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) GetOrderUser(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReportOrder provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetReportOrder(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReturnByID provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetReturnByID(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReturnsUser provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetReturnsUser(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnsUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetShipment provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetShipment(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetShippingOptions provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) GetShippingOptions(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShippingOptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectReturn provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) RejectReturn(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reorder provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) Reorder(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShipOrder provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) ShipOrder(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShipOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCart provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) UpdateCart(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
+		r0 = rf(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateGuestCart provides a mock function with given fields: c
+func (_m *OrderHandlerInterface) UpdateGuestCart(c *fiber.Ctx) error {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuestCart")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
@@ -184,6 +592,10 @@ func (_m *OrderHandlerInterface) GetOrderUser(c *fiber.Ctx) error {
 func (_m *OrderHandlerInterface) UpdateOrderStatus(c *fiber.Ctx) error {
 	ret := _m.Called(c)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*fiber.Ctx) error); ok {
 		r0 = rf(c)
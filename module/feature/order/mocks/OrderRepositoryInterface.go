@@ -8,7 +8,13 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	snap "github.com/midtrans/midtrans-go/snap"
+	payment "ruti-store/utils/payment"
+
+	shipping "ruti-store/utils/shipping"
+
+	time "time"
+
+	tracking "ruti-store/utils/tracking"
 )
 
 // OrderRepositoryInterface is an autogenerated mock type for the OrderRepositoryInterface type
@@ -20,6 +26,10 @@ type OrderRepositoryInterface struct {
 func (_m *OrderRepositoryInterface) AcceptOrder(orderID string, orderStatus string) error {
 	ret := _m.Called(orderID, orderStatus)
 
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptOrder")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string) error); ok {
 		r0 = rf(orderID, orderStatus)
@@ -30,10 +40,68 @@ func (_m *OrderRepositoryInterface) AcceptOrder(orderID string, orderStatus stri
 	return r0
 }
 
+// AddRefundedAmount provides a mock function with given fields: orderID, amount
+func (_m *OrderRepositoryInterface) AddRefundedAmount(orderID string, amount uint64) error {
+	ret := _m.Called(orderID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRefundedAmount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64) error); ok {
+		r0 = rf(orderID, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddReturnedQuantity provides a mock function with given fields: orderDetailID, quantity
+func (_m *OrderRepositoryInterface) AddReturnedQuantity(orderDetailID uint64, quantity uint64) error {
+	ret := _m.Called(orderDetailID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReturnedQuantity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(orderDetailID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelTransaction provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) CancelTransaction(orderID string) error {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckTransaction provides a mock function with given fields: orderID
 func (_m *OrderRepositoryInterface) CheckTransaction(orderID string) (domain.Status, error) {
 	ret := _m.Called(orderID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CheckTransaction")
+	}
+
 	var r0 domain.Status
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) (domain.Status, error)); ok {
@@ -58,6 +126,10 @@ func (_m *OrderRepositoryInterface) CheckTransaction(orderID string) (domain.Sta
 func (_m *OrderRepositoryInterface) CreateCart(newCart *entities.CartModels) (*entities.CartModels, error) {
 	ret := _m.Called(newCart)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCart")
+	}
+
 	var r0 *entities.CartModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*entities.CartModels) (*entities.CartModels, error)); ok {
@@ -80,10 +152,44 @@ func (_m *OrderRepositoryInterface) CreateCart(newCart *entities.CartModels) (*e
 	return r0, r1
 }
 
+// CreateGuestCart provides a mock function with given fields: newCart
+func (_m *OrderRepositoryInterface) CreateGuestCart(newCart *entities.GuestCartModels) (*entities.GuestCartModels, error) {
+	ret := _m.Called(newCart)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuestCart")
+	}
+
+	var r0 *entities.GuestCartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.GuestCartModels) (*entities.GuestCartModels, error)); ok {
+		return rf(newCart)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.GuestCartModels) *entities.GuestCartModels); ok {
+		r0 = rf(newCart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.GuestCartModels) error); ok {
+		r1 = rf(newCart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOrder provides a mock function with given fields: newOrder
 func (_m *OrderRepositoryInterface) CreateOrder(newOrder *entities.OrderModels) (*entities.OrderModels, error) {
 	ret := _m.Called(newOrder)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 *entities.OrderModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*entities.OrderModels) (*entities.OrderModels, error)); ok {
@@ -106,20 +212,24 @@ func (_m *OrderRepositoryInterface) CreateOrder(newOrder *entities.OrderModels)
 	return r0, r1
 }
 
-// CreateSnap provides a mock function with given fields: orderID, name, email, totalAmountPaid
-func (_m *OrderRepositoryInterface) CreateSnap(orderID string, name string, email string, totalAmountPaid uint64) (*snap.Response, error) {
+// CreatePayment provides a mock function with given fields: orderID, name, email, totalAmountPaid
+func (_m *OrderRepositoryInterface) CreatePayment(orderID string, name string, email string, totalAmountPaid uint64) (*payment.Charge, error) {
 	ret := _m.Called(orderID, name, email, totalAmountPaid)
 
-	var r0 *snap.Response
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePayment")
+	}
+
+	var r0 *payment.Charge
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, string, uint64) (*snap.Response, error)); ok {
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64) (*payment.Charge, error)); ok {
 		return rf(orderID, name, email, totalAmountPaid)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, string, uint64) *snap.Response); ok {
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64) *payment.Charge); ok {
 		r0 = rf(orderID, name, email, totalAmountPaid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*snap.Response)
+			r0 = ret.Get(0).(*payment.Charge)
 		}
 	}
 
@@ -132,10 +242,186 @@ func (_m *OrderRepositoryInterface) CreateSnap(orderID string, name string, emai
 	return r0, r1
 }
 
+// CreatePaymentEvent provides a mock function with given fields: event
+func (_m *OrderRepositoryInterface) CreatePaymentEvent(event *entities.PaymentEventModels) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePaymentEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.PaymentEventModels) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateReturn provides a mock function with given fields: newReturn
+func (_m *OrderRepositoryInterface) CreateReturn(newReturn *entities.ReturnRequestModels) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(newReturn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturn")
+	}
+
+	var r0 *entities.ReturnRequestModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ReturnRequestModels) (*entities.ReturnRequestModels, error)); ok {
+		return rf(newReturn)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ReturnRequestModels) *entities.ReturnRequestModels); ok {
+		r0 = rf(newReturn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ReturnRequestModels) error); ok {
+		r1 = rf(newReturn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReturnPhoto provides a mock function with given fields: photo
+func (_m *OrderRepositoryInterface) CreateReturnPhoto(photo *entities.ReturnPhotoModels) (*entities.ReturnPhotoModels, error) {
+	ret := _m.Called(photo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturnPhoto")
+	}
+
+	var r0 *entities.ReturnPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ReturnPhotoModels) (*entities.ReturnPhotoModels, error)); ok {
+		return rf(photo)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ReturnPhotoModels) *entities.ReturnPhotoModels); ok {
+		r0 = rf(photo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ReturnPhotoModels) error); ok {
+		r1 = rf(photo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShipment provides a mock function with given fields: shipment
+func (_m *OrderRepositoryInterface) CreateShipment(shipment *entities.ShipmentModels) (*entities.ShipmentModels, error) {
+	ret := _m.Called(shipment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShipment")
+	}
+
+	var r0 *entities.ShipmentModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ShipmentModels) (*entities.ShipmentModels, error)); ok {
+		return rf(shipment)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ShipmentModels) *entities.ShipmentModels); ok {
+		r0 = rf(shipment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ShipmentModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ShipmentModels) error); ok {
+		r1 = rf(shipment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShipmentEvents provides a mock function with given fields: events
+func (_m *OrderRepositoryInterface) CreateShipmentEvents(events []*entities.ShipmentEventModels) (int64, error) {
+	ret := _m.Called(events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShipmentEvents")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]*entities.ShipmentEventModels) (int64, error)); ok {
+		return rf(events)
+	}
+	if rf, ok := ret.Get(0).(func([]*entities.ShipmentEventModels) int64); ok {
+		r0 = rf(events)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func([]*entities.ShipmentEventModels) error); ok {
+		r1 = rf(events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateStatusHistory provides a mock function with given fields: history
+func (_m *OrderRepositoryInterface) CreateStatusHistory(history *entities.OrderStatusHistoryModels) error {
+	ret := _m.Called(history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatusHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.OrderStatusHistoryModels) error); ok {
+		r0 = rf(history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCartItem provides a mock function with given fields: cartItemID
 func (_m *OrderRepositoryInterface) DeleteCartItem(cartItemID uint64) error {
 	ret := _m.Called(cartItemID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCartItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(cartItemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGuestCartItem provides a mock function with given fields: cartItemID
+func (_m *OrderRepositoryInterface) DeleteGuestCartItem(cartItemID uint64) error {
+	ret := _m.Called(cartItemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGuestCartItem")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(uint64) error); ok {
 		r0 = rf(cartItemID)
@@ -146,10 +432,88 @@ func (_m *OrderRepositoryInterface) DeleteCartItem(cartItemID uint64) error {
 	return r0
 }
 
+// GetAllOrderFilter provides a mock function with given fields: page, perPage, filter
+func (_m *OrderRepositoryInterface) GetAllOrderFilter(page int, perPage int, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, perPage, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrderFilter")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, perPage, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.OrderModels); ok {
+		r0 = rf(page, perPage, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, perPage, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, perPage, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllOrderFilterAndSearch provides a mock function with given fields: page, perPage, name, filter
+func (_m *OrderRepositoryInterface) GetAllOrderFilterAndSearch(page int, perPage int, name string, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, perPage, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrderFilterAndSearch")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, perPage, name, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []*entities.OrderModels); ok {
+		r0 = rf(page, perPage, name, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) int64); ok {
+		r1 = rf(page, perPage, name, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string, string) error); ok {
+		r2 = rf(page, perPage, name, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAllOrdersByUserID provides a mock function with given fields: userID, page, pageSize
 func (_m *OrderRepositoryInterface) GetAllOrdersByUserID(userID uint64, page int, pageSize int) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(userID, page, pageSize)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrdersByUserID")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -183,6 +547,10 @@ func (_m *OrderRepositoryInterface) GetAllOrdersByUserID(userID uint64, page int
 func (_m *OrderRepositoryInterface) GetAllOrdersSearch(page int, perPage int, name string) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(page, perPage, name)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrdersSearch")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -216,6 +584,10 @@ func (_m *OrderRepositoryInterface) GetAllOrdersSearch(page int, perPage int, na
 func (_m *OrderRepositoryInterface) GetAllOrdersUserWithFilter(userID uint64, orderStatus string, page int, pageSize int) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(userID, orderStatus, page, pageSize)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrdersUserWithFilter")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -245,51 +617,537 @@ func (_m *OrderRepositoryInterface) GetAllOrdersUserWithFilter(userID uint64, or
 	return r0, r1, r2
 }
 
-// GetCartByID provides a mock function with given fields: cartID
-func (_m *OrderRepositoryInterface) GetCartByID(cartID uint64) (*entities.CartModels, error) {
-	ret := _m.Called(cartID)
+// GetAllPaymentFilter provides a mock function with given fields: page, perPage, filter
+func (_m *OrderRepositoryInterface) GetAllPaymentFilter(page int, perPage int, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, perPage, filter)
 
-	var r0 *entities.CartModels
-	var r1 error
-	if rf, ok := ret.Get(0).(func(uint64) (*entities.CartModels, error)); ok {
-		return rf(cartID)
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPaymentFilter")
 	}
-	if rf, ok := ret.Get(0).(func(uint64) *entities.CartModels); ok {
-		r0 = rf(cartID)
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, perPage, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.OrderModels); ok {
+		r0 = rf(page, perPage, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.CartModels)
+			r0 = ret.Get(0).([]*entities.OrderModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint64) error); ok {
-		r1 = rf(cartID)
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, perPage, filter)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, perPage, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllPaymentFilterAndSearch provides a mock function with given fields: page, perPage, name, filter
+func (_m *OrderRepositoryInterface) GetAllPaymentFilterAndSearch(page int, perPage int, name string, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, perPage, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPaymentFilterAndSearch")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, perPage, name, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []*entities.OrderModels); ok {
+		r0 = rf(page, perPage, name, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) int64); ok {
+		r1 = rf(page, perPage, name, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string, string) error); ok {
+		r2 = rf(page, perPage, name, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCartByGuestID provides a mock function with given fields: guestID
+func (_m *OrderRepositoryInterface) GetCartByGuestID(guestID string) ([]*entities.GuestCartModels, error) {
+	ret := _m.Called(guestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartByGuestID")
+	}
+
+	var r0 []*entities.GuestCartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.GuestCartModels, error)); ok {
+		return rf(guestID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.GuestCartModels); ok {
+		r0 = rf(guestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(guestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCartByID provides a mock function with given fields: cartID
+func (_m *OrderRepositoryInterface) GetCartByID(cartID uint64) (*entities.CartModels, error) {
+	ret := _m.Called(cartID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartByID")
+	}
+
+	var r0 *entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.CartModels, error)); ok {
+		return rf(cartID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.CartModels); ok {
+		r0 = rf(cartID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(cartID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCartByUserID provides a mock function with given fields: userID
+func (_m *OrderRepositoryInterface) GetCartByUserID(userID uint64) ([]*entities.CartModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartByUserID")
+	}
+
+	var r0 []*entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.CartModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.CartModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCartItem provides a mock function with given fields: userID, variantID
+func (_m *OrderRepositoryInterface) GetCartItem(userID uint64, variantID uint64) (*entities.CartModels, error) {
+	ret := _m.Called(userID, variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartItem")
+	}
+
+	var r0 *entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) (*entities.CartModels, error)); ok {
+		return rf(userID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64) *entities.CartModels); ok {
+		r0 = rf(userID, variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(userID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGuestCartByID provides a mock function with given fields: cartID
+func (_m *OrderRepositoryInterface) GetGuestCartByID(cartID uint64) (*entities.GuestCartModels, error) {
+	ret := _m.Called(cartID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuestCartByID")
+	}
+
+	var r0 *entities.GuestCartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.GuestCartModels, error)); ok {
+		return rf(cartID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.GuestCartModels); ok {
+		r0 = rf(cartID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(cartID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGuestCartItem provides a mock function with given fields: guestID, variantID
+func (_m *OrderRepositoryInterface) GetGuestCartItem(guestID string, variantID uint64) (*entities.GuestCartModels, error) {
+	ret := _m.Called(guestID, variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuestCartItem")
+	}
+
+	var r0 *entities.GuestCartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint64) (*entities.GuestCartModels, error)); ok {
+		return rf(guestID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64) *entities.GuestCartModels); ok {
+		r0 = rf(guestID, variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64) error); ok {
+		r1 = rf(guestID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) GetOrderByID(orderID string) (*entities.OrderModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 *entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.OrderModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.OrderModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedOrders provides a mock function with given fields: page, pageSize
+func (_m *OrderRepositoryInterface) GetPaginatedOrders(page int, pageSize int) ([]*entities.OrderModels, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedOrders")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.OrderModels, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedReturns provides a mock function with given fields: page, pageSize, status
+func (_m *OrderRepositoryInterface) GetPaginatedReturns(page int, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error) {
+	ret := _m.Called(page, pageSize, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedReturns")
+	}
+
+	var r0 []*entities.ReturnRequestModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.ReturnRequestModels, int64, error)); ok {
+		return rf(page, pageSize, status)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.ReturnRequestModels); ok {
+		r0 = rf(page, pageSize, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, pageSize, status)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, pageSize, status)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetReportOrder provides a mock function with given fields: startDate, endDate
+func (_m *OrderRepositoryInterface) GetReportOrder(startDate time.Time, endDate time.Time) ([]*entities.OrderModels, error) {
+	ret := _m.Called(startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportOrder")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) ([]*entities.OrderModels, error)); ok {
+		return rf(startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []*entities.OrderModels); ok {
+		r0 = rf(startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time) error); ok {
+		r1 = rf(startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnByID provides a mock function with given fields: returnID
+func (_m *OrderRepositoryInterface) GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(returnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnByID")
+	}
+
+	var r0 *entities.ReturnRequestModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ReturnRequestModels, error)); ok {
+		return rf(returnID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ReturnRequestModels); ok {
+		r0 = rf(returnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(returnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnsByUserID provides a mock function with given fields: userID, page, pageSize
+func (_m *OrderRepositoryInterface) GetReturnsByUserID(userID uint64, page int, pageSize int) ([]*entities.ReturnRequestModels, int64, error) {
+	ret := _m.Called(userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnsByUserID")
+	}
+
+	var r0 []*entities.ReturnRequestModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.ReturnRequestModels, int64, error)); ok {
+		return rf(userID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.ReturnRequestModels); ok {
+		r0 = rf(userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int64); ok {
+		r1 = rf(userID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) error); ok {
+		r2 = rf(userID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetShipmentByOrderID provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipmentByOrderID")
+	}
+
+	var r0 *entities.ShipmentModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.ShipmentModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.ShipmentModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ShipmentModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShipmentsInTransit provides a mock function with given fields: limit
+func (_m *OrderRepositoryInterface) GetShipmentsInTransit(limit int) ([]*entities.ShipmentModels, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipmentsInTransit")
+	}
+
+	var r0 []*entities.ShipmentModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*entities.ShipmentModels, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*entities.ShipmentModels); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ShipmentModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// GetCartByUserID provides a mock function with given fields: userID
-func (_m *OrderRepositoryInterface) GetCartByUserID(userID uint64) ([]*entities.CartModels, error) {
-	ret := _m.Called(userID)
+// GetShippingOptions provides a mock function with given fields: destination, weight, courier
+func (_m *OrderRepositoryInterface) GetShippingOptions(destination string, weight uint64, courier string) ([]shipping.ShippingOption, error) {
+	ret := _m.Called(destination, weight, courier)
 
-	var r0 []*entities.CartModels
+	if len(ret) == 0 {
+		panic("no return value specified for GetShippingOptions")
+	}
+
+	var r0 []shipping.ShippingOption
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.CartModels, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(string, uint64, string) ([]shipping.ShippingOption, error)); ok {
+		return rf(destination, weight, courier)
 	}
-	if rf, ok := ret.Get(0).(func(uint64) []*entities.CartModels); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(string, uint64, string) []shipping.ShippingOption); ok {
+		r0 = rf(destination, weight, courier)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entities.CartModels)
+			r0 = ret.Get(0).([]shipping.ShippingOption)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint64) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(string, uint64, string) error); ok {
+		r1 = rf(destination, weight, courier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -297,25 +1155,29 @@ func (_m *OrderRepositoryInterface) GetCartByUserID(userID uint64) ([]*entities.
 	return r0, r1
 }
 
-// GetCartItem provides a mock function with given fields: userID, productID
-func (_m *OrderRepositoryInterface) GetCartItem(userID uint64, productID uint64) (*entities.CartModels, error) {
-	ret := _m.Called(userID, productID)
+// GetStatusHistory provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) GetStatusHistory(orderID string) ([]*entities.OrderStatusHistoryModels, error) {
+	ret := _m.Called(orderID)
 
-	var r0 *entities.CartModels
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistory")
+	}
+
+	var r0 []*entities.OrderStatusHistoryModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint64, uint64) (*entities.CartModels, error)); ok {
-		return rf(userID, productID)
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.OrderStatusHistoryModels, error)); ok {
+		return rf(orderID)
 	}
-	if rf, ok := ret.Get(0).(func(uint64, uint64) *entities.CartModels); ok {
-		r0 = rf(userID, productID)
+	if rf, ok := ret.Get(0).(func(string) []*entities.OrderStatusHistoryModels); ok {
+		r0 = rf(orderID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.CartModels)
+			r0 = ret.Get(0).([]*entities.OrderStatusHistoryModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
-		r1 = rf(userID, productID)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -323,10 +1185,160 @@ func (_m *OrderRepositoryInterface) GetCartItem(userID uint64, productID uint64)
 	return r0, r1
 }
 
-// GetOrderByID provides a mock function with given fields: orderID
-func (_m *OrderRepositoryInterface) GetOrderByID(orderID string) (*entities.OrderModels, error) {
+// GetTotalItems provides a mock function with no fields
+func (_m *OrderRepositoryInterface) GetTotalItems() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalItems")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUncompletedOrdersBefore provides a mock function with given fields: cutoff, limit
+func (_m *OrderRepositoryInterface) GetUncompletedOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error) {
+	ret := _m.Called(cutoff, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncompletedOrdersBefore")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]*entities.OrderModels, error)); ok {
+		return rf(cutoff, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []*entities.OrderModels); ok {
+		r0 = rf(cutoff, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(cutoff, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnpaidOrdersBefore provides a mock function with given fields: cutoff, limit
+func (_m *OrderRepositoryInterface) GetUnpaidOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error) {
+	ret := _m.Called(cutoff, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnpaidOrdersBefore")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]*entities.OrderModels, error)); ok {
+		return rf(cutoff, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []*entities.OrderModels); ok {
+		r0 = rf(cutoff, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(cutoff, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnrefundedCancelledOrders provides a mock function with given fields: limit
+func (_m *OrderRepositoryInterface) GetUnrefundedCancelledOrders(limit int) ([]*entities.OrderModels, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnrefundedCancelledOrders")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*entities.OrderModels, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*entities.OrderModels); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasOpenReturn provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) HasOpenReturn(orderID string) (bool, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasOpenReturn")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockOrder provides a mock function with given fields: orderID
+func (_m *OrderRepositoryInterface) LockOrder(orderID string) (*entities.OrderModels, error) {
 	ret := _m.Called(orderID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for LockOrder")
+	}
+
 	var r0 *entities.OrderModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) (*entities.OrderModels, error)); ok {
@@ -349,25 +1361,29 @@ func (_m *OrderRepositoryInterface) GetOrderByID(orderID string) (*entities.Orde
 	return r0, r1
 }
 
-// GetPaginatedOrders provides a mock function with given fields: page, pageSize
-func (_m *OrderRepositoryInterface) GetPaginatedOrders(page int, pageSize int) ([]*entities.OrderModels, error) {
-	ret := _m.Called(page, pageSize)
+// LockReturn provides a mock function with given fields: returnID
+func (_m *OrderRepositoryInterface) LockReturn(returnID uint64) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(returnID)
 
-	var r0 []*entities.OrderModels
+	if len(ret) == 0 {
+		panic("no return value specified for LockReturn")
+	}
+
+	var r0 *entities.ReturnRequestModels
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.OrderModels, error)); ok {
-		return rf(page, pageSize)
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ReturnRequestModels, error)); ok {
+		return rf(returnID)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []*entities.OrderModels); ok {
-		r0 = rf(page, pageSize)
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ReturnRequestModels); ok {
+		r0 = rf(returnID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entities.OrderModels)
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, pageSize)
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(returnID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -375,23 +1391,47 @@ func (_m *OrderRepositoryInterface) GetPaginatedOrders(page int, pageSize int) (
 	return r0, r1
 }
 
-// GetTotalItems provides a mock function with given fields:
-func (_m *OrderRepositoryInterface) GetTotalItems() (int64, error) {
-	ret := _m.Called()
+// OpenReviews provides a mock function with given fields: orderID, openedAt
+func (_m *OrderRepositoryInterface) OpenReviews(orderID string, openedAt time.Time) error {
+	ret := _m.Called(orderID, openedAt)
 
-	var r0 int64
+	if len(ret) == 0 {
+		panic("no return value specified for OpenReviews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(orderID, openedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParseNotification provides a mock function with given fields: payload
+func (_m *OrderRepositoryInterface) ParseNotification(payload []byte) (*payment.Notification, error) {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseNotification")
+	}
+
+	var r0 *payment.Notification
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func([]byte) (*payment.Notification, error)); ok {
+		return rf(payload)
 	}
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func([]byte) *payment.Notification); ok {
+		r0 = rf(payload)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payment.Notification)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(payload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -399,10 +1439,32 @@ func (_m *OrderRepositoryInterface) GetTotalItems() (int64, error) {
 	return r0, r1
 }
 
+// RefundPayment provides a mock function with given fields: orderID, refundKey, amount, reason
+func (_m *OrderRepositoryInterface) RefundPayment(orderID string, refundKey string, amount uint64, reason string) error {
+	ret := _m.Called(orderID, refundKey, amount, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, uint64, string) error); ok {
+		r0 = rf(orderID, refundKey, amount, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveProductFromCart provides a mock function with given fields: userID, productID
 func (_m *OrderRepositoryInterface) RemoveProductFromCart(userID uint64, productID uint64) error {
 	ret := _m.Called(userID, productID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProductFromCart")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
 		r0 = rf(userID, productID)
@@ -413,10 +1475,44 @@ func (_m *OrderRepositoryInterface) RemoveProductFromCart(userID uint64, product
 	return r0
 }
 
+// TrackShipment provides a mock function with given fields: courier, airwayBill
+func (_m *OrderRepositoryInterface) TrackShipment(courier string, airwayBill string) (*tracking.Result, error) {
+	ret := _m.Called(courier, airwayBill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackShipment")
+	}
+
+	var r0 *tracking.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*tracking.Result, error)); ok {
+		return rf(courier, airwayBill)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *tracking.Result); ok {
+		r0 = rf(courier, airwayBill)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tracking.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(courier, airwayBill)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateCartItem provides a mock function with given fields: cartItem
 func (_m *OrderRepositoryInterface) UpdateCartItem(cartItem *entities.CartModels) error {
 	ret := _m.Called(cartItem)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCartItem")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*entities.CartModels) error); ok {
 		r0 = rf(cartItem)
@@ -427,10 +1523,32 @@ func (_m *OrderRepositoryInterface) UpdateCartItem(cartItem *entities.CartModels
 	return r0
 }
 
+// UpdateGuestCartItem provides a mock function with given fields: cartItem
+func (_m *OrderRepositoryInterface) UpdateGuestCartItem(cartItem *entities.GuestCartModels) error {
+	ret := _m.Called(cartItem)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuestCartItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.GuestCartModels) error); ok {
+		r0 = rf(cartItem)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateOrderStatus provides a mock function with given fields: orderID, orderStatus
 func (_m *OrderRepositoryInterface) UpdateOrderStatus(orderID string, orderStatus string) error {
 	ret := _m.Called(orderID, orderStatus)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string) error); ok {
 		r0 = rf(orderID, orderStatus)
@@ -445,6 +1563,10 @@ func (_m *OrderRepositoryInterface) UpdateOrderStatus(orderID string, orderStatu
 func (_m *OrderRepositoryInterface) UpdatePayment(orderID string, orderStatus string, paymentStatus string) error {
 	ret := _m.Called(orderID, orderStatus, paymentStatus)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayment")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
 		r0 = rf(orderID, orderStatus, paymentStatus)
@@ -455,6 +1577,42 @@ func (_m *OrderRepositoryInterface) UpdatePayment(orderID string, orderStatus st
 	return r0
 }
 
+// UpdateReturn provides a mock function with given fields: returnRequest
+func (_m *OrderRepositoryInterface) UpdateReturn(returnRequest *entities.ReturnRequestModels) error {
+	ret := _m.Called(returnRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.ReturnRequestModels) error); ok {
+		r0 = rf(returnRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateShipment provides a mock function with given fields: shipment
+func (_m *OrderRepositoryInterface) UpdateShipment(shipment *entities.ShipmentModels) error {
+	ret := _m.Called(shipment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateShipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.ShipmentModels) error); ok {
+		r0 = rf(shipment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOrderRepositoryInterface creates a new instance of OrderRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderRepositoryInterface(t interface {
@@ -7,6 +7,8 @@ import (
 	domain "ruti-store/module/feature/order/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderServiceInterface is an autogenerated mock type for the OrderServiceInterface type
@@ -14,13 +16,93 @@ type OrderServiceInterface struct {
 	mock.Mock
 }
 
-// AcceptOrder provides a mock function with given fields: orderID
-func (_m *OrderServiceInterface) AcceptOrder(orderID string) error {
-	ret := _m.Called(orderID)
+// AcceptOrder provides a mock function with given fields: userID, orderID
+func (_m *OrderServiceInterface) AcceptOrder(userID uint64, orderID string) error {
+	ret := _m.Called(userID, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptOrder")
+	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(orderID)
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(userID, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApproveReturn provides a mock function with given fields: adminID, returnID, req
+func (_m *OrderServiceInterface) ApproveReturn(adminID uint64, returnID uint64, req *domain.ApproveReturnRequest) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(adminID, returnID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveReturn")
+	}
+
+	var r0 *entities.ReturnRequestModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.ApproveReturnRequest) (*entities.ReturnRequestModels, error)); ok {
+		return rf(adminID, returnID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.ApproveReturnRequest) *entities.ReturnRequestModels); ok {
+		r0 = rf(adminID, returnID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, *domain.ApproveReturnRequest) error); ok {
+		r1 = rf(adminID, returnID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AutoCompleteOrders provides a mock function with given fields: after
+func (_m *OrderServiceInterface) AutoCompleteOrders(after time.Duration) (int, error) {
+	ret := _m.Called(after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoCompleteOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Duration) (int, error)); ok {
+		return rf(after)
+	}
+	if rf, ok := ret.Get(0).(func(time.Duration) int); ok {
+		r0 = rf(after)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Duration) error); ok {
+		r1 = rf(after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CallBack provides a mock function with given fields: payload
+func (_m *OrderServiceInterface) CallBack(payload []byte) error {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallBack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(payload)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,13 +110,17 @@ func (_m *OrderServiceInterface) AcceptOrder(orderID string) error {
 	return r0
 }
 
-// CallBack provides a mock function with given fields: req
-func (_m *OrderServiceInterface) CallBack(req map[string]interface{}) error {
-	ret := _m.Called(req)
+// CancelOrder provides a mock function with given fields: userID, req
+func (_m *OrderServiceInterface) CancelOrder(userID uint64, req *domain.CancelOrderRequest) error {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(map[string]interface{}) error); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CancelOrderRequest) error); ok {
+		r0 = rf(userID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,6 +132,10 @@ func (_m *OrderServiceInterface) CallBack(req map[string]interface{}) error {
 func (_m *OrderServiceInterface) CreateCart(userID uint64, req *domain.CreateCartRequest) (*entities.CartModels, error) {
 	ret := _m.Called(userID, req)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCart")
+	}
+
 	var r0 *entities.CartModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateCartRequest) (*entities.CartModels, error)); ok {
@@ -68,10 +158,51 @@ func (_m *OrderServiceInterface) CreateCart(userID uint64, req *domain.CreateCar
 	return r0, r1
 }
 
+// CreateGuestCart provides a mock function with given fields: cartToken, req
+func (_m *OrderServiceInterface) CreateGuestCart(cartToken string, req *domain.CreateCartRequest) (*entities.GuestCartModels, string, error) {
+	ret := _m.Called(cartToken, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuestCart")
+	}
+
+	var r0 *entities.GuestCartModels
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, *domain.CreateCartRequest) (*entities.GuestCartModels, string, error)); ok {
+		return rf(cartToken, req)
+	}
+	if rf, ok := ret.Get(0).(func(string, *domain.CreateCartRequest) *entities.GuestCartModels); ok {
+		r0 = rf(cartToken, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *domain.CreateCartRequest) string); ok {
+		r1 = rf(cartToken, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, *domain.CreateCartRequest) error); ok {
+		r2 = rf(cartToken, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CreateOrder provides a mock function with given fields: userID, request
 func (_m *OrderServiceInterface) CreateOrder(userID uint64, request *domain.CreateOrderRequest) (*domain.CreateOrderResponse, error) {
 	ret := _m.Called(userID, request)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
 	var r0 *domain.CreateOrderResponse
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateOrderRequest) (*domain.CreateOrderResponse, error)); ok {
@@ -98,6 +229,10 @@ func (_m *OrderServiceInterface) CreateOrder(userID uint64, request *domain.Crea
 func (_m *OrderServiceInterface) CreateOrderCart(userID uint64, request *domain.CreateOrderCartRequest) (*domain.CreateOrderResponse, error) {
 	ret := _m.Called(userID, request)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrderCart")
+	}
+
 	var r0 *domain.CreateOrderResponse
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateOrderCartRequest) (*domain.CreateOrderResponse, error)); ok {
@@ -120,13 +255,95 @@ func (_m *OrderServiceInterface) CreateOrderCart(userID uint64, request *domain.
 	return r0, r1
 }
 
-// DeleteCartItems provides a mock function with given fields: cartID
-func (_m *OrderServiceInterface) DeleteCartItems(cartID uint64) error {
-	ret := _m.Called(cartID)
+// CreateReturn provides a mock function with given fields: userID, req
+func (_m *OrderServiceInterface) CreateReturn(userID uint64, req *domain.CreateReturnRequest) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturn")
+	}
+
+	var r0 *entities.ReturnRequestModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReturnRequest) (*entities.ReturnRequestModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReturnRequest) *entities.ReturnRequestModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateReturnRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReturnPhoto provides a mock function with given fields: userID, req
+func (_m *OrderServiceInterface) CreateReturnPhoto(userID uint64, req *domain.CreateReturnPhotoRequest) (*entities.ReturnPhotoModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReturnPhoto")
+	}
+
+	var r0 *entities.ReturnPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReturnPhotoRequest) (*entities.ReturnPhotoModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReturnPhotoRequest) *entities.ReturnPhotoModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateReturnPhotoRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCartItems provides a mock function with given fields: userID, cartID
+func (_m *OrderServiceInterface) DeleteCartItems(userID uint64, cartID uint64) error {
+	ret := _m.Called(userID, cartID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCartItems")
+	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint64) error); ok {
-		r0 = rf(cartID)
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(userID, cartID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGuestCartItem provides a mock function with given fields: cartToken, cartID
+func (_m *OrderServiceInterface) DeleteGuestCartItem(cartToken string, cartID uint64) error {
+	ret := _m.Called(cartToken, cartID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGuestCartItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64) error); ok {
+		r0 = rf(cartToken, cartID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -134,10 +351,116 @@ func (_m *OrderServiceInterface) DeleteCartItems(cartID uint64) error {
 	return r0
 }
 
+// ExpireUnpaidOrders provides a mock function with given fields: ttl
+func (_m *OrderServiceInterface) ExpireUnpaidOrders(ttl time.Duration) (int, error) {
+	ret := _m.Called(ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireUnpaidOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Duration) (int, error)); ok {
+		return rf(ttl)
+	}
+	if rf, ok := ret.Get(0).(func(time.Duration) int); ok {
+		r0 = rf(ttl)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Duration) error); ok {
+		r1 = rf(ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilterAndPaginateOrder provides a mock function with given fields: page, pageSize, filter
+func (_m *OrderServiceInterface) FilterAndPaginateOrder(page int, pageSize int, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, pageSize, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterAndPaginateOrder")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, pageSize, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, pageSize, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, pageSize, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FilterAndPaginatePayment provides a mock function with given fields: page, pageSize, filter
+func (_m *OrderServiceInterface) FilterAndPaginatePayment(page int, pageSize int, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, pageSize, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterAndPaginatePayment")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, pageSize, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, pageSize, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, pageSize, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAllOrders provides a mock function with given fields: page, pageSize
 func (_m *OrderServiceInterface) GetAllOrders(page int, pageSize int) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(page, pageSize)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrders")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -171,6 +494,10 @@ func (_m *OrderServiceInterface) GetAllOrders(page int, pageSize int) ([]*entiti
 func (_m *OrderServiceInterface) GetAllOrdersByUserID(userID uint64, page int, pageSize int) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(userID, page, pageSize)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrdersByUserID")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -204,6 +531,10 @@ func (_m *OrderServiceInterface) GetAllOrdersByUserID(userID uint64, page int, p
 func (_m *OrderServiceInterface) GetAllOrdersWithFilter(userID uint64, orderStatus string, page int, pageSize int) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(userID, orderStatus, page, pageSize)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllOrdersWithFilter")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -233,10 +564,51 @@ func (_m *OrderServiceInterface) GetAllOrdersWithFilter(userID uint64, orderStat
 	return r0, r1, r2
 }
 
+// GetAllReturns provides a mock function with given fields: page, pageSize, status
+func (_m *OrderServiceInterface) GetAllReturns(page int, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error) {
+	ret := _m.Called(page, pageSize, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllReturns")
+	}
+
+	var r0 []*entities.ReturnRequestModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*entities.ReturnRequestModels, int64, error)); ok {
+		return rf(page, pageSize, status)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*entities.ReturnRequestModels); ok {
+		r0 = rf(page, pageSize, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) int64); ok {
+		r1 = rf(page, pageSize, status)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string) error); ok {
+		r2 = rf(page, pageSize, status)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetCartById provides a mock function with given fields: cartID
 func (_m *OrderServiceInterface) GetCartById(cartID uint64) (*entities.CartModels, error) {
 	ret := _m.Called(cartID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartById")
+	}
+
 	var r0 *entities.CartModels
 	var r1 error
 	if rf, ok := ret.Get(0).(func(uint64) (*entities.CartModels, error)); ok {
@@ -260,19 +632,23 @@ func (_m *OrderServiceInterface) GetCartById(cartID uint64) (*entities.CartModel
 }
 
 // GetCartUser provides a mock function with given fields: userID
-func (_m *OrderServiceInterface) GetCartUser(userID uint64) ([]*entities.CartModels, error) {
+func (_m *OrderServiceInterface) GetCartUser(userID uint64) (*domain.CartSummaryResponse, error) {
 	ret := _m.Called(userID)
 
-	var r0 []*entities.CartModels
+	if len(ret) == 0 {
+		panic("no return value specified for GetCartUser")
+	}
+
+	var r0 *domain.CartSummaryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.CartModels, error)); ok {
+	if rf, ok := ret.Get(0).(func(uint64) (*domain.CartSummaryResponse, error)); ok {
 		return rf(userID)
 	}
-	if rf, ok := ret.Get(0).(func(uint64) []*entities.CartModels); ok {
+	if rf, ok := ret.Get(0).(func(uint64) *domain.CartSummaryResponse); ok {
 		r0 = rf(userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entities.CartModels)
+			r0 = ret.Get(0).(*domain.CartSummaryResponse)
 		}
 	}
 
@@ -285,25 +661,89 @@ func (_m *OrderServiceInterface) GetCartUser(userID uint64) ([]*entities.CartMod
 	return r0, r1
 }
 
-// GetOrderByID provides a mock function with given fields: orderID
-func (_m *OrderServiceInterface) GetOrderByID(orderID string) (*entities.OrderModels, error) {
-	ret := _m.Called(orderID)
+// GetGuestCart provides a mock function with given fields: cartToken
+func (_m *OrderServiceInterface) GetGuestCart(cartToken string) (*domain.CartSummaryResponse, error) {
+	ret := _m.Called(cartToken)
 
-	var r0 *entities.OrderModels
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuestCart")
+	}
+
+	var r0 *domain.CartSummaryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*entities.OrderModels, error)); ok {
-		return rf(orderID)
+	if rf, ok := ret.Get(0).(func(string) (*domain.CartSummaryResponse, error)); ok {
+		return rf(cartToken)
 	}
-	if rf, ok := ret.Get(0).(func(string) *entities.OrderModels); ok {
-		r0 = rf(orderID)
+	if rf, ok := ret.Get(0).(func(string) *domain.CartSummaryResponse); ok {
+		r0 = rf(cartToken)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.OrderModels)
+			r0 = ret.Get(0).(*domain.CartSummaryResponse)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(orderID)
+		r1 = rf(cartToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: orderID
+func (_m *OrderServiceInterface) GetOrderByID(orderID string) (*entities.OrderModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 *entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.OrderModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.OrderModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderTimeline provides a mock function with given fields: orderID
+func (_m *OrderServiceInterface) GetOrderTimeline(orderID string) ([]*entities.OrderStatusHistoryModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderTimeline")
+	}
+
+	var r0 []*entities.OrderStatusHistoryModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.OrderStatusHistoryModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.OrderStatusHistoryModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderStatusHistoryModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -315,6 +755,10 @@ func (_m *OrderServiceInterface) GetOrderByID(orderID string) (*entities.OrderMo
 func (_m *OrderServiceInterface) GetOrdersPage(currentPage int, pageSize int, totalItems int) (int, int, int, error) {
 	ret := _m.Called(currentPage, pageSize, totalItems)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrdersPage")
+	}
+
 	var r0 int
 	var r1 int
 	var r2 int
@@ -349,10 +793,265 @@ func (_m *OrderServiceInterface) GetOrdersPage(currentPage int, pageSize int, to
 	return r0, r1, r2, r3
 }
 
+// GetReportOrder provides a mock function with given fields: starDate, endDate
+func (_m *OrderServiceInterface) GetReportOrder(starDate time.Time, endDate time.Time) ([]*entities.OrderModels, error) {
+	ret := _m.Called(starDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReportOrder")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) ([]*entities.OrderModels, error)); ok {
+		return rf(starDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []*entities.OrderModels); ok {
+		r0 = rf(starDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time) error); ok {
+		r1 = rf(starDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnByID provides a mock function with given fields: returnID
+func (_m *OrderServiceInterface) GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error) {
+	ret := _m.Called(returnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnByID")
+	}
+
+	var r0 *entities.ReturnRequestModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ReturnRequestModels, error)); ok {
+		return rf(returnID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ReturnRequestModels); ok {
+		r0 = rf(returnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(returnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReturnsByUserID provides a mock function with given fields: userID, page, pageSize
+func (_m *OrderServiceInterface) GetReturnsByUserID(userID uint64, page int, pageSize int) ([]*entities.ReturnRequestModels, int64, error) {
+	ret := _m.Called(userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReturnsByUserID")
+	}
+
+	var r0 []*entities.ReturnRequestModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.ReturnRequestModels, int64, error)); ok {
+		return rf(userID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.ReturnRequestModels); ok {
+		r0 = rf(userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReturnRequestModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int64); ok {
+		r1 = rf(userID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) error); ok {
+		r2 = rf(userID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetShipmentByOrderID provides a mock function with given fields: orderID
+func (_m *OrderServiceInterface) GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipmentByOrderID")
+	}
+
+	var r0 *entities.ShipmentModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.ShipmentModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.ShipmentModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ShipmentModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShippingOptions provides a mock function with given fields: userID, req
+func (_m *OrderServiceInterface) GetShippingOptions(userID uint64, req *domain.ShippingOptionsRequest) (*domain.ShippingOptionsResponse, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShippingOptions")
+	}
+
+	var r0 *domain.ShippingOptionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.ShippingOptionsRequest) (*domain.ShippingOptionsResponse, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.ShippingOptionsRequest) *domain.ShippingOptionsResponse); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ShippingOptionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.ShippingOptionsRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeGuestCart provides a mock function with given fields: userID, cartToken
+func (_m *OrderServiceInterface) MergeGuestCart(userID uint64, cartToken string) error {
+	ret := _m.Called(userID, cartToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeGuestCart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(userID, cartToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectReturn provides a mock function with given fields: adminID, returnID, req
+func (_m *OrderServiceInterface) RejectReturn(adminID uint64, returnID uint64, req *domain.RejectReturnRequest) error {
+	ret := _m.Called(adminID, returnID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectReturn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.RejectReturnRequest) error); ok {
+		r0 = rf(adminID, returnID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Reorder provides a mock function with given fields: userID, orderID
+func (_m *OrderServiceInterface) Reorder(userID uint64, orderID string) (*domain.ReorderResponse, error) {
+	ret := _m.Called(userID, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 *domain.ReorderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, string) (*domain.ReorderResponse, error)); ok {
+		return rf(userID, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, string) *domain.ReorderResponse); ok {
+		r0 = rf(userID, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReorderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, string) error); ok {
+		r1 = rf(userID, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryCancelledRefunds provides a mock function with no fields
+func (_m *OrderServiceInterface) RetryCancelledRefunds() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryCancelledRefunds")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchAndPaginateOrder provides a mock function with given fields: page, pageSize, name
 func (_m *OrderServiceInterface) SearchAndPaginateOrder(page int, pageSize int, name string) ([]*entities.OrderModels, int64, error) {
 	ret := _m.Called(page, pageSize, name)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAndPaginateOrder")
+	}
+
 	var r0 []*entities.OrderModels
 	var r1 int64
 	var r2 error
@@ -382,13 +1081,209 @@ func (_m *OrderServiceInterface) SearchAndPaginateOrder(page int, pageSize int,
 	return r0, r1, r2
 }
 
-// UpdateOrderStatus provides a mock function with given fields: req
-func (_m *OrderServiceInterface) UpdateOrderStatus(req *domain.UpdateOrderStatus) error {
-	ret := _m.Called(req)
+// SearchFilterAndPaginateOrder provides a mock function with given fields: page, pageSize, name, filter
+func (_m *OrderServiceInterface) SearchFilterAndPaginateOrder(page int, pageSize int, name string, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, pageSize, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchFilterAndPaginateOrder")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, pageSize, name, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize, name, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) int64); ok {
+		r1 = rf(page, pageSize, name, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string, string) error); ok {
+		r2 = rf(page, pageSize, name, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SearchFilterAndPaginatePayment provides a mock function with given fields: page, pageSize, name, filter
+func (_m *OrderServiceInterface) SearchFilterAndPaginatePayment(page int, pageSize int, name string, filter string) ([]*entities.OrderModels, int64, error) {
+	ret := _m.Called(page, pageSize, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchFilterAndPaginatePayment")
+	}
+
+	var r0 []*entities.OrderModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]*entities.OrderModels, int64, error)); ok {
+		return rf(page, pageSize, name, filter)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []*entities.OrderModels); ok {
+		r0 = rf(page, pageSize, name, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) int64); ok {
+		r1 = rf(page, pageSize, name, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, string, string) error); ok {
+		r2 = rf(page, pageSize, name, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ShipOrder provides a mock function with given fields: adminID, req
+func (_m *OrderServiceInterface) ShipOrder(adminID uint64, req *domain.ShipOrderRequest) (*entities.ShipmentModels, error) {
+	ret := _m.Called(adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShipOrder")
+	}
+
+	var r0 *entities.ShipmentModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.ShipOrderRequest) (*entities.ShipmentModels, error)); ok {
+		return rf(adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.ShipOrderRequest) *entities.ShipmentModels); ok {
+		r0 = rf(adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ShipmentModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.ShipOrderRequest) error); ok {
+		r1 = rf(adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackShipments provides a mock function with no fields
+func (_m *OrderServiceInterface) TrackShipments() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackShipments")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCartQuantity provides a mock function with given fields: userID, cartID, req
+func (_m *OrderServiceInterface) UpdateCartQuantity(userID uint64, cartID uint64, req *domain.UpdateCartRequest) (*entities.CartModels, error) {
+	ret := _m.Called(userID, cartID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCartQuantity")
+	}
+
+	var r0 *entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.UpdateCartRequest) (*entities.CartModels, error)); ok {
+		return rf(userID, cartID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.UpdateCartRequest) *entities.CartModels); ok {
+		r0 = rf(userID, cartID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, *domain.UpdateCartRequest) error); ok {
+		r1 = rf(userID, cartID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateGuestCartQuantity provides a mock function with given fields: cartToken, cartID, req
+func (_m *OrderServiceInterface) UpdateGuestCartQuantity(cartToken string, cartID uint64, req *domain.UpdateCartRequest) (*entities.GuestCartModels, error) {
+	ret := _m.Called(cartToken, cartID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuestCartQuantity")
+	}
+
+	var r0 *entities.GuestCartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint64, *domain.UpdateCartRequest) (*entities.GuestCartModels, error)); ok {
+		return rf(cartToken, cartID, req)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64, *domain.UpdateCartRequest) *entities.GuestCartModels); ok {
+		r0 = rf(cartToken, cartID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GuestCartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64, *domain.UpdateCartRequest) error); ok {
+		r1 = rf(cartToken, cartID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOrderStatus provides a mock function with given fields: adminID, req
+func (_m *OrderServiceInterface) UpdateOrderStatus(adminID uint64, req *domain.UpdateOrderStatus) error {
+	ret := _m.Called(adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrderStatus")
+	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.UpdateOrderStatus) error); ok {
-		r0 = rf(req)
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateOrderStatus) error); ok {
+		r0 = rf(adminID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,46 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "ruti-store/module/feature/order/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UnitOfWorkInterface is an autogenerated mock type for the UnitOfWorkInterface type
+type UnitOfWorkInterface struct {
+	mock.Mock
+}
+
+// Transaction provides a mock function with given fields: fn
+func (_m *UnitOfWorkInterface) Transaction(fn func(*domain.UnitOfWork) error) error {
+	ret := _m.Called(fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*domain.UnitOfWork) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUnitOfWorkInterface creates a new instance of UnitOfWorkInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUnitOfWorkInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UnitOfWorkInterface {
+	mock := &UnitOfWorkInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
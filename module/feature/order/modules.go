@@ -2,12 +2,15 @@ package order
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/midtrans/midtrans-go/coreapi"
-	"github.com/midtrans/midtrans-go/snap"
+	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/gorm"
+	"ruti-store/config"
 	address "ruti-store/module/feature/address/domain"
 	addressRepository "ruti-store/module/feature/address/repository"
 	addressService "ruti-store/module/feature/address/service"
+	flashsale "ruti-store/module/feature/flashsale/domain"
+	flashSaleRepository "ruti-store/module/feature/flashsale/repository"
+	flashSaleService "ruti-store/module/feature/flashsale/service"
 	"ruti-store/module/feature/middleware"
 	notification "ruti-store/module/feature/notification/domain"
 	notificationRepository "ruti-store/module/feature/notification/repository"
@@ -24,12 +27,17 @@ import (
 	userService "ruti-store/module/feature/user/service"
 	assistant "ruti-store/utils/assitant"
 	generator2 "ruti-store/utils/generator"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/scheduler"
 	"ruti-store/utils/shipping"
 	"ruti-store/utils/token"
+	"ruti-store/utils/tracking"
+	"time"
 )
 
 var (
 	orderRepo        domain.OrderRepositoryInterface
+	unitOfWork       domain.UnitOfWorkInterface
 	orderServ        domain.OrderServiceInterface
 	orderHand        domain.OrderHandlerInterface
 	productRepo      product.ProductRepositoryInterface
@@ -43,13 +51,17 @@ var (
 	notificationRepo notification.NotificationRepositoryInterface
 	notificationServ notification.NotificationServiceInterface
 	openAi           assistant.AssistantServiceInterface
+	tracker          tracking.TrackingProviderInterface
+	flashSaleRepo    flashsale.FlashSaleRepositoryInterface
+	flashSaleServ    flashsale.FlashSaleServiceInterface
+	cartToken        token.CartTokenInterface
+	fakePaymentHand  *handler.FakePaymentHandler
 )
 
-func InitializeOrder(db *gorm.DB, snapClient snap.Client, coreClient coreapi.Client) {
+func InitializeOrder(db *gorm.DB, paymentGateway payment.PaymentGatewayInterface, initConfig *config.Config) {
 	openAi = assistant.NewAssistantService()
 	productRepo = productRepository.NewProductRepository(db, openAi)
-	productServ = productService.NewProductService(productRepo)
-	uuidGenerator = generator2.NewGeneratorUUID(db)
+	uuidGenerator = generator2.NewGeneratorUUID(db, initConfig.OrderNumberFormat)
 	ship = shipping.NewShippingService()
 	addressRepo = addressRepository.NewAddressRepository(db, ship)
 	addressServ = addressService.NewAddressService(addressRepo)
@@ -57,10 +69,27 @@ func InitializeOrder(db *gorm.DB, snapClient snap.Client, coreClient coreapi.Cli
 	userServ = userService.NewUserService(userRepo)
 	notificationRepo = notificationRepository.NewNotificationRepository(db)
 	notificationServ = notificationService.NewNotificationService(notificationRepo)
+	productServ = productService.NewProductService(productRepo, notificationServ)
+	flashSaleRepo = flashSaleRepository.NewFlashSaleRepository(db)
+	flashSaleServ = flashSaleService.NewFlashSaleService(flashSaleRepo)
 
-	orderRepo = repository.NewOrderRepository(db, snapClient, coreClient)
-	orderServ = service.NewOrderService(orderRepo, uuidGenerator, productServ, addressServ, userServ, notificationServ)
-	orderHand = handler.NewOrderHandler(orderServ)
+	tracker = tracking.NewTrackingProvider(*initConfig)
+	cartToken = token.NewCartToken(initConfig.Secret)
+
+	orderRepo = repository.NewOrderRepository(db, paymentGateway, ship, tracker)
+	unitOfWork = repository.NewUnitOfWork(db, paymentGateway, ship, tracker, openAi)
+	orderServ = service.NewOrderService(orderRepo, unitOfWork, uuidGenerator, productServ, addressServ, userServ, notificationServ, flashSaleServ, cartToken)
+	orderHand = handler.NewOrderHandler(orderServ, initConfig.StoreName, initConfig.StoreAddress)
+
+	if fakeGateway, ok := paymentGateway.(*payment.FakeGateway); ok {
+		fakePaymentHand = handler.NewFakePaymentHandler(orderServ, fakeGateway)
+	}
+}
+
+// OrderService returns the order service for the modules that work with the cart, like auth,
+// which merges the cart of a guest into their user cart when they log in.
+func OrderService() domain.OrderServiceInterface {
+	return orderServ
 }
 
 func SetupOrderRoutes(app *fiber.App, jwt token.JWTInterface, userService user.UserServiceInterface) {
@@ -70,13 +99,82 @@ func SetupOrderRoutes(app *fiber.App, jwt token.JWTInterface, userService user.U
 	api.Post("/create", middleware.AuthMiddleware(jwt, userService), orderHand.CreateOrder)
 	api.Post("/callback", orderHand.Callback)
 	api.Post("/cart/create", middleware.AuthMiddleware(jwt, userService), orderHand.CreateCart)
+	api.Put("/cart/update/:id", middleware.AuthMiddleware(jwt, userService), orderHand.UpdateCart)
 	api.Delete("/cart/delete/:id", middleware.AuthMiddleware(jwt, userService), orderHand.DeleteCart)
 	api.Get("/cart/list", middleware.AuthMiddleware(jwt, userService), orderHand.GetCartUser)
+	api.Post("/guest-cart/create", orderHand.CreateGuestCart)
+	api.Put("/guest-cart/update/:id", orderHand.UpdateGuestCart)
+	api.Delete("/guest-cart/delete/:id", orderHand.DeleteGuestCart)
+	api.Get("/guest-cart/list", orderHand.GetGuestCart)
 	api.Post("/create/cart", middleware.AuthMiddleware(jwt, userService), orderHand.CreateOrderCart)
+	api.Post("/shipping/options", middleware.AuthMiddleware(jwt, userService), orderHand.GetShippingOptions)
 	api.Post("/accept/:id", middleware.AuthMiddleware(jwt, userService), orderHand.AcceptOrder)
+	api.Post("/cancel", middleware.AuthMiddleware(jwt, userService), orderHand.CancelOrder)
+	api.Post("/reorder/:id", middleware.AuthMiddleware(jwt, userService), orderHand.Reorder)
 	api.Put("/update-status", middleware.AuthMiddleware(jwt, userService), orderHand.UpdateOrderStatus)
 	api.Get("details/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetOrderByID)
 	api.Get("/user/list", middleware.AuthMiddleware(jwt, userService), orderHand.GetOrderUser)
 	api.Get("/cart/details/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetCartByID)
 	api.Get("/get-report-order", middleware.AuthMiddleware(jwt, userService), orderHand.GetReportOrder)
+	api.Get("/timeline/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetOrderTimeline)
+	api.Get("/invoice/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetInvoice)
+	api.Put("/ship", middleware.AuthMiddleware(jwt, userService), orderHand.ShipOrder)
+	api.Get("/shipment/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetShipment)
+	api.Post("/return/create", middleware.AuthMiddleware(jwt, userService), orderHand.CreateReturn)
+	api.Post("/return/photo", middleware.AuthMiddleware(jwt, userService), orderHand.CreateReturnPhoto)
+	api.Get("/return/list", middleware.AuthMiddleware(jwt, userService), orderHand.GetAllReturns)
+	api.Get("/return/user/list", middleware.AuthMiddleware(jwt, userService), orderHand.GetReturnsUser)
+	api.Get("/return/details/:id", middleware.AuthMiddleware(jwt, userService), orderHand.GetReturnByID)
+	api.Put("/return/approve/:id", middleware.AuthMiddleware(jwt, userService), orderHand.ApproveReturn)
+	api.Put("/return/reject/:id", middleware.AuthMiddleware(jwt, userService), orderHand.RejectReturn)
+	if fakePaymentHand != nil {
+		api.Post("/fake-payment/:id/:action", fakePaymentHand.SimulatePayment)
+	}
+}
+
+func SetupOrderJobs(jobs scheduler.SchedulerInterface, unpaidOrderTTL, autoCompleteAfter time.Duration) {
+	jobs.Register(scheduler.Job{
+		Name:     "order:expire-unpaid",
+		Interval: 5 * time.Minute,
+		Run: func() error {
+			expired, err := orderServ.ExpireUnpaidOrders(unpaidOrderTTL)
+			if expired > 0 {
+				log.Infof("expired %d unpaid orders", expired)
+			}
+			return err
+		},
+	})
+	jobs.Register(scheduler.Job{
+		Name:     "order:track-shipments",
+		Interval: 30 * time.Minute,
+		Run: func() error {
+			delivered, err := orderServ.TrackShipments()
+			if delivered > 0 {
+				log.Infof("%d shipments delivered", delivered)
+			}
+			return err
+		},
+	})
+	jobs.Register(scheduler.Job{
+		Name:     "order:retry-refunds",
+		Interval: 15 * time.Minute,
+		Run: func() error {
+			refunded, err := orderServ.RetryCancelledRefunds()
+			if refunded > 0 {
+				log.Infof("refunded %d cancelled orders", refunded)
+			}
+			return err
+		},
+	})
+	jobs.Register(scheduler.Job{
+		Name:     "order:auto-complete",
+		Interval: time.Hour,
+		Run: func() error {
+			completed, err := orderServ.AutoCompleteOrders(autoCompleteAfter)
+			if completed > 0 {
+				log.Infof("auto-completed %d orders", completed)
+			}
+			return err
+		},
+	})
 }
@@ -1,26 +1,30 @@
 package repository
 
 import (
-	"github.com/midtrans/midtrans-go/coreapi"
-	"github.com/midtrans/midtrans-go/snap"
+	"fmt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"ruti-store/module/entities"
 	"ruti-store/module/feature/order/domain"
 	"ruti-store/utils/payment"
+	"ruti-store/utils/shipping"
+	"ruti-store/utils/tracking"
 	"time"
 )
 
 type OrderRepository struct {
-	db   *gorm.DB
-	snap snap.Client
-	core coreapi.Client
+	db       *gorm.DB
+	gateway  payment.PaymentGatewayInterface
+	shipping shipping.ShippingServiceInterface
+	tracker  tracking.TrackingProviderInterface
 }
 
-func NewOrderRepository(db *gorm.DB, snap snap.Client, core coreapi.Client) domain.OrderRepositoryInterface {
+func NewOrderRepository(db *gorm.DB, gateway payment.PaymentGatewayInterface, shipping shipping.ShippingServiceInterface, tracker tracking.TrackingProviderInterface) domain.OrderRepositoryInterface {
 	return &OrderRepository{
-		db:   db,
-		snap: snap,
-		core: core,
+		db:       db,
+		gateway:  gateway,
+		shipping: shipping,
+		tracker:  tracker,
 	}
 }
 
@@ -51,9 +55,13 @@ func (r *OrderRepository) GetPaginatedOrders(page, pageSize int) ([]*entities.Or
 	return orders, nil
 }
 
-func (r *OrderRepository) CreateSnap(orderID, name, email string, totalAmountPaid uint64) (*snap.Response, error) {
-	snapClient := r.snap
-	result, err := payment.CreatePaymentRequest(snapClient, orderID, totalAmountPaid, name, email)
+func (r *OrderRepository) CreatePayment(orderID, name, email string, totalAmountPaid uint64) (*payment.Charge, error) {
+	result, err := r.gateway.CreateCharge(payment.ChargeRequest{
+		OrderID:       orderID,
+		Amount:        totalAmountPaid,
+		CustomerName:  name,
+		CustomerEmail: email,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -62,17 +70,25 @@ func (r *OrderRepository) CreateSnap(orderID, name, email string, totalAmountPai
 }
 
 func (r *OrderRepository) CheckTransaction(orderID string) (domain.Status, error) {
-	var status domain.Status
-	transactionStatusResp, err := r.core.CheckTransaction(orderID)
+	result, err := r.gateway.GetStatus(orderID)
 	if err != nil {
 		return domain.Status{}, err
-	} else {
-		if transactionStatusResp != nil {
-			status = payment.TransactionStatus(transactionStatusResp)
-			return status, nil
-		}
 	}
-	return domain.Status{}, err
+	return toOrderStatus(result.Status), nil
+}
+
+// toOrderStatus maps a gateway payment status to the order and payment status it leads to.
+// Statuses that don't settle the payment, such as a denied attempt, map to an empty Status.
+func toOrderStatus(status payment.Status) domain.Status {
+	switch status {
+	case payment.StatusPaid:
+		return domain.Status{PaymentStatus: string(domain.PaymentStatusPaid), OrderStatus: string(domain.OrderStatusPaid)}
+	case payment.StatusFailed:
+		return domain.Status{PaymentStatus: string(domain.PaymentStatusFailed), OrderStatus: string(domain.OrderStatusFailed)}
+	case payment.StatusPending:
+		return domain.Status{PaymentStatus: string(domain.PaymentStatusPending), OrderStatus: string(domain.OrderStatusPending)}
+	}
+	return domain.Status{}
 }
 
 func (r *OrderRepository) CreateOrder(newOrder *entities.OrderModels) (*entities.OrderModels, error) {
@@ -122,16 +138,16 @@ func (r *OrderRepository) CreateCart(newCart *entities.CartModels) (*entities.Ca
 	return newCart, nil
 }
 
-func (r *OrderRepository) GetCartItem(userID, productID uint64) (*entities.CartModels, error) {
+func (r *OrderRepository) GetCartItem(userID, variantID uint64) (*entities.CartModels, error) {
 	var cartItem *entities.CartModels
-	if err := r.db.Where("user_id = ? AND product_id = ?", userID, productID).First(&cartItem).Error; err != nil {
+	if err := r.db.Where("user_id = ? AND variant_id = ?", userID, variantID).First(&cartItem).Error; err != nil {
 		return nil, err
 	}
 	return cartItem, nil
 }
 
 func (r *OrderRepository) UpdateCartItem(cartItem *entities.CartModels) error {
-	if err := r.db.Save(&cartItem).Error; err != nil {
+	if err := r.db.Omit(clause.Associations).Save(cartItem).Error; err != nil {
 		return err
 	}
 	return nil
@@ -146,7 +162,8 @@ func (r *OrderRepository) DeleteCartItem(cartItemID uint64) error {
 
 func (r *OrderRepository) GetCartByID(cartID uint64) (*entities.CartModels, error) {
 	var carts *entities.CartModels
-	if err := r.db.Preload("Product.Photos").Where("id = ?", cartID).First(&carts).Error; err != nil {
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("id = ?", cartID).First(&carts).Error; err != nil {
 		return nil, err
 	}
 	return carts, nil
@@ -154,7 +171,55 @@ func (r *OrderRepository) GetCartByID(cartID uint64) (*entities.CartModels, erro
 
 func (r *OrderRepository) GetCartByUserID(userID uint64) ([]*entities.CartModels, error) {
 	var carts []*entities.CartModels
-	if err := r.db.Preload("Product.Photos").Where("user_id = ?", userID).Find(&carts).Error; err != nil {
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("user_id = ?", userID).Order("created_at DESC").Find(&carts).Error; err != nil {
+		return nil, err
+	}
+	return carts, nil
+}
+
+func (r *OrderRepository) CreateGuestCart(newCart *entities.GuestCartModels) (*entities.GuestCartModels, error) {
+	if err := r.db.Omit(clause.Associations).Create(newCart).Error; err != nil {
+		return nil, err
+	}
+	return newCart, nil
+}
+
+func (r *OrderRepository) GetGuestCartItem(guestID string, variantID uint64) (*entities.GuestCartModels, error) {
+	var cartItem *entities.GuestCartModels
+	if err := r.db.Where("guest_id = ? AND variant_id = ?", guestID, variantID).First(&cartItem).Error; err != nil {
+		return nil, err
+	}
+	return cartItem, nil
+}
+
+func (r *OrderRepository) UpdateGuestCartItem(cartItem *entities.GuestCartModels) error {
+	if err := r.db.Omit(clause.Associations).Save(cartItem).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) DeleteGuestCartItem(cartItemID uint64) error {
+	if err := r.db.Where("id = ?", cartItemID).Delete(&entities.GuestCartModels{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) GetGuestCartByID(cartID uint64) (*entities.GuestCartModels, error) {
+	var carts *entities.GuestCartModels
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("id = ?", cartID).First(&carts).Error; err != nil {
+		return nil, err
+	}
+	return carts, nil
+}
+
+func (r *OrderRepository) GetCartByGuestID(guestID string) ([]*entities.GuestCartModels, error) {
+	var carts []*entities.GuestCartModels
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("guest_id = ?", guestID).Order("created_at DESC").Find(&carts).Error; err != nil {
 		return nil, err
 	}
 	return carts, nil
@@ -344,3 +409,330 @@ func (r *OrderRepository) GetAllOrderFilterAndSearch(page, perPage int, name, fi
 
 	return orders, totalItems, nil
 }
+
+func (r *OrderRepository) LockOrder(orderID string) (*entities.OrderModels, error) {
+	var order entities.OrderModels
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND deleted_at IS NULL", orderID).
+		First(&order).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepository) CreateStatusHistory(history *entities.OrderStatusHistoryModels) error {
+	if err := r.db.Create(history).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) GetStatusHistory(orderID string) ([]*entities.OrderStatusHistoryModels, error) {
+	var histories []*entities.OrderStatusHistoryModels
+	if err := r.db.Where("order_id = ?", orderID).
+		Order("created_at ASC, id ASC").
+		Find(&histories).Error; err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
+
+func (r *OrderRepository) ParseNotification(payload []byte) (*payment.Notification, error) {
+	return r.gateway.ParseWebhook(payload)
+}
+
+func (r *OrderRepository) CreatePaymentEvent(event *entities.PaymentEventModels) error {
+	if err := r.db.Create(event).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) CancelTransaction(orderID string) error {
+	return r.gateway.Cancel(orderID)
+}
+
+func (r *OrderRepository) GetUnpaidOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error) {
+	var orders []*entities.OrderModels
+	if err := r.db.
+		Where("order_status = ? AND created_at < ? AND deleted_at IS NULL", domain.OrderStatusPending, cutoff).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetUncompletedOrdersBefore returns shipped and delivered orders that entered their current
+// status before cutoff, leaving out the ones with an open return request. Orders without a status
+// history fall back to when they were last updated.
+func (r *OrderRepository) GetUncompletedOrdersBefore(cutoff time.Time, limit int) ([]*entities.OrderModels, error) {
+	var orders []*entities.OrderModels
+	if err := r.db.
+		Where("order_status IN ? AND deleted_at IS NULL", []domain.OrderStatus{domain.OrderStatusShipped, domain.OrderStatusDelivered}).
+		Where("COALESCE((SELECT MAX(h.created_at) FROM order_status_history h WHERE h.order_id = orders.id AND h.to_status = orders.order_status), orders.updated_at) < ?", cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM return_requests r WHERE r.order_id = orders.id AND r.status IN ?)",
+			[]domain.ReturnStatus{domain.ReturnStatusRequested, domain.ReturnStatusApproved}).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetUnrefundedCancelledOrders returns cancelled orders that are still paid, because refunding
+// them failed when they were cancelled.
+func (r *OrderRepository) GetUnrefundedCancelledOrders(limit int) ([]*entities.OrderModels, error) {
+	var orders []*entities.OrderModels
+	if err := r.db.
+		Preload("User").
+		Where("order_status = ? AND payment_status = ? AND deleted_at IS NULL", domain.OrderStatusCancelled, domain.PaymentStatusPaid).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// OpenReviews lets the customer review the items of a completed order.
+func (r *OrderRepository) OpenReviews(orderID string, openedAt time.Time) error {
+	return r.db.Model(&entities.OrderDetailsModels{}).
+		Where("order_id = ? AND reviewable_at IS NULL", orderID).
+		Update("reviewable_at", openedAt).Error
+}
+
+func (r *OrderRepository) RefundPayment(orderID, refundKey string, amount uint64, reason string) error {
+	_, err := r.gateway.Refund(payment.RefundRequest{
+		OrderID:   orderID,
+		RefundKey: refundKey,
+		Amount:    amount,
+		Reason:    reason,
+	})
+	return err
+}
+
+func (r *OrderRepository) CreateReturn(newReturn *entities.ReturnRequestModels) (*entities.ReturnRequestModels, error) {
+	if err := r.db.Create(newReturn).Error; err != nil {
+		return nil, err
+	}
+	return newReturn, nil
+}
+
+func (r *OrderRepository) GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error) {
+	var returnRequest entities.ReturnRequestModels
+	if err := r.db.
+		Preload("Order").
+		Preload("User").
+		Preload("Items.OrderDetail.Product.Photos").
+		Preload("Photos").
+		Where("id = ?", returnID).
+		First(&returnRequest).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+func (r *OrderRepository) LockReturn(returnID uint64) (*entities.ReturnRequestModels, error) {
+	var returnRequest entities.ReturnRequestModels
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", returnID).
+		First(&returnRequest).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Preload("OrderDetail").Where("return_id = ?", returnID).Find(&returnRequest.Items).Error; err != nil {
+		return nil, err
+	}
+	return &returnRequest, nil
+}
+
+func (r *OrderRepository) UpdateReturn(returnRequest *entities.ReturnRequestModels) error {
+	if err := r.db.Model(returnRequest).Omit(clause.Associations).Updates(map[string]interface{}{
+		"status":        returnRequest.Status,
+		"admin_note":    returnRequest.AdminNote,
+		"reviewed_by":   returnRequest.ReviewedBy,
+		"restock":       returnRequest.Restock,
+		"refund_amount": returnRequest.RefundAmount,
+		"refund_key":    returnRequest.RefundKey,
+		"refund_error":  returnRequest.RefundError,
+		"refunded_at":   returnRequest.RefundedAt,
+		"updated_at":    time.Now(),
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) HasOpenReturn(orderID string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&entities.ReturnRequestModels{}).
+		Where("order_id = ? AND status IN ?", orderID, []domain.ReturnStatus{domain.ReturnStatusRequested, domain.ReturnStatusApproved}).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *OrderRepository) CreateReturnPhoto(photo *entities.ReturnPhotoModels) (*entities.ReturnPhotoModels, error) {
+	if err := r.db.Create(photo).Error; err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+func (r *OrderRepository) GetPaginatedReturns(page, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error) {
+	var returns []*entities.ReturnRequestModels
+	var totalItems int64
+
+	offset := (page - 1) * pageSize
+
+	query := r.db.Model(&entities.ReturnRequestModels{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.
+		Preload("Order").
+		Preload("User").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&returns).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return returns, totalItems, nil
+}
+
+func (r *OrderRepository) GetReturnsByUserID(userID uint64, page, pageSize int) ([]*entities.ReturnRequestModels, int64, error) {
+	var returns []*entities.ReturnRequestModels
+	var totalItems int64
+
+	offset := (page - 1) * pageSize
+
+	query := r.db.Model(&entities.ReturnRequestModels{}).Where("user_id = ?", userID)
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.
+		Preload("Order").
+		Preload("Items.OrderDetail.Product.Photos").
+		Preload("Photos").
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&returns).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return returns, totalItems, nil
+}
+
+func (r *OrderRepository) AddReturnedQuantity(orderDetailID, quantity uint64) error {
+	result := r.db.Model(&entities.OrderDetailsModels{}).
+		Where("id = ? AND quantity - returned_quantity >= ?", orderDetailID, quantity).
+		Update("returned_quantity", gorm.Expr("returned_quantity + ?", quantity))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: order detail %d has fewer than %d items left to return", domain.ErrInvalidReturn, orderDetailID, quantity)
+	}
+	return nil
+}
+
+func (r *OrderRepository) AddRefundedAmount(orderID string, amount uint64) error {
+	if err := r.db.Model(&entities.OrderModels{}).
+		Where("id = ?", orderID).
+		Update("total_refunded", gorm.Expr("total_refunded + ?", amount)).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *OrderRepository) GetShippingOptions(destination string, weight uint64, courier string) ([]shipping.ShippingOption, error) {
+	request := shipping.RajaOngkirRequest{
+		Destination: destination,
+		Weight:      int(weight),
+		Courier:     courier,
+	}
+
+	result, err := r.shipping.GetShippingOptions(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *OrderRepository) TrackShipment(courier, airwayBill string) (*tracking.Result, error) {
+	result, err := r.tracker.Track(courier, airwayBill)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *OrderRepository) CreateShipment(shipment *entities.ShipmentModels) (*entities.ShipmentModels, error) {
+	if err := r.db.Omit(clause.Associations).Create(shipment).Error; err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+func (r *OrderRepository) GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error) {
+	var shipment entities.ShipmentModels
+	if err := r.db.
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("occurred_at ASC")
+		}).
+		Where("order_id = ?", orderID).
+		First(&shipment).Error; err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r *OrderRepository) UpdateShipment(shipment *entities.ShipmentModels) error {
+	if err := r.db.Model(shipment).Omit(clause.Associations).Updates(map[string]interface{}{
+		"status":          shipment.Status,
+		"receiver":        shipment.Receiver,
+		"tracking_error":  shipment.TrackingError,
+		"delivered_at":    shipment.DeliveredAt,
+		"last_checked_at": shipment.LastCheckedAt,
+		"updated_at":      time.Now(),
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateShipmentEvents stores the events that aren't stored yet and returns how many were new.
+func (r *OrderRepository) CreateShipmentEvents(events []*entities.ShipmentEventModels) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&events)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *OrderRepository) GetShipmentsInTransit(limit int) ([]*entities.ShipmentModels, error) {
+	var shipments []*entities.ShipmentModels
+	if err := r.db.
+		Where("delivered_at IS NULL AND airway_bill <> ''").
+		Order("last_checked_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
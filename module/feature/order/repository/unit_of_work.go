@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	flashSaleRepository "ruti-store/module/feature/flashsale/repository"
+	"ruti-store/module/feature/order/domain"
+	productRepository "ruti-store/module/feature/product/repository"
+	voucherRepository "ruti-store/module/feature/voucher/repository"
+	assistant "ruti-store/utils/assitant"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/shipping"
+	"ruti-store/utils/tracking"
+)
+
+type UnitOfWork struct {
+	db       *gorm.DB
+	gateway  payment.PaymentGatewayInterface
+	shipping shipping.ShippingServiceInterface
+	tracker  tracking.TrackingProviderInterface
+	openAi   assistant.AssistantServiceInterface
+}
+
+func NewUnitOfWork(db *gorm.DB, gateway payment.PaymentGatewayInterface, shipping shipping.ShippingServiceInterface, tracker tracking.TrackingProviderInterface, openAi assistant.AssistantServiceInterface) domain.UnitOfWorkInterface {
+	return &UnitOfWork{
+		db:       db,
+		gateway:  gateway,
+		shipping: shipping,
+		tracker:  tracker,
+		openAi:   openAi,
+	}
+}
+
+func (u *UnitOfWork) Transaction(fn func(uow *domain.UnitOfWork) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&domain.UnitOfWork{
+			OrderRepo:     NewOrderRepository(tx, u.gateway, u.shipping, u.tracker),
+			ProductRepo:   productRepository.NewProductRepository(tx, u.openAi),
+			VoucherRepo:   voucherRepository.NewVoucherRepository(tx),
+			FlashSaleRepo: flashSaleRepository.NewFlashSaleRepository(tx),
+		})
+	})
+}
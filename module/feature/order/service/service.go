@@ -3,42 +3,59 @@ package service
 import (
 	"errors"
 	"fmt"
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
 	"math"
 	"ruti-store/module/entities"
 	address "ruti-store/module/feature/address/domain"
+	flashsale "ruti-store/module/feature/flashsale/domain"
 	notification "ruti-store/module/feature/notification/domain"
 	"ruti-store/module/feature/order/domain"
 	product "ruti-store/module/feature/product/domain"
 	users "ruti-store/module/feature/user/domain"
+	voucher "ruti-store/module/feature/voucher/domain"
 	"ruti-store/utils/generator"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/shipping"
+	"ruti-store/utils/token"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type OrderService struct {
 	repo                domain.OrderRepositoryInterface
+	uow                 domain.UnitOfWorkInterface
 	generatorID         generator.GeneratorInterface
 	productService      product.ProductServiceInterface
 	addressService      address.AddressServiceInterface
 	userService         users.UserServiceInterface
 	notificationService notification.NotificationServiceInterface
+	flashSaleService    flashsale.FlashSaleServiceInterface
+	cartToken           token.CartTokenInterface
 }
 
 func NewOrderService(
 	repo domain.OrderRepositoryInterface,
+	uow domain.UnitOfWorkInterface,
 	generatorID generator.GeneratorInterface,
 	productService product.ProductServiceInterface,
 	addressService address.AddressServiceInterface,
 	userService users.UserServiceInterface,
 	notificationService notification.NotificationServiceInterface,
-
+	flashSaleService flashsale.FlashSaleServiceInterface,
+	cartToken token.CartTokenInterface,
 ) domain.OrderServiceInterface {
 	return &OrderService{
 		repo:                repo,
+		uow:                 uow,
 		generatorID:         generatorID,
 		productService:      productService,
 		addressService:      addressService,
 		userService:         userService,
 		notificationService: notificationService,
+		flashSaleService:    flashSaleService,
+		cartToken:           cartToken,
 	}
 }
 
@@ -83,9 +100,9 @@ func (s *OrderService) CreateOrder(userID uint64, request *domain.CreateOrderReq
 		return nil, errors.New("failed to generate order ID")
 	}
 
-	addresses, err := s.addressService.GetAddressByID(request.AddressID)
+	addresses, err := s.userAddress(userID, request.AddressID)
 	if err != nil {
-		return nil, errors.New("address not found")
+		return nil, err
 	}
 
 	products, err := s.productService.GetProductByID(request.ProductID)
@@ -93,18 +110,43 @@ func (s *OrderService) CreateOrder(userID uint64, request *domain.CreateOrderReq
 		return nil, errors.New("product not found")
 	}
 
+	if err := checkAvailable(products); err != nil {
+		return nil, err
+	}
+
+	variant, err := requestVariant(products, request.VariantID, request.Size, request.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStock(products, variant, request.Quantity); err != nil {
+		return nil, err
+	}
+
+	price, discount, saleItem, err := s.unitPrice(products, variant, request.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight := variant.Weight * request.Quantity
+	shipment, err := s.shippingQuote(addresses, totalWeight, request.Courier, request.CourierService)
+	if err != nil {
+		return nil, err
+	}
+
 	var orderDetails []entities.OrderDetailsModels
 	var totalQuantity, totalPrice, totalDiscount uint64
 
 	orderDetail := entities.OrderDetailsModels{
 		OrderID:       orderID,
 		ProductID:     request.ProductID,
-		Size:          request.Size,
-		Color:         request.Color,
+		VariantID:     variant.ID,
+		Size:          variant.Size,
+		Color:         variant.Color,
 		Quantity:      request.Quantity,
 		IsReviewed:    false,
-		TotalPrice:    request.Quantity * (products.Price - products.Discount),
-		TotalDiscount: products.Discount * request.Quantity,
+		TotalPrice:    request.Quantity * price,
+		TotalDiscount: discount * request.Quantity,
 	}
 
 	totalQuantity += request.Quantity
@@ -112,9 +154,10 @@ func (s *OrderService) CreateOrder(userID uint64, request *domain.CreateOrderReq
 	totalDiscount += orderDetail.TotalDiscount
 
 	orderDetails = append(orderDetails, orderDetail)
+	discountItems := []voucher.DiscountItem{newDiscountItem(products, orderDetail.TotalPrice)}
 
 	grandTotalPrice := totalPrice
-	totalAmountPaid := grandTotalPrice + 2000
+	totalAmountPaid := grandTotalPrice + shipment.Cost + adminFees
 
 	newData := &entities.OrderModels{
 		ID:                 orderID,
@@ -124,84 +167,516 @@ func (s *OrderService) CreateOrder(userID uint64, request *domain.CreateOrderReq
 		Note:               request.Note,
 		GrandTotalQuantity: totalQuantity,
 		GrandTotalPrice:    grandTotalPrice,
-		ShipmentFee:        0,
-		AdminFees:          2000,
+		GrandTotalDiscount: totalDiscount,
+		VoucherCode:        strings.ToUpper(strings.TrimSpace(request.VoucherCode)),
+		ShipmentFee:        shipment.Cost,
+		Courier:            shipment.Courier,
+		CourierService:     shipment.Service,
+		ShipmentETD:        shipment.ETD,
+		TotalWeight:        totalWeight,
+		AdminFees:          adminFees,
 		TotalAmountPaid:    totalAmountPaid,
-		OrderStatus:        "Menunggu Konfirmasi",
-		PaymentStatus:      "Menunggu Konfirmasi",
+		OrderStatus:        string(domain.OrderStatusPending),
+		PaymentStatus:      string(domain.PaymentStatusPending),
 		CreatedAt:          time.Now(),
 		OrderDetails:       orderDetails,
 	}
 
-	createdOrder, err := s.repo.CreateOrder(newData)
+	stocks := []stockRequest{newStockRequest(products, variant, request.Quantity, saleItem)}
+	return s.checkout(newData, stocks, nil, discountItems)
+}
+
+// userAddress returns the address orders of the user are shipped to, which has to be one of the
+// user's own addresses.
+func (s *OrderService) userAddress(userID, addressID uint64) (*entities.AddressModels, error) {
+	addresses, err := s.addressService.GetAddressByID(addressID)
 	if err != nil {
-		return nil, err
+		return nil, errors.New("address not found")
+	}
+	if addresses.UserID != userID {
+		return nil, domain.ErrAddressNotOwned
 	}
+	return addresses, nil
+}
+
+// adminFees is the flat fee added to every order.
+const adminFees = 2000
 
-	user, err := s.userService.GetUserByID(createdOrder.UserID)
+// shippingQuote returns the chosen courier service for delivering weight grams to the address,
+// with the fee quoted by the shipping service.
+func (s *OrderService) shippingQuote(addresses *entities.AddressModels, weight uint64, courier, service string) (*shipping.ShippingOption, error) {
+	options, err := s.repo.GetShippingOptions(addresses.CityID, shippingWeight(weight), courier)
 	if err != nil {
-		return nil, errors.New("user not found")
+		return nil, fmt.Errorf("failed to get shipping cost: %w", err)
+	}
+
+	for i := range options {
+		if strings.EqualFold(options[i].Service, service) {
+			return &options[i], nil
+		}
 	}
+	return nil, fmt.Errorf("%w: %s %s to %s", domain.ErrShippingNotFound, strings.ToUpper(courier), service, addresses.CityName)
+}
+
+// shippingWeight is the weight sent to the courier, which needs at least one gram.
+func shippingWeight(weight uint64) uint64 {
+	if weight == 0 {
+		return 1
+	}
+	return weight
+}
 
-	snapResult, err := s.repo.CreateSnap(createdOrder.ID, user.Name, user.Email, createdOrder.TotalAmountPaid)
+func (s *OrderService) GetShippingOptions(userID uint64, req *domain.ShippingOptionsRequest) (*domain.ShippingOptionsResponse, error) {
+	addresses, err := s.userAddress(userID, req.AddressID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, variant := range products.Variants {
-		if variant.Size == request.Size && variant.Color == request.Color {
-			if err := s.productService.ReduceStockWhenPurchasing(variant.ID, request.Quantity); err != nil {
-				return nil, errors.New("failed reduce stock for variant")
+	var totalWeight uint64
+	if len(req.CartItems) > 0 {
+		for _, cartItemRequest := range req.CartItems {
+			cartItem, err := s.repo.GetCartByID(cartItemRequest.ID)
+			if err != nil {
+				return nil, errors.New("cart item not found")
+			}
+			if cartItem.UserID != userID {
+				return nil, domain.ErrCartNotOwned
+			}
+
+			products, err := s.productService.GetProductByID(cartItem.ProductID)
+			if err != nil {
+				return nil, errors.New("product not found")
+			}
+			variant, err := cartVariant(products, cartItem)
+			if err != nil {
+				return nil, err
 			}
-			break
+			totalWeight += variant.Weight * cartItem.Quantity
 		}
+	} else {
+		if req.ProductID == 0 || req.Quantity == 0 {
+			return nil, errors.New("cart items or product and quantity are required")
+		}
+
+		products, err := s.productService.GetProductByID(req.ProductID)
+		if err != nil {
+			return nil, errors.New("product not found")
+		}
+		variant, err := requestVariant(products, req.VariantID, req.Size, req.Color)
+		if err != nil {
+			return nil, err
+		}
+		totalWeight = variant.Weight * req.Quantity
 	}
 
-	notificationRequest := domain.CreateNotificationPaymentRequest{
-		OrderID:       createdOrder.ID,
-		UserID:        createdOrder.UserID,
-		PaymentStatus: "Menunggu Konfirmasi",
+	options, err := s.repo.GetShippingOptions(addresses.CityID, shippingWeight(totalWeight), req.Courier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipping cost: %w", err)
+	}
+
+	return &domain.ShippingOptionsResponse{
+		Destination: addresses.CityName,
+		TotalWeight: totalWeight,
+		Options:     options,
+	}, nil
+}
+
+// stockRequest is a variant quantity that has to be reserved when an order is placed, along
+// with the flash sale item whose quota it takes, if any.
+type stockRequest struct {
+	variantID       uint64
+	quantity        uint64
+	flashSaleItemID uint64
+	label           string
+}
+
+func newStockRequest(products *entities.ProductModels, variant *entities.ProductVariantModels, quantity uint64, saleItem *entities.FlashSaleItemModels) stockRequest {
+	stock := stockRequest{
+		variantID: variant.ID,
+		quantity:  quantity,
+		label:     fmt.Sprintf("%s (%s/%s)", products.Name, variant.Size, variant.Color),
+	}
+	if saleItem != nil {
+		stock.flashSaleItemID = saleItem.ID
+	}
+	return stock
+}
+
+// unitPrice returns the price a unit of the variant is sold at and its discount off the
+// regular price. A variant on a running flash sale with quantity units of quota left is sold at
+// the sale price, and the returned sale item's quota has to be claimed at checkout; otherwise
+// the product discount applies.
+func (s *OrderService) unitPrice(products *entities.ProductModels, variant *entities.ProductVariantModels, quantity uint64) (uint64, uint64, *entities.FlashSaleItemModels, error) {
+	saleItems, err := s.flashSaleService.GetActiveItems([]uint64{products.ID})
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	price, discount, saleItem := variantPrice(products, variant, quantity, saleItems)
+	return price, discount, saleItem, nil
+}
+
+// variantPrice is unitPrice with the running flash sale items of the product already loaded.
+// The regular price is the variant's own price when it overrides the product's.
+func variantPrice(products *entities.ProductModels, variant *entities.ProductVariantModels, quantity uint64, saleItems []*entities.FlashSaleItemModels) (uint64, uint64, *entities.FlashSaleItemModels) {
+	regular := product.VariantPrice(products, variant)
+	if saleItem := flashsale.FindItem(saleItems, variant.ID, quantity); saleItem != nil && saleItem.SalePrice < regular {
+		return saleItem.SalePrice, regular - saleItem.SalePrice, saleItem
+	}
+	discount := product.VariantDiscount(products, variant)
+	return regular - discount, discount, nil
+}
+
+// checkAvailable rejects a product that was deleted or taken off sale.
+func checkAvailable(products *entities.ProductModels) error {
+	if products.DeletedAt != nil || products.Status == product.ProductStatusInactive {
+		return fmt.Errorf("%w: %s", product.ErrProductUnavailable, products.Name)
+	}
+	return nil
+}
+
+// checkStock rejects a quantity the variant can't cover. It only gives the customer an early,
+// readable error: the reservation made at checkout is what actually guards against overselling.
+func checkStock(products *entities.ProductModels, variant *entities.ProductVariantModels, quantity uint64) error {
+	if variant.Stock < quantity {
+		return fmt.Errorf("%w: %s (%s/%s) only has %d left", product.ErrOutOfStock, products.Name, variant.Size, variant.Color, variant.Stock)
+	}
+	return nil
+}
+
+func findVariant(products *entities.ProductModels, size, color string) (*entities.ProductVariantModels, error) {
+	for i := range products.Variants {
+		if products.Variants[i].Size == size && products.Variants[i].Color == color {
+			return &products.Variants[i], nil
+		}
+	}
+	return nil, fmt.Errorf("variant %s/%s of product %s not found", size, color, products.Name)
+}
+
+// requestVariant returns the variant a request, cart item or order line refers to by id. Clients
+// and rows from before variants were referenced by id don't have one and are matched on their
+// size and color.
+func requestVariant(products *entities.ProductModels, variantID uint64, size, color string) (*entities.ProductVariantModels, error) {
+	if variantID == 0 {
+		return findVariant(products, size, color)
+	}
+	for i := range products.Variants {
+		if products.Variants[i].ID == variantID {
+			return &products.Variants[i], nil
+		}
+	}
+	return nil, fmt.Errorf("variant %d of product %s not found", variantID, products.Name)
+}
+
+// cartVariant returns the variant of a cart item.
+func cartVariant(products *entities.ProductModels, cartItem *entities.CartModels) (*entities.ProductVariantModels, error) {
+	return requestVariant(products, cartItem.VariantID, cartItem.Size, cartItem.Color)
+}
+
+func newDiscountItem(products *entities.ProductModels, subtotal uint64) voucher.DiscountItem {
+	item := voucher.DiscountItem{ProductID: products.ID, Subtotal: subtotal}
+	for _, category := range products.Categories {
+		item.CategoryIDs = append(item.CategoryIDs, category.ID)
+	}
+	return item
+}
+
+// applyVoucher locks the voucher of a new order, checks that the customer may still use it and
+// takes its discount off the order. The discount is also recorded on the order details it applies
+// to, discountItems being the details in the same order, so returns refund what was paid for them.
+// The returned usage has to be redeemed in the same transaction once the order is stored.
+func applyVoucher(uow *domain.UnitOfWork, newOrder *entities.OrderModels, discountItems []voucher.DiscountItem) (*entities.VoucherUsageModels, error) {
+	voucherData, err := uow.VoucherRepo.LockVoucherByCode(newOrder.VoucherCode)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: voucher %s not found", voucher.ErrInvalidVoucher, newOrder.VoucherCode)
 	}
-	_, err = s.SendNotificationPayment(notificationRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &domain.CreateOrderResponse{
-		OrderID:         createdOrder.ID,
-		IdOrder:         createdOrder.IdOrder,
-		RedirectURL:     snapResult.RedirectURL,
-		TotalAmountPaid: createdOrder.TotalAmountPaid,
+	userUsage, err := uow.VoucherRepo.CountUserUsage(voucherData.ID, newOrder.UserID)
+	if err != nil {
+		return nil, err
 	}
-	return response, nil
+	if err := voucher.CheckUsage(voucherData, userUsage); err != nil {
+		return nil, err
+	}
+
+	discount, err := voucher.CalculateDiscount(voucherData, discountItems, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	newOrder.VoucherCode = voucherData.Code
+	newOrder.VoucherDiscount = discount
+	for i, share := range voucher.AllocateDiscount(voucherData, discountItems, discount) {
+		newOrder.OrderDetails[i].VoucherDiscount = share
+	}
+	newOrder.GrandTotalDiscount += discount
+	newOrder.TotalAmountPaid -= discount
+
+	return &entities.VoucherUsageModels{
+		VoucherID: voucherData.ID,
+		UserID:    newOrder.UserID,
+		OrderID:   newOrder.ID,
+		Discount:  discount,
+		Status:    voucher.UsageStatusUsed,
+		CreatedAt: time.Now(),
+	}, nil
 }
 
-func (s *OrderService) CallBack(req map[string]interface{}) error {
-	orderID, exist := req["order_id"].(string)
-	if !exist {
-		return errors.New("invalid notification payload")
+// checkout stores the order, reserves its stock and clears the purchased cart items in a single
+// transaction. The payment gateway can't take part in that transaction, so if creating the
+// payment fails afterwards the order is compensated: it is marked as failed, the
+// reservation is released and the cart items are restored.
+func (s *OrderService) checkout(newOrder *entities.OrderModels, stocks []stockRequest, cartItems []*entities.CartModels, discountItems []voucher.DiscountItem) (*domain.CreateOrderResponse, error) {
+	user, err := s.userService.GetUserByID(newOrder.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
 	}
 
-	status, err := s.repo.CheckTransaction(orderID)
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		var usage *entities.VoucherUsageModels
+		if newOrder.VoucherCode != "" {
+			if usage, err = applyVoucher(uow, newOrder, discountItems); err != nil {
+				return err
+			}
+		}
+		if _, err := uow.OrderRepo.CreateOrder(newOrder); err != nil {
+			return err
+		}
+		if usage != nil {
+			if err := uow.VoucherRepo.RedeemVoucher(usage); err != nil {
+				return err
+			}
+		}
+		history := &entities.OrderStatusHistoryModels{
+			OrderID:   newOrder.ID,
+			ToStatus:  newOrder.OrderStatus,
+			ActorID:   newOrder.UserID,
+			ActorRole: domain.ActorRoleCustomer,
+			Note:      "order placed",
+			CreatedAt: time.Now(),
+		}
+		if err := uow.OrderRepo.CreateStatusHistory(history); err != nil {
+			return err
+		}
+		for _, stock := range stocks {
+			if err := uow.ProductRepo.ReserveStock(newOrder.ID, stock.variantID, stock.quantity); err != nil {
+				if errors.Is(err, product.ErrOutOfStock) {
+					return fmt.Errorf("%w: %s", product.ErrOutOfStock, stock.label)
+				}
+				return errors.New("failed to reserve stock for variant")
+			}
+			if stock.flashSaleItemID == 0 {
+				continue
+			}
+			if err := uow.FlashSaleRepo.ClaimQuota(newOrder.ID, stock.flashSaleItemID, stock.quantity); err != nil {
+				if errors.Is(err, flashsale.ErrQuotaExhausted) {
+					return fmt.Errorf("%w: %s", flashsale.ErrQuotaExhausted, stock.label)
+				}
+				return errors.New("failed to claim flash sale quota")
+			}
+		}
+		for _, cartItem := range cartItems {
+			if err := uow.OrderRepo.DeleteCartItem(cartItem.ID); err != nil {
+				return errors.New("failed to delete cart item")
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	transaction, err := s.repo.GetOrderByID(orderID)
+	charge, err := s.repo.CreatePayment(newOrder.ID, user.Name, user.Email, newOrder.TotalAmountPaid)
 	if err != nil {
-		return errors.New("transaction data not found")
+		if compensateErr := s.compensateCheckout(newOrder, cartItems); compensateErr != nil {
+			return nil, fmt.Errorf("failed to create payment: %v (rollback failed: %v)", err, compensateErr)
+		}
+		return nil, fmt.Errorf("failed to create payment: %v", err)
+	}
+
+	notificationRequest := domain.CreateNotificationPaymentRequest{
+		OrderID:       newOrder.ID,
+		UserID:        newOrder.UserID,
+		PaymentStatus: string(domain.PaymentStatusPending),
+	}
+	if _, err := s.SendNotificationPayment(notificationRequest); err != nil {
+		log.Errorf("failed to send payment notification for order %s: %v", newOrder.ID, err)
+	}
+
+	response := &domain.CreateOrderResponse{
+		OrderID:         newOrder.ID,
+		IdOrder:         newOrder.IdOrder,
+		RedirectURL:     charge.RedirectURL,
+		ShipmentFee:     newOrder.ShipmentFee,
+		VoucherDiscount: newOrder.VoucherDiscount,
+		TotalAmountPaid: newOrder.TotalAmountPaid,
 	}
+	return response, nil
+}
 
-	if status.PaymentStatus == "Konfirmasi" {
-		if err := s.ConfirmPayment(transaction.ID); err != nil {
+func (s *OrderService) compensateCheckout(order *entities.OrderModels, cartItems []*entities.CartModels) error {
+	return s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if _, err := transition(uow, order.ID, domain.OrderStatusFailed, domain.PaymentStatusFailed, domain.SystemActor, "payment could not be created"); err != nil {
+			return err
+		}
+		if err := uow.ProductRepo.ReleaseReservation(order.ID); err != nil {
+			return err
+		}
+		if err := uow.VoucherRepo.ReleaseVoucher(order.ID); err != nil {
 			return err
 		}
-	} else if status.PaymentStatus == "Gagal" {
-		if err := s.CancelPayment(transaction.ID); err != nil {
+		if err := uow.FlashSaleRepo.ReleaseQuota(order.ID); err != nil {
 			return err
 		}
+		for _, cartItem := range cartItems {
+			restored := &entities.CartModels{
+				ID:        cartItem.ID,
+				UserID:    cartItem.UserID,
+				ProductID: cartItem.ProductID,
+				VariantID: cartItem.VariantID,
+				Size:      cartItem.Size,
+				Color:     cartItem.Color,
+				Quantity:  cartItem.Quantity,
+				Price:     cartItem.Price,
+				CreatedAt: cartItem.CreatedAt,
+				UpdatedAt: time.Now(),
+			}
+			if _, err := uow.OrderRepo.CreateCart(restored); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// transition moves a locked order to status to inside uow and records the change in the order's
+// status history. An empty paymentStatus keeps the current payment status.
+func transition(uow *domain.UnitOfWork, orderID string, to domain.OrderStatus, paymentStatus domain.PaymentStatus, actor domain.Actor, note string) (*entities.OrderModels, error) {
+	order, err := uow.OrderRepo.LockOrder(orderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
+
+	from := domain.OrderStatus(order.OrderStatus)
+	if err := domain.ValidateTransition(from, to); err != nil {
+		return nil, err
+	}
+
+	if paymentStatus == "" {
+		paymentStatus = domain.PaymentStatus(order.PaymentStatus)
+	}
+	if err := uow.OrderRepo.UpdatePayment(order.ID, string(to), string(paymentStatus)); err != nil {
+		return nil, err
+	}
+
+	history := &entities.OrderStatusHistoryModels{
+		OrderID:    order.ID,
+		FromStatus: string(from),
+		ToStatus:   string(to),
+		ActorID:    actor.ID,
+		ActorRole:  actor.Role,
+		Note:       note,
+		CreatedAt:  time.Now(),
+	}
+	if err := uow.OrderRepo.CreateStatusHistory(history); err != nil {
+		return nil, err
+	}
+
+	order.OrderStatus = string(to)
+	order.PaymentStatus = string(paymentStatus)
+	return order, nil
+}
+
+func (s *OrderService) CallBack(payload []byte) error {
+	event := &entities.PaymentEventModels{
+		Payload:   string(payload),
+		CreatedAt: time.Now(),
+	}
+
+	outcome, err := s.processPaymentNotification(payload, event)
+	event.Outcome = outcome
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if createErr := s.repo.CreatePaymentEvent(event); createErr != nil {
+		log.Errorf("failed to store payment event for order %s: %v", event.OrderID, createErr)
+	}
+
+	return err
+}
+
+// processPaymentNotification applies a payment notification to its order and returns the outcome
+// to record for the delivery. Notifications for an order whose payment is already settled are a
+// no-op, so a redelivered or late notification never confirms or cancels a payment twice.
+func (s *OrderService) processPaymentNotification(payload []byte, event *entities.PaymentEventModels) (string, error) {
+	notification, err := s.repo.ParseNotification(payload)
+	if err != nil {
+		return domain.PaymentEventRejected, errors.New("invalid notification payload")
+	}
+
+	event.OrderID = notification.OrderID
+	event.TransactionID = notification.TransactionID
+	event.TransactionStatus = notification.TransactionStatus
+	event.StatusCode = notification.StatusCode
+	event.FraudStatus = notification.FraudStatus
+	event.GrossAmount = notification.GrossAmount
+	event.SignatureValid = notification.SignatureValid
+	if !notification.SignatureValid {
+		return domain.PaymentEventRejected, domain.ErrInvalidSignature
+	}
+
+	transaction, err := s.repo.GetOrderByID(notification.OrderID)
+	if err != nil {
+		return domain.PaymentEventRejected, errors.New("transaction data not found")
+	}
+
+	grossAmount, err := strconv.ParseFloat(notification.GrossAmount, 64)
+	if err != nil || uint64(math.Round(grossAmount)) != transaction.TotalAmountPaid {
+		return domain.PaymentEventRejected, fmt.Errorf("%w: got %s, expected %d", domain.ErrAmountMismatch, notification.GrossAmount, transaction.TotalAmountPaid)
+	}
+
+	status, err := s.repo.CheckTransaction(transaction.ID)
+	if err != nil {
+		return domain.PaymentEventFailed, err
+	}
+
+	switch domain.PaymentStatus(status.PaymentStatus) {
+	case domain.PaymentStatusPaid:
+		err = s.ConfirmPayment(transaction.ID)
+	case domain.PaymentStatusFailed:
+		err = s.CancelPayment(transaction.ID)
+	default:
+		return domain.PaymentEventIgnored, nil
+	}
+
+	if errors.Is(err, errPaymentSettled) {
+		if transaction.PaymentStatus == status.PaymentStatus {
+			return domain.PaymentEventDuplicate, nil
+		}
+		return domain.PaymentEventIgnored, nil
+	}
+	if err != nil {
+		return domain.PaymentEventFailed, err
 	}
+	return domain.PaymentEventProcessed, nil
+}
 
+// errPaymentSettled is returned by ConfirmPayment and CancelPayment when the order is no longer
+// waiting for its payment.
+var errPaymentSettled = errors.New("payment already settled")
+
+// lockPendingPayment locks the order inside uow and makes sure its payment is still pending.
+func lockPendingPayment(uow *domain.UnitOfWork, orderID string) error {
+	order, err := uow.OrderRepo.LockOrder(orderID)
+	if err != nil {
+		return errors.New("transaction data not found")
+	}
+	if domain.OrderStatus(order.OrderStatus) != domain.OrderStatusPending {
+		return fmt.Errorf("%w: order is %s", errPaymentSettled, order.OrderStatus)
+	}
 	return nil
 }
 
@@ -219,16 +694,22 @@ func (s *OrderService) ConfirmPayment(orderID string) error {
 		return errors.New("transaction data not found")
 	}
 
-	orders.OrderStatus = "Proses"
-	orders.PaymentStatus = "Konfirmasi"
-
-	if err := s.repo.UpdatePayment(orders.ID, orders.OrderStatus, orders.PaymentStatus); err != nil {
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if err := lockPendingPayment(uow, orders.ID); err != nil {
+			return err
+		}
+		if _, err := transition(uow, orders.ID, domain.OrderStatusPaid, domain.PaymentStatusPaid, domain.SystemActor, "payment received"); err != nil {
+			return err
+		}
+		return uow.ProductRepo.CommitReservation(orders.ID)
+	})
+	if err != nil {
 		return err
 	}
 	notificationRequest := domain.CreateNotificationPaymentRequest{
 		OrderID:       orders.ID,
 		UserID:        orders.UserID,
-		PaymentStatus: "Konfirmasi",
+		PaymentStatus: string(domain.PaymentStatusPaid),
 	}
 	_, err = s.SendNotificationPayment(notificationRequest)
 	if err != nil {
@@ -239,27 +720,39 @@ func (s *OrderService) ConfirmPayment(orderID string) error {
 }
 
 func (s *OrderService) CancelPayment(orderID string) error {
+	return s.failPayment(orderID, "payment failed")
+}
+
+// failPayment marks a pending order and its payment as failed, releases its stock reservation
+// and notifies the customer.
+func (s *OrderService) failPayment(orderID, note string) error {
 	orders, err := s.repo.GetOrderByID(orderID)
 	if err != nil {
 		return errors.New("transaction data not found")
 	}
 
-	orders.OrderStatus = "Gagal"
-	orders.PaymentStatus = "Gagal"
-
-	if err := s.repo.UpdatePayment(orderID, orders.OrderStatus, orders.PaymentStatus); err != nil {
-		return err
-	}
-
-	for _, orderDetail := range orders.OrderDetails {
-		if err := s.productService.IncreaseStock(orderDetail.ProductID, orderDetail.Quantity); err != nil {
-			return errors.New("failed to increase stock")
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if err := lockPendingPayment(uow, orders.ID); err != nil {
+			return err
+		}
+		if _, err := transition(uow, orders.ID, domain.OrderStatusFailed, domain.PaymentStatusFailed, domain.SystemActor, note); err != nil {
+			return err
+		}
+		if err := uow.ProductRepo.ReleaseReservation(orders.ID); err != nil {
+			return err
+		}
+		if err := uow.VoucherRepo.ReleaseVoucher(orders.ID); err != nil {
+			return err
 		}
+		return uow.FlashSaleRepo.ReleaseQuota(orders.ID)
+	})
+	if err != nil {
+		return err
 	}
 	notificationRequest := domain.CreateNotificationPaymentRequest{
 		OrderID:       orders.ID,
 		UserID:        orders.UserID,
-		PaymentStatus: "Menunggu Konfirmasi",
+		PaymentStatus: string(domain.PaymentStatusFailed),
 	}
 	_, err = s.SendNotificationPayment(notificationRequest)
 	if err != nil {
@@ -282,12 +775,12 @@ func (s *OrderService) SendNotificationPayment(request domain.CreateNotification
 		return "", err
 	}
 
-	switch request.PaymentStatus {
-	case "Menunggu Konfirmasi":
+	switch domain.PaymentStatus(request.PaymentStatus) {
+	case domain.PaymentStatusPending:
 		notificationMsg = fmt.Sprintf("Halo, %s! Pesanan dengan ID %s sudah berhasil dibuat. Harap ditunggu!", user.Name, orders.IdOrder)
-	case "Konfirmasi":
+	case domain.PaymentStatusPaid:
 		notificationMsg = fmt.Sprintf("Terima kasih, %s! Pembayaran untuk pesanan dengan ID %s telah kami terima. Semoga harimu menyenangkan!", user.Name, orders.IdOrder)
-	case "Gagal":
+	case domain.PaymentStatusFailed:
 		notificationMsg = fmt.Sprintf("Maaf, %s. Pembayaran untuk pesanan dengan ID %s gagal. Beritahu kami jika Anda membutuhkan bantuan!", user.Name, orders.IdOrder)
 	default:
 		return "", errors.New("Status pesanan tidak valid")
@@ -319,16 +812,24 @@ func (s *OrderService) SendNotificationOrder(request domain.CreateNotificationOr
 		return "", err
 	}
 
-	switch request.OrderStatus {
-	case "Pengiriman":
+	switch domain.OrderStatus(request.OrderStatus) {
+	case domain.OrderStatusShipped:
 		notificationMsg = fmt.Sprintf("Halo, %s! Pesanan dengan ID %s sedang dalam proses pengiriman. Harap ditunggu!", user.Name, orders.IdOrder)
-	case "Selesai":
+	case domain.OrderStatusDelivered:
+		notificationMsg = fmt.Sprintf("Halo, %s! Pesanan dengan ID %s sudah diterima kurir di alamat tujuan. Jangan lupa konfirmasi pesanan Anda!", user.Name, orders.IdOrder)
+	case domain.OrderStatusCompleted:
 		notificationMsg = fmt.Sprintf("Selamat, %s! Pesanan dengan ID %s sudah sampai tujuan. Semoga Anda puas!", user.Name, orders.IdOrder)
-	case "Menunggu Konfirmasi":
+	case domain.OrderStatusPending:
 		notificationMsg = fmt.Sprintf("Halo, %s! Pesanan dengan ID %s sedang menunggu konfirmasi. Harap ditunggu!", user.Name, orders.IdOrder)
-	case "Proses":
+	case domain.OrderStatusPaid:
+		notificationMsg = fmt.Sprintf("Halo, %s! Pembayaran pesanan dengan ID %s sudah diterima dan akan segera diproses.", user.Name, orders.IdOrder)
+	case domain.OrderStatusProcessing:
 		notificationMsg = fmt.Sprintf("Halo, %s! Pesanan dengan ID %s sedang dalam proses. Harap ditunggu!", user.Name, orders.IdOrder)
-	case "Gagal":
+	case domain.OrderStatusCancelled:
+		notificationMsg = fmt.Sprintf("Halo, %s. Pesanan dengan ID %s telah dibatalkan.", user.Name, orders.IdOrder)
+	case domain.OrderStatusRefunded:
+		notificationMsg = fmt.Sprintf("Halo, %s. Dana untuk pesanan dengan ID %s telah dikembalikan.", user.Name, orders.IdOrder)
+	case domain.OrderStatusFailed:
 		notificationMsg = fmt.Sprintf("Maaf, %s. Pesanan dengan ID %s gagal. Silakan coba lagi.", user.Name, orders.IdOrder)
 	default:
 		return "", errors.New("Status pengiriman tidak valid")
@@ -359,11 +860,30 @@ func (s *OrderService) CreateCart(userID uint64, req *domain.CreateCartRequest)
 		return nil, errors.New("user not found")
 	}
 
-	existingCartItem, err := s.repo.GetCartItem(user.ID, products.ID)
+	if err := checkAvailable(products); err != nil {
+		return nil, err
+	}
+
+	variant, err := requestVariant(products, req.VariantID, req.Size, req.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	existingCartItem, err := s.repo.GetCartItem(user.ID, variant.ID)
 	if err == nil && existingCartItem != nil {
-		existingCartItem.Quantity += req.Quantity
+		quantity := existingCartItem.Quantity + req.Quantity
+		if err := checkStock(products, variant, quantity); err != nil {
+			return nil, err
+		}
+		price, _, _, err := s.unitPrice(products, variant, quantity)
+		if err != nil {
+			return nil, err
+		}
+		existingCartItem.Quantity = quantity
+		existingCartItem.Price = price
+		existingCartItem.UpdatedAt = time.Now()
 
-		err := s.repo.UpdateCartItem(existingCartItem)
+		err = s.repo.UpdateCartItem(existingCartItem)
 		if err != nil {
 			return nil, errors.New("gagal mengubah jumlah produk di keranjang")
 		}
@@ -371,12 +891,25 @@ func (s *OrderService) CreateCart(userID uint64, req *domain.CreateCartRequest)
 		return existingCartItem, nil
 	}
 
+	if err := checkStock(products, variant, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	price, _, _, err := s.unitPrice(products, variant, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
 	newData := &entities.CartModels{
 		UserID:    user.ID,
 		ProductID: products.ID,
-		Size:      req.Size,
-		Color:     req.Color,
+		VariantID: variant.ID,
+		Size:      variant.Size,
+		Color:     variant.Color,
 		Quantity:  req.Quantity,
+		Price:     price,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 	}
 
 	result, err := s.repo.CreateCart(newData)
@@ -387,11 +920,55 @@ func (s *OrderService) CreateCart(userID uint64, req *domain.CreateCartRequest)
 
 }
 
-func (s *OrderService) DeleteCartItems(cartID uint64) error {
+// UpdateCartQuantity sets the quantity of a cart item. The item's price snapshot is refreshed,
+// since the customer sees the current price when changing the quantity.
+func (s *OrderService) UpdateCartQuantity(userID, cartID uint64, req *domain.UpdateCartRequest) (*entities.CartModels, error) {
+	cartItem, err := s.repo.GetCartByID(cartID)
+	if err != nil {
+		return nil, errors.New("cart item not found")
+	}
+	if cartItem.UserID != userID {
+		return nil, domain.ErrCartNotOwned
+	}
+
+	products := &cartItem.Product
+	if err := checkAvailable(products); err != nil {
+		return nil, err
+	}
+
+	variant, err := cartVariant(products, cartItem)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStock(products, variant, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	price, _, _, err := s.unitPrice(products, variant, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	cartItem.VariantID = variant.ID
+	cartItem.Quantity = req.Quantity
+	cartItem.Price = price
+	cartItem.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateCartItem(cartItem); err != nil {
+		return nil, errors.New("gagal mengubah jumlah produk di keranjang")
+	}
+	return cartItem, nil
+}
+
+func (s *OrderService) DeleteCartItems(userID, cartID uint64) error {
 	cart, err := s.repo.GetCartByID(cartID)
 	if err != nil {
 		return err
 	}
+	if cart.UserID != userID {
+		return domain.ErrCartNotOwned
+	}
 
 	err = s.repo.DeleteCartItem(cart.ID)
 	if err != nil {
@@ -401,7 +978,9 @@ func (s *OrderService) DeleteCartItems(cartID uint64) error {
 	return nil
 }
 
-func (s *OrderService) GetCartUser(userID uint64) ([]*entities.CartModels, error) {
+// GetCartUser returns the user's cart with every item checked against the current product,
+// stock and price, and the totals of the items that can still be bought.
+func (s *OrderService) GetCartUser(userID uint64) (*domain.CartSummaryResponse, error) {
 	user, err := s.userService.GetUserByID(userID)
 	if err != nil {
 		return nil, err
@@ -410,8 +989,419 @@ func (s *OrderService) GetCartUser(userID uint64) ([]*entities.CartModels, error
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return s.cartSummary(result)
+}
+
+// cartSummary revalidates the cart items and sums up the ones that can still be bought.
+func (s *OrderService) cartSummary(cartItems []*entities.CartModels) (*domain.CartSummaryResponse, error) {
+	productIDs := make([]uint64, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		productIDs = append(productIDs, cartItem.ProductID)
+	}
+	saleItems, err := s.flashSaleService.GetActiveItems(productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.CartSummaryResponse{Items: make([]*domain.CartItemResponse, 0, len(cartItems))}
+	for _, cartItem := range cartItems {
+		item := revalidateCartItem(cartItem, saleItems)
+		summary.Items = append(summary.Items, item)
+		if len(item.Issues) > 0 {
+			summary.HasIssues = true
+		}
+		if !item.Available {
+			continue
+		}
+		summary.TotalQuantity += item.Quantity
+		summary.Subtotal += item.Subtotal
+		summary.TotalDiscount += item.Discount
+		summary.TotalWeight += item.Weight
+	}
+	return summary, nil
+}
+
+// revalidateCartItem prices a cart item as it would be bought now and flags whatever changed
+// since it was added. An item whose price changed can still be bought at the new price.
+func revalidateCartItem(cartItem *entities.CartModels, saleItems []*entities.FlashSaleItemModels) *domain.CartItemResponse {
+	item := domain.CartItemFormatter(cartItem)
+	products := &cartItem.Product
+
+	inactive := checkAvailable(products) != nil
+	if inactive {
+		item.Issues = append(item.Issues, domain.CartIssueInactive)
+	}
+
+	variant, err := cartVariant(products, cartItem)
+	if err != nil {
+		item.Issues = append(item.Issues, domain.CartIssueVariantUnavailable)
+		return item
+	}
+
+	price, discount, _ := variantPrice(products, variant, cartItem.Quantity, saleItems)
+	item.VariantID = variant.ID
+	item.Stock = variant.Stock
+	item.Price = price
+	item.Subtotal = price * cartItem.Quantity
+	item.Discount = discount * cartItem.Quantity
+	item.Weight = variant.Weight * cartItem.Quantity
+
+	outOfStock := variant.Stock < cartItem.Quantity
+	if outOfStock {
+		item.Issues = append(item.Issues, domain.CartIssueOutOfStock)
+	}
+	if cartItem.Price != 0 && cartItem.Price != price {
+		item.Issues = append(item.Issues, domain.CartIssuePriceChanged)
+	}
+
+	item.Available = !inactive && !outOfStock
+	return item
+}
+
+// guestID returns the guest id of a cart token. An empty token starts a new guest cart, and
+// the new token is returned so the client can keep using it.
+func (s *OrderService) guestID(cartToken string) (string, string, error) {
+	if cartToken == "" {
+		newToken, err := s.cartToken.GenerateCartToken()
+		if err != nil {
+			return "", "", err
+		}
+		cartToken = newToken
+	}
+
+	guestID, err := s.cartToken.ValidateCartToken(cartToken)
+	if err != nil {
+		return "", "", err
+	}
+	return guestID, cartToken, nil
+}
+
+// guestCartItem views a guest cart item as a cart item, so guest carts are priced and checked
+// the same way as user carts.
+func guestCartItem(guestItem *entities.GuestCartModels) *entities.CartModels {
+	return &entities.CartModels{
+		ID:        guestItem.ID,
+		ProductID: guestItem.ProductID,
+		VariantID: guestItem.VariantID,
+		Size:      guestItem.Size,
+		Color:     guestItem.Color,
+		Quantity:  guestItem.Quantity,
+		Price:     guestItem.Price,
+		CreatedAt: guestItem.CreatedAt,
+		UpdatedAt: guestItem.UpdatedAt,
+		Product:   guestItem.Product,
+	}
+}
+
+// CreateGuestCart adds a product to the cart of a guest. It returns the cart token of the
+// guest, which is a new one when cartToken is empty.
+func (s *OrderService) CreateGuestCart(cartToken string, req *domain.CreateCartRequest) (*entities.GuestCartModels, string, error) {
+	guestID, cartToken, err := s.guestID(cartToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	products, err := s.productService.GetProductByID(req.ProductID)
+	if err != nil {
+		return nil, "", errors.New("product not found")
+	}
+
+	if err := checkAvailable(products); err != nil {
+		return nil, "", err
+	}
+
+	variant, err := requestVariant(products, req.VariantID, req.Size, req.Color)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cartItem, err := s.repo.GetGuestCartItem(guestID, variant.ID)
+	if err != nil || cartItem == nil {
+		cartItem = &entities.GuestCartModels{
+			GuestID:   guestID,
+			ProductID: products.ID,
+			VariantID: variant.ID,
+			Size:      variant.Size,
+			Color:     variant.Color,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	quantity := cartItem.Quantity + req.Quantity
+	if err := checkStock(products, variant, quantity); err != nil {
+		return nil, "", err
+	}
+	price, _, _, err := s.unitPrice(products, variant, quantity)
+	if err != nil {
+		return nil, "", err
+	}
+	cartItem.Quantity = quantity
+	cartItem.Price = price
+	cartItem.UpdatedAt = time.Now()
+
+	if cartItem.ID != 0 {
+		if err := s.repo.UpdateGuestCartItem(cartItem); err != nil {
+			return nil, "", errors.New("gagal mengubah jumlah produk di keranjang")
+		}
+		return cartItem, cartToken, nil
+	}
+
+	result, err := s.repo.CreateGuestCart(cartItem)
+	if err != nil {
+		return nil, "", err
+	}
+	return result, cartToken, nil
+}
+
+// guestCartByID returns a guest cart item after checking that it belongs to the cart token.
+func (s *OrderService) guestCartByID(cartToken string, cartID uint64) (*entities.GuestCartModels, error) {
+	guestID, err := s.cartToken.ValidateCartToken(cartToken)
+	if err != nil {
+		return nil, err
+	}
+
+	cartItem, err := s.repo.GetGuestCartByID(cartID)
+	if err != nil {
+		return nil, errors.New("cart item not found")
+	}
+	if cartItem.GuestID != guestID {
+		return nil, domain.ErrCartNotOwned
+	}
+	return cartItem, nil
+}
+
+func (s *OrderService) UpdateGuestCartQuantity(cartToken string, cartID uint64, req *domain.UpdateCartRequest) (*entities.GuestCartModels, error) {
+	cartItem, err := s.guestCartByID(cartToken, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	products := &cartItem.Product
+	if err := checkAvailable(products); err != nil {
+		return nil, err
+	}
+
+	variant, err := cartVariant(products, guestCartItem(cartItem))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStock(products, variant, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	price, _, _, err := s.unitPrice(products, variant, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	cartItem.Quantity = req.Quantity
+	cartItem.Price = price
+	cartItem.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateGuestCartItem(cartItem); err != nil {
+		return nil, errors.New("gagal mengubah jumlah produk di keranjang")
+	}
+	return cartItem, nil
+}
+
+func (s *OrderService) DeleteGuestCartItem(cartToken string, cartID uint64) error {
+	cartItem, err := s.guestCartByID(cartToken, cartID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteGuestCartItem(cartItem.ID)
+}
+
+func (s *OrderService) GetGuestCart(cartToken string) (*domain.CartSummaryResponse, error) {
+	guestID, err := s.cartToken.ValidateCartToken(cartToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetCartByGuestID(guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	cartItems := make([]*entities.CartModels, 0, len(result))
+	for _, guestItem := range result {
+		cartItems = append(cartItems, guestCartItem(guestItem))
+	}
+	return s.cartSummary(cartItems)
+}
+
+// MergeGuestCart moves the cart of a guest into the cart of the user they logged in as. Items of
+// a variant the user already has in their cart are added to that item. Quantities are capped at
+// the variant's stock, and items of a variant that is gone or sold out are dropped.
+func (s *OrderService) MergeGuestCart(userID uint64, cartToken string) error {
+	guestID, err := s.cartToken.ValidateCartToken(cartToken)
+	if err != nil {
+		return err
+	}
+
+	return s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		guestItems, err := uow.OrderRepo.GetCartByGuestID(guestID)
+		if err != nil {
+			return err
+		}
+
+		for _, guestItem := range guestItems {
+			if err := uow.OrderRepo.DeleteGuestCartItem(guestItem.ID); err != nil {
+				return err
+			}
+
+			products := &guestItem.Product
+			variant, err := cartVariant(products, guestCartItem(guestItem))
+			if err != nil || checkAvailable(products) != nil {
+				continue
+			}
+
+			cartItem, err := uow.OrderRepo.GetCartItem(userID, variant.ID)
+			if err == nil && cartItem != nil {
+				if quantity := cartItem.Quantity + guestItem.Quantity; quantity <= variant.Stock {
+					cartItem.Quantity = quantity
+				} else if variant.Stock > cartItem.Quantity {
+					cartItem.Quantity = variant.Stock
+				}
+				cartItem.UpdatedAt = time.Now()
+				if err := uow.OrderRepo.UpdateCartItem(cartItem); err != nil {
+					return err
+				}
+				continue
+			}
+
+			quantity := guestItem.Quantity
+			if quantity > variant.Stock {
+				quantity = variant.Stock
+			}
+			if quantity == 0 {
+				continue
+			}
+			newData := &entities.CartModels{
+				UserID:    userID,
+				ProductID: guestItem.ProductID,
+				VariantID: variant.ID,
+				Size:      variant.Size,
+				Color:     variant.Color,
+				Quantity:  quantity,
+				Price:     guestItem.Price,
+				CreatedAt: guestItem.CreatedAt,
+				UpdatedAt: time.Now(),
+			}
+			if _, err := uow.OrderRepo.CreateCart(newData); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
 
+// Reorder copies the lines of a past order into the customer's cart, matched on their variant.
+// Lines whose product or variant is gone or sold out are skipped, and quantities are clamped to
+// what's in stock. Items already in the cart are topped up the same way.
+func (s *OrderService) Reorder(userID uint64, orderID string) (*domain.ReorderResponse, error) {
+	orders, err := s.repo.GetOrderByID(orderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
+
+	if orders.UserID != userID {
+		return nil, domain.ErrOrderNotOwned
+	}
+
+	result := &domain.ReorderResponse{
+		OrderID: orders.ID,
+		Added:   make([]*domain.ReorderItemResponse, 0),
+		Skipped: make([]*domain.ReorderItemResponse, 0),
+	}
+	for _, detail := range orders.OrderDetails {
+		item := &domain.ReorderItemResponse{
+			ProductID: detail.ProductID,
+			VariantID: detail.VariantID,
+			Name:      detail.Product.Name,
+			Size:      detail.Size,
+			Color:     detail.Color,
+			Requested: detail.Quantity,
+		}
+
+		added, err := s.reorderItem(userID, item)
+		if err != nil {
+			return nil, err
+		}
+		if added {
+			result.Added = append(result.Added, item)
+		} else {
+			result.Skipped = append(result.Skipped, item)
+		}
+	}
+
+	return result, nil
+}
+
+// reorderItem puts as much of the requested quantity of item in the cart as the stock allows
+// and fills in what was added. It reports whether anything was added.
+func (s *OrderService) reorderItem(userID uint64, item *domain.ReorderItemResponse) (bool, error) {
+	products, err := s.productService.GetProductByID(item.ProductID)
+	if err != nil || checkAvailable(products) != nil {
+		item.Issue = domain.CartIssueInactive
+		return false, nil
+	}
+	variant, err := requestVariant(products, item.VariantID, item.Size, item.Color)
+	if err != nil {
+		item.Issue = domain.CartIssueVariantUnavailable
+		return false, nil
+	}
+	item.VariantID = variant.ID
+
+	var inCart uint64
+	cartItem, err := s.repo.GetCartItem(userID, variant.ID)
+	inCartAlready := err == nil && cartItem != nil
+	if inCartAlready {
+		inCart = cartItem.Quantity
+	}
+
+	quantity := inCart + item.Requested
+	if quantity > variant.Stock {
+		quantity = variant.Stock
+		item.Issue = domain.CartIssueOutOfStock
+	}
+	if quantity <= inCart {
+		item.Issue = domain.CartIssueOutOfStock
+		return false, nil
+	}
+	item.Quantity = quantity - inCart
+
+	price, _, _, err := s.unitPrice(products, variant, quantity)
+	if err != nil {
+		return false, err
+	}
+
+	if inCartAlready {
+		cartItem.Quantity = quantity
+		cartItem.Price = price
+		cartItem.UpdatedAt = time.Now()
+		if err := s.repo.UpdateCartItem(cartItem); err != nil {
+			return false, errors.New("gagal mengubah jumlah produk di keranjang")
+		}
+		return true, nil
+	}
+
+	newData := &entities.CartModels{
+		UserID:    userID,
+		ProductID: products.ID,
+		VariantID: variant.ID,
+		Size:      variant.Size,
+		Color:     variant.Color,
+		Quantity:  quantity,
+		Price:     price,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if _, err := s.repo.CreateCart(newData); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 func (s *OrderService) CreateOrderCart(userID uint64, request *domain.CreateOrderCartRequest) (*domain.CreateOrderResponse, error) {
@@ -425,13 +1415,16 @@ func (s *OrderService) CreateOrderCart(userID uint64, request *domain.CreateOrde
 		return nil, errors.New("failed to generate order ID")
 	}
 
-	addresses, err := s.addressService.GetAddressByID(request.AddressID)
+	addresses, err := s.userAddress(userID, request.AddressID)
 	if err != nil {
-		return nil, errors.New("address not found")
+		return nil, err
 	}
 
 	var orderDetails []entities.OrderDetailsModels
-	var totalQuantity, totalPrice, totalDiscount uint64
+	var stocks []stockRequest
+	var cartItems []*entities.CartModels
+	var discountItems []voucher.DiscountItem
+	var totalQuantity, totalPrice, totalDiscount, totalWeight uint64
 
 	for _, cartItemRequest := range request.CartItems {
 
@@ -439,41 +1432,63 @@ func (s *OrderService) CreateOrderCart(userID uint64, request *domain.CreateOrde
 		if err != nil {
 			return nil, errors.New("cart item not found")
 		}
+		if cartItem.UserID != userID {
+			return nil, domain.ErrCartNotOwned
+		}
 
 		products, err := s.productService.GetProductByID(cartItem.ProductID)
 		if err != nil {
 			return nil, errors.New("product not found")
 		}
 
+		if err := checkAvailable(products); err != nil {
+			return nil, err
+		}
+
+		variant, err := cartVariant(products, cartItem)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkStock(products, variant, cartItem.Quantity); err != nil {
+			return nil, err
+		}
+
+		price, discount, saleItem, err := s.unitPrice(products, variant, cartItem.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
 		orderDetail := entities.OrderDetailsModels{
 			OrderID:       orderID,
 			ProductID:     products.ID,
-			Size:          cartItem.Size,
-			Color:         cartItem.Color,
+			VariantID:     variant.ID,
+			Size:          variant.Size,
+			Color:         variant.Color,
 			Quantity:      cartItem.Quantity,
 			IsReviewed:    false,
-			TotalPrice:    cartItem.Quantity * (products.Price - products.Discount),
-			TotalDiscount: products.Discount * cartItem.Quantity,
+			TotalPrice:    cartItem.Quantity * price,
+			TotalDiscount: discount * cartItem.Quantity,
 		}
 
 		totalQuantity += cartItem.Quantity
 		totalPrice += orderDetail.TotalPrice
 		totalDiscount += orderDetail.TotalDiscount
+		totalWeight += variant.Weight * cartItem.Quantity
 
 		orderDetails = append(orderDetails, orderDetail)
+		stocks = append(stocks, newStockRequest(products, variant, cartItem.Quantity, saleItem))
+		cartItems = append(cartItems, cartItem)
+		discountItems = append(discountItems, newDiscountItem(products, orderDetail.TotalPrice))
+	}
 
-		for _, variant := range products.Variants {
-			if variant.Size == cartItem.Size && variant.Color == cartItem.Color {
-				if err := s.productService.ReduceStockWhenPurchasing(variant.ID, cartItem.Quantity); err != nil {
-					return nil, errors.New("failed reduce stock for variant")
-				}
-				break
-			}
-		}
+	shipment, err := s.shippingQuote(addresses, totalWeight, request.Courier, request.CourierService)
+	if err != nil {
+		return nil, err
 	}
 
 	grandTotalPrice := totalPrice
-	totalAmountPaid := grandTotalPrice + 2000
+	totalAmountPaid := grandTotalPrice + shipment.Cost + adminFees
 
 	newData := &entities.OrderModels{
 		ID:                 orderID,
@@ -483,75 +1498,59 @@ func (s *OrderService) CreateOrderCart(userID uint64, request *domain.CreateOrde
 		Note:               request.Note,
 		GrandTotalQuantity: totalQuantity,
 		GrandTotalPrice:    grandTotalPrice,
-		ShipmentFee:        0,
-		AdminFees:          2000,
+		GrandTotalDiscount: totalDiscount,
+		VoucherCode:        strings.ToUpper(strings.TrimSpace(request.VoucherCode)),
+		ShipmentFee:        shipment.Cost,
+		Courier:            shipment.Courier,
+		CourierService:     shipment.Service,
+		ShipmentETD:        shipment.ETD,
+		TotalWeight:        totalWeight,
+		AdminFees:          adminFees,
 		TotalAmountPaid:    totalAmountPaid,
-		OrderStatus:        "Menunggu Konfirmasi",
-		PaymentStatus:      "Menunggu Konfirmasi",
+		OrderStatus:        string(domain.OrderStatusPending),
+		PaymentStatus:      string(domain.PaymentStatusPending),
 		CreatedAt:          time.Now(),
 		OrderDetails:       orderDetails,
 	}
 
-	createdOrder, err := s.repo.CreateOrder(newData)
-	if err != nil {
-		return nil, err
-	}
-
-	user, err := s.userService.GetUserByID(createdOrder.UserID)
-	if err != nil {
-		return nil, errors.New("user not found")
-	}
-
-	snapResult, err := s.repo.CreateSnap(createdOrder.ID, user.Name, user.Email, createdOrder.TotalAmountPaid)
-	if err != nil {
-		return nil, err
-	}
-
-	notificationRequest := domain.CreateNotificationPaymentRequest{
-		OrderID:       createdOrder.ID,
-		UserID:        createdOrder.UserID,
-		PaymentStatus: "Menunggu Konfirmasi",
-	}
-	_, err = s.SendNotificationPayment(notificationRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, cartItemRequest := range request.CartItems {
-		if err := s.repo.DeleteCartItem(cartItemRequest.ID); err != nil {
-			return nil, errors.New("failed to delete cart item")
-		}
-	}
-
-	response := &domain.CreateOrderResponse{
-		OrderID:         createdOrder.ID,
-		IdOrder:         createdOrder.IdOrder,
-		RedirectURL:     snapResult.RedirectURL,
-		TotalAmountPaid: createdOrder.TotalAmountPaid,
-	}
-	return response, nil
+	return s.checkout(newData, stocks, cartItems, discountItems)
 }
 
-func (s *OrderService) AcceptOrder(orderID string) error {
+func (s *OrderService) AcceptOrder(userID uint64, orderID string) error {
 	orders, err := s.repo.GetOrderByID(orderID)
 	if err != nil {
 		return errors.New("order not found")
 	}
 
+	if orders.UserID != userID {
+		return domain.ErrOrderNotOwned
+	}
+
 	user, err := s.userService.GetUserByID(orders.UserID)
 	if err != nil {
 		return errors.New("user not found")
 	}
 
-	orders.OrderStatus = "Selesai"
+	actor := domain.Actor{ID: user.ID, Role: domain.ActorRoleCustomer}
+	return s.completeOrder(orders, actor, "order accepted by customer")
+}
 
-	if err := s.repo.AcceptOrder(orders.ID, orders.OrderStatus); err != nil {
+// completeOrder moves an order to Selesai, opens the review window of its items and notifies
+// the customer.
+func (s *OrderService) completeOrder(orders *entities.OrderModels, actor domain.Actor, note string) error {
+	err := s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if _, err := transition(uow, orders.ID, domain.OrderStatusCompleted, "", actor, note); err != nil {
+			return err
+		}
+		return uow.OrderRepo.OpenReviews(orders.ID, time.Now())
+	})
+	if err != nil {
 		return err
 	}
 	notificationRequest := domain.CreateNotificationOrderRequest{
 		OrderID:     orders.ID,
-		UserID:      user.ID,
-		OrderStatus: "Selesai",
+		UserID:      orders.UserID,
+		OrderStatus: string(domain.OrderStatusCompleted),
 	}
 	_, err = s.SendNotificationOrder(notificationRequest)
 	if err != nil {
@@ -561,13 +1560,26 @@ func (s *OrderService) AcceptOrder(orderID string) error {
 	return nil
 }
 
-func (s *OrderService) UpdateOrderStatus(req *domain.UpdateOrderStatus) error {
+func (s *OrderService) UpdateOrderStatus(adminID uint64, req *domain.UpdateOrderStatus) error {
+	status := domain.OrderStatus(req.OrderStatus)
+	if !status.IsValid() {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidOrderStatus, req.OrderStatus)
+	}
+	if !status.IsAdminSettable() {
+		return fmt.Errorf("%w: %s can't be set manually", domain.ErrInvalidTransition, status)
+	}
+
 	orders, err := s.repo.GetOrderByID(req.ID)
 	if err != nil {
 		return errors.New("order not found")
 	}
 
-	if err := s.repo.UpdateOrderStatus(orders.ID, req.OrderStatus); err != nil {
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		actor := domain.Actor{ID: adminID, Role: domain.ActorRoleAdmin}
+		_, err := transition(uow, orders.ID, status, "", actor, req.Note)
+		return err
+	})
+	if err != nil {
 		return err
 	}
 
@@ -589,45 +1601,215 @@ func (s *OrderService) UpdateOrderStatus(req *domain.UpdateOrderStatus) error {
 	return nil
 }
 
-func (s *OrderService) GetAllOrdersByUserID(userID uint64, page, pageSize int) ([]*entities.OrderModels, int64, error) {
-	user, err := s.userService.GetUserByID(userID)
+// CancelOrder cancels an order for the customer who placed it, as long as it hasn't been
+// shipped. Unpaid orders have their payment voided and their reservation released. Paid orders
+// are cancelled and restocked first and refunded afterwards, so when the refund fails the order
+// stays cancelled with its payment still paid and calling CancelOrder again retries the refund.
+func (s *OrderService) CancelOrder(userID uint64, req *domain.CancelOrderRequest) error {
+	orders, err := s.repo.GetOrderByID(req.OrderID)
 	if err != nil {
-		return nil, 0, errors.New("user not found")
+		return errors.New("order not found")
 	}
 
-	result, totalItems, err := s.repo.GetAllOrdersByUserID(user.ID, page, pageSize)
-	if err != nil {
-		return nil, 0, err
+	if orders.UserID != userID {
+		return domain.ErrOrderNotOwned
 	}
 
-	return result, totalItems, nil
-}
-
-func (s *OrderService) GetCartById(cartID uint64) (*entities.CartModels, error) {
-	result, err := s.repo.GetCartByID(cartID)
-	if err != nil {
-		return nil, err
+	actor := domain.Actor{ID: userID, Role: domain.ActorRoleCustomer}
+	switch domain.OrderStatus(orders.OrderStatus) {
+	case domain.OrderStatusPending:
+		if err := s.cancelUnpaidOrder(orders, actor, req.Reason); err != nil {
+			return err
+		}
+		s.notifyCancellation(orders, req.Reason)
+		return nil
+	case domain.OrderStatusPaid, domain.OrderStatusProcessing:
+		if err := s.cancelPaidOrder(orders, actor, req.Reason); err != nil {
+			return err
+		}
+	case domain.OrderStatusCancelled:
+		// The refund failed last time, retry it as the order was already cancelled.
+		if domain.PaymentStatus(orders.PaymentStatus) != domain.PaymentStatusPaid {
+			return domain.ErrOrderNotCancellable
+		}
+	default:
+		return domain.ErrOrderNotCancellable
 	}
-	return result, nil
-}
 
-func (s *OrderService) GetAllOrdersWithFilter(userID uint64, orderStatus string, page, pageSize int) ([]*entities.OrderModels, int64, error) {
-	user, err := s.userService.GetUserByID(userID)
+	// The customer and the admins hear of the cancellation once the payment is refunded. A
+	// refund that fails is retried by RetryCancelledRefunds, which notifies them then.
+	refunded, err := s.refundCancelledOrder(orders, actor, req.Reason)
 	if err != nil {
-		return nil, 0, errors.New("user not found")
+		return err
 	}
-
-	result, totalItems, err := s.repo.GetAllOrdersUserWithFilter(user.ID, orderStatus, page, pageSize)
-	if err != nil {
-		return nil, 0, err
+	if refunded {
+		s.notifyCancellation(orders, req.Reason)
 	}
-
-	return result, totalItems, nil
+	return nil
 }
 
-func (s *OrderService) SearchAndPaginateOrder(page, pageSize int, name string) ([]*entities.OrderModels, int64, error) {
-	result, totalItems, err := s.repo.GetAllOrdersSearch(page, pageSize, name)
-	if err != nil {
+// notifyCancellation tells the customer and the admins that the order was cancelled.
+func (s *OrderService) notifyCancellation(orders *entities.OrderModels, reason string) {
+	notificationRequest := domain.CreateNotificationOrderRequest{
+		OrderID:     orders.ID,
+		UserID:      orders.UserID,
+		OrderStatus: string(domain.OrderStatusCancelled),
+	}
+	if _, err := s.SendNotificationOrder(notificationRequest); err != nil {
+		log.Errorf("failed to send cancel notification for order %s: %v", orders.ID, err)
+	}
+	adminNotification := &notification.CreateAdminNotificationRequest{
+		OrderID: orders.IdOrder,
+		Title:   "Pembatalan Pesanan",
+		Message: fmt.Sprintf("Pesanan dengan ID %s dibatalkan oleh %s. Alasan: %s", orders.IdOrder, orders.User.Name, reason),
+	}
+	if err := s.notificationService.NotifyAdmins(adminNotification); err != nil {
+		log.Errorf("failed to notify admins of cancelled order %s: %v", orders.ID, err)
+	}
+}
+
+// cancelUnpaidOrder voids the payment of a pending order and gives back what it reserved.
+func (s *OrderService) cancelUnpaidOrder(orders *entities.OrderModels, actor domain.Actor, reason string) error {
+	err := s.repo.CancelTransaction(orders.ID)
+	if err != nil && !errors.Is(err, payment.ErrTransactionNotFound) {
+		return fmt.Errorf("failed to cancel payment: %v", err)
+	}
+
+	return s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if err := lockPendingPayment(uow, orders.ID); err != nil {
+			if errors.Is(err, errPaymentSettled) {
+				return domain.ErrOrderNotCancellable
+			}
+			return err
+		}
+		if _, err := transition(uow, orders.ID, domain.OrderStatusCancelled, domain.PaymentStatusFailed, actor, reason); err != nil {
+			return err
+		}
+		if err := uow.ProductRepo.ReleaseReservation(orders.ID); err != nil {
+			return err
+		}
+		if err := uow.VoucherRepo.ReleaseVoucher(orders.ID); err != nil {
+			return err
+		}
+		return uow.FlashSaleRepo.ReleaseQuota(orders.ID)
+	})
+}
+
+// cancelPaidOrder cancels a paid order that hasn't been shipped and puts its items back in stock.
+func (s *OrderService) cancelPaidOrder(orders *entities.OrderModels, actor domain.Actor, reason string) error {
+	return s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if _, err := transition(uow, orders.ID, domain.OrderStatusCancelled, "", actor, reason); err != nil {
+			return err
+		}
+
+		for _, detail := range orders.OrderDetails {
+			products, err := uow.ProductRepo.GetProductByID(detail.ProductID)
+			if err != nil {
+				return errors.New("product not found")
+			}
+			variant, err := requestVariant(products, detail.VariantID, detail.Size, detail.Color)
+			if err != nil {
+				return err
+			}
+			restock := product.StockMovement{
+				Reason:    product.MovementCancellation,
+				OrderID:   orders.ID,
+				ActorID:   actor.ID,
+				ActorRole: actor.Role,
+				Note:      reason,
+			}
+			if err := uow.ProductRepo.IncreaseStock(variant.ID, detail.Quantity, restock); err != nil {
+				return err
+			}
+		}
+
+		if err := uow.VoucherRepo.ReleaseVoucher(orders.ID); err != nil {
+			return err
+		}
+		return uow.FlashSaleRepo.ReleaseQuota(orders.ID)
+	})
+}
+
+// refundCancelledOrder refunds the full payment of a cancelled order through the payment
+// gateway. The refund key is derived from the order, so retrying doesn't refund twice. It reports
+// whether this call marked the order refunded, which is false when another one got there first.
+func (s *OrderService) refundCancelledOrder(orders *entities.OrderModels, actor domain.Actor, reason string) (bool, error) {
+	if err := s.repo.RefundPayment(orders.ID, "cancel-"+orders.ID, orders.TotalAmountPaid, reason); err != nil {
+		return false, fmt.Errorf("failed to refund payment: %v", err)
+	}
+
+	refunded := false
+	err := s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		locked, err := uow.OrderRepo.LockOrder(orders.ID)
+		if err != nil {
+			return errors.New("order not found")
+		}
+		if domain.PaymentStatus(locked.PaymentStatus) != domain.PaymentStatusPaid {
+			return nil
+		}
+		refunded = true
+
+		if err := uow.OrderRepo.AddRefundedAmount(orders.ID, orders.TotalAmountPaid); err != nil {
+			return err
+		}
+		if err := uow.OrderRepo.UpdatePayment(orders.ID, locked.OrderStatus, string(domain.PaymentStatusRefunded)); err != nil {
+			return err
+		}
+		return uow.OrderRepo.CreateStatusHistory(&entities.OrderStatusHistoryModels{
+			OrderID:    orders.ID,
+			FromStatus: locked.OrderStatus,
+			ToStatus:   locked.OrderStatus,
+			ActorID:    actor.ID,
+			ActorRole:  actor.Role,
+			Note:       fmt.Sprintf("refunded %d", orders.TotalAmountPaid),
+			CreatedAt:  time.Now(),
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return refunded, nil
+}
+
+func (s *OrderService) GetAllOrdersByUserID(userID uint64, page, pageSize int) ([]*entities.OrderModels, int64, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, 0, errors.New("user not found")
+	}
+
+	result, totalItems, err := s.repo.GetAllOrdersByUserID(user.ID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, totalItems, nil
+}
+
+func (s *OrderService) GetCartById(cartID uint64) (*entities.CartModels, error) {
+	result, err := s.repo.GetCartByID(cartID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *OrderService) GetAllOrdersWithFilter(userID uint64, orderStatus string, page, pageSize int) ([]*entities.OrderModels, int64, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, 0, errors.New("user not found")
+	}
+
+	result, totalItems, err := s.repo.GetAllOrdersUserWithFilter(user.ID, orderStatus, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return result, totalItems, nil
+}
+
+func (s *OrderService) SearchAndPaginateOrder(page, pageSize int, name string) ([]*entities.OrderModels, int64, error) {
+	result, totalItems, err := s.repo.GetAllOrdersSearch(page, pageSize, name)
+	if err != nil {
 		return nil, 0, err
 	}
 	return result, totalItems, nil
@@ -672,3 +1854,690 @@ func (s *OrderService) SearchFilterAndPaginateOrder(page, pageSize int, name, fi
 	}
 	return result, totalItems, nil
 }
+
+func (s *OrderService) GetOrderTimeline(orderID string) ([]*entities.OrderStatusHistoryModels, error) {
+	result, err := s.repo.GetStatusHistory(orderID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// expiryBatchSize caps how many orders a single ExpireUnpaidOrders run looks at.
+const expiryBatchSize = 100
+
+// ExpireUnpaidOrders fails orders that are still waiting for payment after ttl and releases their
+// stock. The gateway is asked first: a payment whose webhook got lost is confirmed instead, and any
+// other unsettled payment, whether pending, denied or in a state we don't know, is cancelled at the
+// gateway before the order is failed. It returns how many orders were expired.
+func (s *OrderService) ExpireUnpaidOrders(ttl time.Duration) (int, error) {
+	orders, err := s.repo.GetUnpaidOrdersBefore(time.Now().Add(-ttl), expiryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, order := range orders {
+		status, err := s.repo.CheckTransaction(order.ID)
+		if errors.Is(err, payment.ErrTransactionNotFound) {
+			// The customer never opened the payment page, so there is nothing to cancel.
+			status, err = domain.Status{PaymentStatus: string(domain.PaymentStatusFailed)}, nil
+		}
+		if err != nil {
+			log.Errorf("failed to check payment of order %s: %v", order.ID, err)
+			continue
+		}
+
+		switch domain.PaymentStatus(status.PaymentStatus) {
+		case domain.PaymentStatusPaid:
+			err = s.ConfirmPayment(order.ID)
+		case domain.PaymentStatusFailed:
+			err = s.failPayment(order.ID, "payment expired")
+		default:
+			if err = s.repo.CancelTransaction(order.ID); err == nil {
+				err = s.failPayment(order.ID, "payment expired")
+			}
+		}
+
+		if errors.Is(err, errPaymentSettled) {
+			continue
+		}
+		if err != nil {
+			log.Errorf("failed to expire order %s: %v", order.ID, err)
+			continue
+		}
+		if domain.PaymentStatus(status.PaymentStatus) != domain.PaymentStatusPaid {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// completionBatchSize caps how many orders a single AutoCompleteOrders run looks at.
+const completionBatchSize = 100
+
+// AutoCompleteOrders completes shipped and delivered orders the customer didn't accept within
+// after, on behalf of the system. Orders with an open return request are left for the admin. It
+// returns how many orders were completed.
+func (s *OrderService) AutoCompleteOrders(after time.Duration) (int, error) {
+	orders, err := s.repo.GetUncompletedOrdersBefore(time.Now().Add(-after), completionBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, order := range orders {
+		note := fmt.Sprintf("completed automatically by system after %d days", int(after.Hours()/24))
+		if err := s.completeOrder(order, domain.SystemActor, note); err != nil {
+			if !errors.Is(err, domain.ErrInvalidTransition) {
+				log.Errorf("failed to auto-complete order %s: %v", order.ID, err)
+			}
+			continue
+		}
+		log.Infof("order %s completed automatically by system", order.ID)
+		completed++
+	}
+
+	return completed, nil
+}
+
+const refundBatchSize = 100
+
+// RetryCancelledRefunds refunds the cancelled orders whose refund failed when they were
+// cancelled, and tells the customer and the admins of the cancellation once it goes through. It
+// returns how many orders were refunded.
+func (s *OrderService) RetryCancelledRefunds() (int, error) {
+	orders, err := s.repo.GetUnrefundedCancelledOrders(refundBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	refunded := 0
+	for _, order := range orders {
+		reason := s.cancellationReason(order.ID)
+		done, err := s.refundCancelledOrder(order, domain.SystemActor, reason)
+		if err != nil {
+			log.Errorf("failed to refund cancelled order %s: %v", order.ID, err)
+			continue
+		}
+		if done {
+			s.notifyCancellation(order, reason)
+			refunded++
+		}
+	}
+
+	return refunded, nil
+}
+
+// cancellationReason returns the reason the order was cancelled with, from its status history.
+func (s *OrderService) cancellationReason(orderID string) string {
+	history, err := s.repo.GetStatusHistory(orderID)
+	if err != nil {
+		return ""
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ToStatus == string(domain.OrderStatusCancelled) && history[i].FromStatus != history[i].ToStatus {
+			return history[i].Note
+		}
+	}
+	return ""
+}
+
+func (s *OrderService) CreateReturn(userID uint64, req *domain.CreateReturnRequest) (*entities.ReturnRequestModels, error) {
+	orders, err := s.repo.GetOrderByID(req.OrderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
+
+	if orders.UserID != userID {
+		return nil, domain.ErrOrderNotOwned
+	}
+
+	if !domain.OrderStatus(orders.OrderStatus).IsReturnable() {
+		return nil, fmt.Errorf("%w: orders that are %s can't be returned", domain.ErrInvalidReturn, orders.OrderStatus)
+	}
+
+	hasOpenReturn, err := s.repo.HasOpenReturn(orders.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasOpenReturn {
+		return nil, fmt.Errorf("%w: order already has a return in progress", domain.ErrInvalidReturn)
+	}
+
+	details := make(map[uint64]entities.OrderDetailsModels)
+	for _, detail := range orders.OrderDetails {
+		details[detail.ID] = detail
+	}
+
+	var items []entities.ReturnItemModels
+	for _, item := range req.Items {
+		detail, exists := details[item.OrderDetailID]
+		if !exists {
+			return nil, fmt.Errorf("%w: item %d is not part of order %s", domain.ErrInvalidReturn, item.OrderDetailID, orders.IdOrder)
+		}
+		delete(details, item.OrderDetailID)
+
+		if item.Quantity > detail.Quantity-detail.ReturnedQuantity {
+			return nil, fmt.Errorf("%w: only %d of item %d can be returned", domain.ErrInvalidReturn, detail.Quantity-detail.ReturnedQuantity, detail.ID)
+		}
+
+		amount := detail.TotalPrice*item.Quantity/detail.Quantity - voucherShare(orders, detail, item.Quantity)
+
+		items = append(items, entities.ReturnItemModels{
+			OrderDetailID: detail.ID,
+			Quantity:      item.Quantity,
+			Amount:        amount,
+		})
+	}
+
+	newReturn := &entities.ReturnRequestModels{
+		OrderID:   orders.ID,
+		UserID:    userID,
+		Reason:    req.Reason,
+		Status:    string(domain.ReturnStatusRequested),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Items:     items,
+	}
+
+	result, err := s.repo.CreateReturn(newReturn)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *OrderService) CreateReturnPhoto(userID uint64, req *domain.CreateReturnPhotoRequest) (*entities.ReturnPhotoModels, error) {
+	returnRequest, err := s.repo.GetReturnByID(req.ReturnID)
+	if err != nil {
+		return nil, errors.New("return request not found")
+	}
+
+	if returnRequest.UserID != userID {
+		return nil, domain.ErrOrderNotOwned
+	}
+
+	if domain.ReturnStatus(returnRequest.Status) != domain.ReturnStatusRequested {
+		return nil, domain.ErrReturnNotPending
+	}
+
+	photo := &entities.ReturnPhotoModels{
+		ReturnID:  returnRequest.ID,
+		URL:       req.Photo,
+		CreatedAt: time.Now(),
+	}
+
+	result, err := s.repo.CreateReturnPhoto(photo)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *OrderService) GetReturnByID(returnID uint64) (*entities.ReturnRequestModels, error) {
+	result, err := s.repo.GetReturnByID(returnID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *OrderService) GetAllReturns(page, pageSize int, status string) ([]*entities.ReturnRequestModels, int64, error) {
+	result, totalItems, err := s.repo.GetPaginatedReturns(page, pageSize, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, totalItems, nil
+}
+
+func (s *OrderService) GetReturnsByUserID(userID uint64, page, pageSize int) ([]*entities.ReturnRequestModels, int64, error) {
+	result, totalItems, err := s.repo.GetReturnsByUserID(userID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, totalItems, nil
+}
+
+// ApproveReturn approves a return request and refunds it through the payment gateway. The
+// request is approved before the gateway is called and only marked as refunded afterwards, so
+// when the refund fails the request stays approved and calling ApproveReturn again retries it
+// with the same refund key. The refund amount is worked out from the order as it is once locked,
+// so refunds and returns settled in the meantime are taken into account.
+func (s *OrderService) ApproveReturn(adminID, returnID uint64, req *domain.ApproveReturnRequest) (*entities.ReturnRequestModels, error) {
+	returnRequest, err := s.repo.GetReturnByID(returnID)
+	if err != nil {
+		return nil, errors.New("return request not found")
+	}
+
+	var approved *entities.ReturnRequestModels
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		orders, err := lockOrderDetails(uow, returnRequest.OrderID)
+		if err != nil {
+			return err
+		}
+		locked, err := uow.OrderRepo.LockReturn(returnID)
+		if err != nil {
+			return errors.New("return request not found")
+		}
+
+		switch domain.ReturnStatus(locked.Status) {
+		case domain.ReturnStatusRequested:
+			amount, err := refundAmount(orders, locked, req.RefundAmount)
+			if err != nil {
+				return err
+			}
+			locked.Status = string(domain.ReturnStatusApproved)
+			locked.Restock = req.Restock
+			locked.AdminNote = req.Note
+			locked.ReviewedBy = adminID
+			locked.RefundAmount = amount
+			locked.RefundKey = fmt.Sprintf("return-%d", locked.ID)
+			if err := uow.OrderRepo.UpdateReturn(locked); err != nil {
+				return err
+			}
+		case domain.ReturnStatusApproved:
+			// The refund failed last time, retry it as it was approved.
+		default:
+			return domain.ErrReturnNotPending
+		}
+
+		approved = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RefundPayment(approved.OrderID, approved.RefundKey, approved.RefundAmount, returnRequest.Reason); err != nil {
+		approved.RefundError = err.Error()
+		if updateErr := s.repo.UpdateReturn(approved); updateErr != nil {
+			log.Errorf("failed to record refund error of return %d: %v", approved.ID, updateErr)
+		}
+		return nil, fmt.Errorf("failed to refund payment: %v", err)
+	}
+
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		orders, err := lockOrderDetails(uow, approved.OrderID)
+		if err != nil {
+			return err
+		}
+		locked, err := uow.OrderRepo.LockReturn(returnID)
+		if err != nil {
+			return errors.New("return request not found")
+		}
+		if domain.ReturnStatus(locked.Status) != domain.ReturnStatusApproved {
+			return domain.ErrReturnNotPending
+		}
+
+		returned := make(map[uint64]uint64)
+		for _, item := range locked.Items {
+			if err := uow.OrderRepo.AddReturnedQuantity(item.OrderDetailID, item.Quantity); err != nil {
+				return err
+			}
+			returned[item.OrderDetailID] += item.Quantity
+
+			if !locked.Restock {
+				continue
+			}
+			products, err := uow.ProductRepo.GetProductByID(item.OrderDetail.ProductID)
+			if err != nil {
+				return errors.New("product not found")
+			}
+			variant, err := requestVariant(products, item.OrderDetail.VariantID, item.OrderDetail.Size, item.OrderDetail.Color)
+			if err != nil {
+				return err
+			}
+			restock := product.StockMovement{
+				Reason:    product.MovementReturn,
+				OrderID:   orders.ID,
+				ActorID:   adminID,
+				ActorRole: domain.ActorRoleAdmin,
+				Note:      fmt.Sprintf("return %d", locked.ID),
+			}
+			if err := uow.ProductRepo.IncreaseStock(variant.ID, item.Quantity, restock); err != nil {
+				return err
+			}
+		}
+
+		if err := uow.OrderRepo.AddRefundedAmount(orders.ID, locked.RefundAmount); err != nil {
+			return err
+		}
+
+		refundedAt := time.Now()
+		locked.Status = string(domain.ReturnStatusRefunded)
+		locked.RefundError = ""
+		locked.RefundedAt = &refundedAt
+		if err := uow.OrderRepo.UpdateReturn(locked); err != nil {
+			return err
+		}
+
+		actor := domain.Actor{ID: adminID, Role: domain.ActorRoleAdmin}
+		note := fmt.Sprintf("return %d refunded %d", locked.ID, locked.RefundAmount)
+		if returnsEverything(orders, returned) {
+			_, err := transition(uow, orders.ID, domain.OrderStatusRefunded, domain.PaymentStatusRefunded, actor, note)
+			return err
+		}
+		return uow.OrderRepo.CreateStatusHistory(&entities.OrderStatusHistoryModels{
+			OrderID:    orders.ID,
+			FromStatus: orders.OrderStatus,
+			ToStatus:   orders.OrderStatus,
+			ActorID:    actor.ID,
+			ActorRole:  actor.Role,
+			Note:       note,
+			CreatedAt:  refundedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetReturnByID(returnID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.SendNotificationReturn(result); err != nil {
+		log.Errorf("failed to send return notification for return %d: %v", result.ID, err)
+	}
+	return result, nil
+}
+
+func (s *OrderService) RejectReturn(adminID, returnID uint64, req *domain.RejectReturnRequest) error {
+	err := s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		locked, err := uow.OrderRepo.LockReturn(returnID)
+		if err != nil {
+			return errors.New("return request not found")
+		}
+		if domain.ReturnStatus(locked.Status) != domain.ReturnStatusRequested {
+			return domain.ErrReturnNotPending
+		}
+
+		locked.Status = string(domain.ReturnStatusRejected)
+		locked.AdminNote = req.Note
+		locked.ReviewedBy = adminID
+		return uow.OrderRepo.UpdateReturn(locked)
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := s.repo.GetReturnByID(returnID)
+	if err != nil {
+		return err
+	}
+	if err := s.SendNotificationReturn(result); err != nil {
+		log.Errorf("failed to send return notification for return %d: %v", result.ID, err)
+	}
+	return nil
+}
+
+// voucherShare returns the part of the order's voucher discount that was taken off quantity units
+// of detail. Orders placed before the discount was recorded per detail spread it over all details
+// by price.
+func voucherShare(orders *entities.OrderModels, detail entities.OrderDetailsModels, quantity uint64) uint64 {
+	if orders.VoucherDiscount == 0 {
+		return 0
+	}
+
+	var recorded uint64
+	for _, orderDetail := range orders.OrderDetails {
+		recorded += orderDetail.VoucherDiscount
+	}
+	if recorded > 0 {
+		return detail.VoucherDiscount * quantity / detail.Quantity
+	}
+
+	if orders.GrandTotalPrice == 0 {
+		return 0
+	}
+	amount := detail.TotalPrice * quantity / detail.Quantity
+	return amount * orders.VoucherDiscount / orders.GrandTotalPrice
+}
+
+// lockOrderDetails locks the order and reads it again along with its details inside the
+// transaction, so what is decided from it can't be undone by a concurrent refund or return.
+func lockOrderDetails(uow *domain.UnitOfWork, orderID string) (*entities.OrderModels, error) {
+	if _, err := uow.OrderRepo.LockOrder(orderID); err != nil {
+		return nil, errors.New("order not found")
+	}
+	orders, err := uow.OrderRepo.GetOrderByID(orderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
+	return orders, nil
+}
+
+// refundAmount returns how much a return refunds. By default that is the price paid for the
+// returned items, or everything left of the payment, fees included, when the return covers the
+// rest of the order. An admin may refund less than that, never more.
+func refundAmount(orders *entities.OrderModels, returnRequest *entities.ReturnRequestModels, requested uint64) (uint64, error) {
+	var remaining uint64
+	if orders.TotalAmountPaid > orders.TotalRefunded {
+		remaining = orders.TotalAmountPaid - orders.TotalRefunded
+	}
+
+	var itemsTotal uint64
+	returned := make(map[uint64]uint64)
+	for _, item := range returnRequest.Items {
+		itemsTotal += item.Amount
+		returned[item.OrderDetailID] += item.Quantity
+	}
+
+	maxAmount := itemsTotal
+	if returnsEverything(orders, returned) || maxAmount > remaining {
+		maxAmount = remaining
+	}
+
+	if requested == 0 {
+		return maxAmount, nil
+	}
+	if requested > maxAmount {
+		return 0, fmt.Errorf("%w: refund amount can't exceed %d", domain.ErrInvalidReturn, maxAmount)
+	}
+	return requested, nil
+}
+
+// returnsEverything reports whether returning the given quantities per order detail leaves
+// nothing of the order with the customer.
+func returnsEverything(orders *entities.OrderModels, returned map[uint64]uint64) bool {
+	for _, detail := range orders.OrderDetails {
+		if detail.ReturnedQuantity+returned[detail.ID] < detail.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *OrderService) SendNotificationReturn(returnRequest *entities.ReturnRequestModels) error {
+	var notificationMsg string
+
+	user, err := s.userService.GetUserByID(returnRequest.UserID)
+	if err != nil {
+		return err
+	}
+
+	switch domain.ReturnStatus(returnRequest.Status) {
+	case domain.ReturnStatusRefunded:
+		notificationMsg = fmt.Sprintf("Halo, %s! Pengembalian untuk pesanan dengan ID %s telah disetujui dan dana sebesar Rp%d telah dikembalikan.", user.Name, returnRequest.Order.IdOrder, returnRequest.RefundAmount)
+	case domain.ReturnStatusRejected:
+		notificationMsg = fmt.Sprintf("Maaf, %s. Pengembalian untuk pesanan dengan ID %s ditolak: %s", user.Name, returnRequest.Order.IdOrder, returnRequest.AdminNote)
+	default:
+		return errors.New("Status pengembalian tidak valid")
+	}
+
+	req := &notification.CreateNotificationRequest{
+		UserID:  user.ID,
+		OrderID: returnRequest.Order.IdOrder,
+		Title:   "Status Pengembalian",
+		Message: notificationMsg,
+	}
+	_, err = s.notificationService.CreateNotification(req)
+	if err != nil {
+		return errors.New("error send message")
+	}
+
+	return nil
+}
+
+// ShipOrder moves a processed order to Pengiriman and records the airway bill the courier
+// issued for it, so the shipment can be tracked.
+func (s *OrderService) ShipOrder(adminID uint64, req *domain.ShipOrderRequest) (*entities.ShipmentModels, error) {
+	orders, err := s.repo.GetOrderByID(req.OrderID)
+	if err != nil {
+		return nil, errors.New("order not found")
+	}
+
+	courier := req.Courier
+	service := req.Service
+	if courier == "" {
+		courier = orders.Courier
+		service = orders.CourierService
+	}
+	if courier == "" {
+		return nil, errors.New("courier is required for orders placed without one")
+	}
+
+	var result *entities.ShipmentModels
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		actor := domain.Actor{ID: adminID, Role: domain.ActorRoleAdmin}
+		note := fmt.Sprintf("shipped with %s %s, airway bill %s", strings.ToUpper(courier), service, req.AirwayBill)
+		if req.Note != "" {
+			note = req.Note
+		}
+		if _, err := transition(uow, orders.ID, domain.OrderStatusShipped, "", actor, note); err != nil {
+			return err
+		}
+
+		shipment := &entities.ShipmentModels{
+			OrderID:    orders.ID,
+			Courier:    courier,
+			Service:    service,
+			AirwayBill: req.AirwayBill,
+			Status:     "ON PROCESS",
+			ShippedAt:  time.Now(),
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+		result, err = uow.OrderRepo.CreateShipment(shipment)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notificationRequest := domain.CreateNotificationOrderRequest{
+		OrderID:     orders.ID,
+		UserID:      orders.UserID,
+		OrderStatus: string(domain.OrderStatusShipped),
+	}
+	if _, err := s.SendNotificationOrder(notificationRequest); err != nil {
+		log.Errorf("failed to send shipping notification for order %s: %v", orders.ID, err)
+	}
+
+	return result, nil
+}
+
+func (s *OrderService) GetShipmentByOrderID(orderID string) (*entities.ShipmentModels, error) {
+	result, err := s.repo.GetShipmentByOrderID(orderID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TrackShipments polls the courier for shipments that haven't been delivered yet, stores new
+// tracking events and marks orders as delivered once the courier reports so. It returns how
+// many shipments were delivered. A shipment that can't be tracked is skipped until the next
+// run.
+func (s *OrderService) TrackShipments() (int, error) {
+	shipments, err := s.repo.GetShipmentsInTransit(100)
+	if err != nil {
+		return 0, err
+	}
+
+	var delivered int
+	for _, shipment := range shipments {
+		isDelivered, err := s.trackShipment(shipment)
+		if err != nil {
+			log.Errorf("failed to track shipment of order %s: %v", shipment.OrderID, err)
+			continue
+		}
+		if isDelivered {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+func (s *OrderService) trackShipment(shipment *entities.ShipmentModels) (bool, error) {
+	checkedAt := time.Now()
+	shipment.LastCheckedAt = &checkedAt
+
+	result, err := s.repo.TrackShipment(shipment.Courier, shipment.AirwayBill)
+	if err != nil {
+		shipment.TrackingError = err.Error()
+		if updateErr := s.repo.UpdateShipment(shipment); updateErr != nil {
+			log.Errorf("failed to record tracking error of order %s: %v", shipment.OrderID, updateErr)
+		}
+		return false, err
+	}
+
+	var events []*entities.ShipmentEventModels
+	for _, event := range result.Events {
+		events = append(events, &entities.ShipmentEventModels{
+			ShipmentID:  shipment.ID,
+			Description: event.Description,
+			Location:    event.Location,
+			OccurredAt:  event.OccurredAt,
+			CreatedAt:   checkedAt,
+		})
+	}
+
+	shipment.Status = result.Status
+	shipment.Receiver = result.Receiver
+	shipment.TrackingError = ""
+
+	var notifyUserID uint64
+	err = s.uow.Transaction(func(uow *domain.UnitOfWork) error {
+		if _, err := uow.OrderRepo.CreateShipmentEvents(events); err != nil {
+			return err
+		}
+		if !result.Delivered {
+			return uow.OrderRepo.UpdateShipment(shipment)
+		}
+
+		shipment.DeliveredAt = &checkedAt
+		orders, err := uow.OrderRepo.LockOrder(shipment.OrderID)
+		if err != nil {
+			return errors.New("order not found")
+		}
+		// The customer may have accepted the order before the courier reported the delivery.
+		if domain.OrderStatus(orders.OrderStatus) == domain.OrderStatusShipped {
+			note := "delivered according to courier tracking"
+			if result.Receiver != "" {
+				note = fmt.Sprintf("delivered to %s according to courier tracking", result.Receiver)
+			}
+			if _, err := transition(uow, orders.ID, domain.OrderStatusDelivered, "", domain.SystemActor, note); err != nil {
+				return err
+			}
+			notifyUserID = orders.UserID
+		}
+		return uow.OrderRepo.UpdateShipment(shipment)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if notifyUserID != 0 {
+		notificationRequest := domain.CreateNotificationOrderRequest{
+			OrderID:     shipment.OrderID,
+			UserID:      notifyUserID,
+			OrderStatus: string(domain.OrderStatusDelivered),
+		}
+		if _, err := s.SendNotificationOrder(notificationRequest); err != nil {
+			log.Errorf("failed to send delivery notification for order %s: %v", shipment.OrderID, err)
+		}
+	}
+	return result.Delivered, nil
+}
@@ -1 +1,853 @@
 package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	addressMocks "ruti-store/module/feature/address/mocks"
+	flashSaleMocks "ruti-store/module/feature/flashsale/mocks"
+	notification "ruti-store/module/feature/notification/domain"
+	notificationMocks "ruti-store/module/feature/notification/mocks"
+	"ruti-store/module/feature/order/domain"
+	"ruti-store/module/feature/order/mocks"
+	"ruti-store/module/feature/order/repository"
+	product "ruti-store/module/feature/product/domain"
+	productMocks "ruti-store/module/feature/product/mocks"
+	userMocks "ruti-store/module/feature/user/mocks"
+	voucherMocks "ruti-store/module/feature/voucher/mocks"
+	utils "ruti-store/utils/mocks"
+	"ruti-store/utils/payment"
+	"ruti-store/utils/token"
+	"ruti-store/utils/tracking"
+)
+
+func runTransaction(repo *mocks.OrderRepositoryInterface) func(func(*domain.UnitOfWork) error) error {
+	return func(fn func(*domain.UnitOfWork) error) error {
+		return fn(&domain.UnitOfWork{OrderRepo: repo})
+	}
+}
+
+func TestOrderService_UpdateOrderStatus(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("Failed Case - Unknown Status", func(t *testing.T) {
+		req := &domain.UpdateOrderStatus{ID: "order-1", OrderStatus: "Hilang"}
+
+		err := service.UpdateOrderStatus(1, req)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidOrderStatus))
+	})
+
+	t.Run("Failed Case - Status Not Settable By Admin", func(t *testing.T) {
+		req := &domain.UpdateOrderStatus{ID: "order-1", OrderStatus: string(domain.OrderStatusRefunded)}
+
+		err := service.UpdateOrderStatus(1, req)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidTransition))
+	})
+
+	t.Run("Failed Case - Shipped Without Airway Bill", func(t *testing.T) {
+		req := &domain.UpdateOrderStatus{ID: "order-1", OrderStatus: string(domain.OrderStatusShipped)}
+
+		err := service.UpdateOrderStatus(1, req)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidTransition))
+		repo.AssertNotCalled(t, "GetOrderByID", mock.Anything)
+	})
+
+	t.Run("Failed Case - Invalid Transition", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:            "order-1",
+			UserID:        2,
+			OrderStatus:   string(domain.OrderStatusPending),
+			PaymentStatus: string(domain.PaymentStatusPending),
+		}
+		req := &domain.UpdateOrderStatus{ID: order.ID, OrderStatus: string(domain.OrderStatusDelivered)}
+
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+		repo.On("LockOrder", order.ID).Return(order, nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+
+		err := service.UpdateOrderStatus(1, req)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidTransition))
+		repo.AssertNotCalled(t, "UpdatePayment", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_AcceptOrder(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("Failed Case - Order Of Another User", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:          "order-1",
+			UserID:      2,
+			OrderStatus: string(domain.OrderStatusShipped),
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+
+		err := service.AcceptOrder(3, order.ID)
+
+		assert.Equal(t, domain.ErrOrderNotOwned, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_Reorder(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	productService := productMocks.NewProductServiceInterface(t)
+	flashSaleService := flashSaleMocks.NewFlashSaleServiceInterface(t)
+	service := NewOrderService(repo, nil, nil, productService, nil, nil, nil, flashSaleService, nil)
+
+	t.Run("Failed Case - Order Of Another User", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:           "order-1",
+			UserID:       2,
+			OrderDetails: []entities.OrderDetailsModels{{ProductID: 1, Size: "M", Color: "Hitam", Quantity: 2}},
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+
+		result, err := service.Reorder(3, order.ID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOrderNotOwned, err)
+		repo.AssertNotCalled(t, "CreateCart", mock.Anything)
+	})
+
+	t.Run("Success Case - Added And Skipped Items", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:     "order-2",
+			UserID: 2,
+			OrderDetails: []entities.OrderDetailsModels{
+				{ProductID: 1, Size: "M", Color: "Hitam", Quantity: 2, Product: entities.ProductModels{Name: "Kemeja Linen"}},
+				{ProductID: 1, Size: "L", Color: "Hitam", Quantity: 2, Product: entities.ProductModels{Name: "Kemeja Linen"}},
+				{ProductID: 1, Size: "XL", Color: "Hitam", Quantity: 1, Product: entities.ProductModels{Name: "Kemeja Linen"}},
+				{ProductID: 1, Size: "S", Color: "Hitam", Quantity: 1, Product: entities.ProductModels{Name: "Kemeja Linen"}},
+				{ProductID: 2, Size: "M", Color: "Putih", Quantity: 1, Product: entities.ProductModels{Name: "Kaos Polos"}},
+			},
+		}
+		linen := &entities.ProductModels{ID: 1, Name: "Kemeja Linen", Price: 100000, Discount: 10000, Variants: []entities.ProductVariantModels{
+			{ID: 11, ProductID: 1, Size: "M", Color: "Hitam", Stock: 10},
+			{ID: 12, ProductID: 1, Size: "L", Color: "Hitam", Stock: 3},
+			{ID: 13, ProductID: 1, Size: "XL", Color: "Hitam", Stock: 0},
+		}}
+		inactive := &entities.ProductModels{ID: 2, Name: "Kaos Polos", Status: product.ProductStatusInactive}
+		inCart := &entities.CartModels{ID: 5, UserID: 2, ProductID: 1, VariantID: 12, Quantity: 2, Price: 90000}
+
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+		productService.On("GetProductByID", uint64(1)).Return(linen, nil).Times(4)
+		productService.On("GetProductByID", uint64(2)).Return(inactive, nil).Once()
+		repo.On("GetCartItem", uint64(2), uint64(11)).Return(nil, errors.New("record not found")).Once()
+		repo.On("GetCartItem", uint64(2), uint64(12)).Return(inCart, nil).Once()
+		repo.On("GetCartItem", uint64(2), uint64(13)).Return(nil, errors.New("record not found")).Once()
+		flashSaleService.On("GetActiveItems", []uint64{1}).Return(nil, nil).Twice()
+		repo.On("CreateCart", mock.MatchedBy(func(cart *entities.CartModels) bool {
+			return cart.UserID == 2 && cart.VariantID == 11 && cart.Quantity == 2 && cart.Price == 90000
+		})).Return(&entities.CartModels{ID: 6}, nil).Once()
+		repo.On("UpdateCartItem", mock.MatchedBy(func(cart *entities.CartModels) bool {
+			return cart.ID == 5 && cart.Quantity == 3
+		})).Return(nil).Once()
+
+		result, err := service.Reorder(2, order.ID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "order-2", result.OrderID)
+		assert.Equal(t, []*domain.ReorderItemResponse{
+			{ProductID: 1, VariantID: 11, Name: "Kemeja Linen", Size: "M", Color: "Hitam", Requested: 2, Quantity: 2},
+			{ProductID: 1, VariantID: 12, Name: "Kemeja Linen", Size: "L", Color: "Hitam", Requested: 2, Quantity: 1, Issue: domain.CartIssueOutOfStock},
+		}, result.Added)
+		assert.Equal(t, []*domain.ReorderItemResponse{
+			{ProductID: 1, VariantID: 13, Name: "Kemeja Linen", Size: "XL", Color: "Hitam", Requested: 1, Issue: domain.CartIssueOutOfStock},
+			{ProductID: 1, Name: "Kemeja Linen", Size: "S", Color: "Hitam", Requested: 1, Issue: domain.CartIssueVariantUnavailable},
+			{ProductID: 2, Name: "Kaos Polos", Size: "M", Color: "Putih", Requested: 1, Issue: domain.CartIssueInactive},
+		}, result.Skipped)
+		repo.AssertExpectations(t)
+		productService.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_CancelOrder(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	userService := userMocks.NewUserServiceInterface(t)
+	notificationService := notificationMocks.NewNotificationServiceInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, userService, notificationService, nil, nil)
+
+	t.Run("Failed Case - Order Of Another User", func(t *testing.T) {
+		order := &entities.OrderModels{ID: "order-1", UserID: 2, OrderStatus: string(domain.OrderStatusPaid)}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+
+		err := service.CancelOrder(3, &domain.CancelOrderRequest{OrderID: order.ID, Reason: "salah ukuran"})
+
+		assert.Equal(t, domain.ErrOrderNotOwned, err)
+		uow.AssertNotCalled(t, "Transaction", mock.Anything)
+	})
+
+	t.Run("Failed Case - Order Already Shipped", func(t *testing.T) {
+		order := &entities.OrderModels{ID: "order-2", UserID: 2, OrderStatus: string(domain.OrderStatusShipped)}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+
+		err := service.CancelOrder(2, &domain.CancelOrderRequest{OrderID: order.ID, Reason: "salah ukuran"})
+
+		assert.Equal(t, domain.ErrOrderNotCancellable, err)
+		uow.AssertNotCalled(t, "Transaction", mock.Anything)
+	})
+
+	t.Run("Success Case - Retry Failed Refund", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:              "order-3",
+			UserID:          2,
+			TotalAmountPaid: 150000,
+			OrderStatus:     string(domain.OrderStatusCancelled),
+			PaymentStatus:   string(domain.PaymentStatusPaid),
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Twice()
+		repo.On("RefundPayment", order.ID, "cancel-order-3", uint64(150000), "salah ukuran").Return(nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("LockOrder", order.ID).Return(order, nil).Once()
+		repo.On("AddRefundedAmount", order.ID, uint64(150000)).Return(nil).Once()
+		repo.On("UpdatePayment", order.ID, string(domain.OrderStatusCancelled), string(domain.PaymentStatusRefunded)).Return(nil).Once()
+		repo.On("CreateStatusHistory", mock.Anything).Return(nil).Once()
+		userService.On("GetUserByID", uint64(2)).Return(&entities.UserModels{ID: 2, Name: "Rina"}, nil).Once()
+		notificationService.On("CreateNotification", mock.Anything).Return(&entities.NotificationModels{ID: 1}, nil).Once()
+		notificationService.On("NotifyAdmins", mock.Anything).Return(nil).Once()
+
+		err := service.CancelOrder(2, &domain.CancelOrderRequest{OrderID: order.ID, Reason: "salah ukuran"})
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+		notificationService.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Refund Failed Notifies Nobody", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:              "order-4",
+			UserID:          2,
+			TotalAmountPaid: 150000,
+			OrderStatus:     string(domain.OrderStatusCancelled),
+			PaymentStatus:   string(domain.PaymentStatusPaid),
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+		repo.On("RefundPayment", order.ID, "cancel-order-4", uint64(150000), "salah ukuran").Return(errors.New("gateway down")).Once()
+
+		err := service.CancelOrder(2, &domain.CancelOrderRequest{OrderID: order.ID, Reason: "salah ukuran"})
+
+		assert.NotNil(t, err)
+		repo.AssertExpectations(t)
+		// Only the refund retried above notified the admins.
+		notificationService.AssertNumberOfCalls(t, "NotifyAdmins", 1)
+	})
+}
+
+func TestOrderService_RetryCancelledRefunds(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	userService := userMocks.NewUserServiceInterface(t)
+	notificationService := notificationMocks.NewNotificationServiceInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, userService, notificationService, nil, nil)
+
+	refunded := &entities.OrderModels{
+		ID:              "order-1",
+		IdOrder:         "INV/2026/10/000001",
+		UserID:          2,
+		TotalAmountPaid: 100000,
+		OrderStatus:     string(domain.OrderStatusCancelled),
+		PaymentStatus:   string(domain.PaymentStatusPaid),
+	}
+	failing := &entities.OrderModels{
+		ID:              "order-2",
+		UserID:          2,
+		TotalAmountPaid: 50000,
+		OrderStatus:     string(domain.OrderStatusCancelled),
+		PaymentStatus:   string(domain.PaymentStatusPaid),
+	}
+	history := []*entities.OrderStatusHistoryModels{
+		{OrderID: refunded.ID, FromStatus: string(domain.OrderStatusPaid), ToStatus: string(domain.OrderStatusCancelled), Note: "salah ukuran"},
+	}
+
+	repo.On("GetUnrefundedCancelledOrders", refundBatchSize).Return([]*entities.OrderModels{refunded, failing}, nil).Once()
+	repo.On("GetStatusHistory", refunded.ID).Return(history, nil).Once()
+	repo.On("GetStatusHistory", failing.ID).Return(nil, nil).Once()
+	repo.On("RefundPayment", refunded.ID, "cancel-order-1", uint64(100000), "salah ukuran").Return(nil).Once()
+	repo.On("RefundPayment", failing.ID, "cancel-order-2", uint64(50000), "").Return(errors.New("gateway down")).Once()
+	uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+	repo.On("LockOrder", refunded.ID).Return(refunded, nil).Once()
+	repo.On("AddRefundedAmount", refunded.ID, uint64(100000)).Return(nil).Once()
+	repo.On("UpdatePayment", refunded.ID, string(domain.OrderStatusCancelled), string(domain.PaymentStatusRefunded)).Return(nil).Once()
+	repo.On("CreateStatusHistory", mock.Anything).Return(nil).Once()
+	repo.On("GetOrderByID", refunded.ID).Return(refunded, nil).Once()
+	userService.On("GetUserByID", uint64(2)).Return(&entities.UserModels{ID: 2, Name: "Rina"}, nil).Once()
+	notificationService.On("CreateNotification", mock.Anything).Return(&entities.NotificationModels{ID: 1}, nil).Once()
+	notificationService.On("NotifyAdmins", mock.MatchedBy(func(req *notification.CreateAdminNotificationRequest) bool {
+		return req.OrderID == refunded.IdOrder
+	})).Return(nil).Once()
+
+	count, err := service.RetryCancelledRefunds()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	repo.AssertExpectations(t)
+	notificationService.AssertExpectations(t)
+}
+
+func TestOrderService_CreateReturn(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, nil)
+
+	order := &entities.OrderModels{
+		ID:          "order-1",
+		UserID:      2,
+		OrderStatus: string(domain.OrderStatusCompleted),
+		OrderDetails: []entities.OrderDetailsModels{
+			{ID: 10, OrderID: "order-1", Quantity: 2, TotalPrice: 20000, ReturnedQuantity: 1},
+		},
+	}
+
+	t.Run("Failed Case - Order Of Another User", func(t *testing.T) {
+		req := &domain.CreateReturnRequest{OrderID: order.ID}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+
+		result, err := service.CreateReturn(3, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrOrderNotOwned, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Order Not Delivered", func(t *testing.T) {
+		shipped := &entities.OrderModels{ID: "order-2", UserID: 2, OrderStatus: string(domain.OrderStatusShipped)}
+		req := &domain.CreateReturnRequest{OrderID: shipped.ID}
+		repo.On("GetOrderByID", shipped.ID).Return(shipped, nil).Once()
+
+		result, err := service.CreateReturn(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrInvalidReturn))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Quantity Already Returned", func(t *testing.T) {
+		req := &domain.CreateReturnRequest{
+			OrderID: order.ID,
+			Items:   []domain.ReturnItemRequest{{OrderDetailID: 10, Quantity: 2}},
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+		repo.On("HasOpenReturn", order.ID).Return(false, nil).Once()
+
+		result, err := service.CreateReturn(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrInvalidReturn))
+		repo.AssertNotCalled(t, "CreateReturn", mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		req := &domain.CreateReturnRequest{
+			OrderID: order.ID,
+			Reason:  "Ukuran tidak sesuai",
+			Items:   []domain.ReturnItemRequest{{OrderDetailID: 10, Quantity: 1}},
+		}
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Once()
+		repo.On("HasOpenReturn", order.ID).Return(false, nil).Once()
+		repo.On("CreateReturn", mock.MatchedBy(func(r *entities.ReturnRequestModels) bool {
+			return len(r.Items) == 1 && r.Items[0].Amount == 10000 && r.Status == string(domain.ReturnStatusRequested)
+		})).Return(&entities.ReturnRequestModels{ID: 1}, nil).Once()
+
+		result, err := service.CreateReturn(2, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), result.ID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case - Voucher Share Of Each Item", func(t *testing.T) {
+		discounted := &entities.OrderModels{
+			ID:              "order-2",
+			UserID:          2,
+			OrderStatus:     string(domain.OrderStatusCompleted),
+			GrandTotalPrice: 60000,
+			VoucherDiscount: 6000,
+			OrderDetails: []entities.OrderDetailsModels{
+				{ID: 20, OrderID: "order-2", Quantity: 2, TotalPrice: 40000},
+				{ID: 21, OrderID: "order-2", Quantity: 2, TotalPrice: 20000, VoucherDiscount: 6000},
+			},
+		}
+		req := &domain.CreateReturnRequest{
+			OrderID: discounted.ID,
+			Reason:  "Ukuran tidak sesuai",
+			Items:   []domain.ReturnItemRequest{{OrderDetailID: 20, Quantity: 1}, {OrderDetailID: 21, Quantity: 1}},
+		}
+		repo.On("GetOrderByID", discounted.ID).Return(discounted, nil).Once()
+		repo.On("HasOpenReturn", discounted.ID).Return(false, nil).Once()
+		repo.On("CreateReturn", mock.MatchedBy(func(r *entities.ReturnRequestModels) bool {
+			return len(r.Items) == 2 && r.Items[0].Amount == 20000 && r.Items[1].Amount == 7000
+		})).Return(&entities.ReturnRequestModels{ID: 2}, nil).Once()
+
+		result, err := service.CreateReturn(2, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), result.ID)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_GetShippingOptions(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	addressService := addressMocks.NewAddressServiceInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, addressService, nil, nil, nil, nil)
+
+	address := &entities.AddressModels{ID: 1, UserID: 2, CityID: "151", CityName: "Jakarta Barat"}
+
+	t.Run("Failed Case - Address Of Another User", func(t *testing.T) {
+		req := &domain.ShippingOptionsRequest{AddressID: 1, CartItems: []domain.CartItemRequest{{ID: 5}}}
+		addressService.On("GetAddressByID", req.AddressID).Return(address, nil).Once()
+
+		result, err := service.GetShippingOptions(3, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrAddressNotOwned, err)
+		repo.AssertNotCalled(t, "GetCartByID", mock.Anything)
+		repo.AssertNotCalled(t, "GetShippingOptions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed Case - Cart Item Of Another User", func(t *testing.T) {
+		req := &domain.ShippingOptionsRequest{AddressID: 1, CartItems: []domain.CartItemRequest{{ID: 5}}}
+		addressService.On("GetAddressByID", req.AddressID).Return(address, nil).Once()
+		repo.On("GetCartByID", uint64(5)).Return(&entities.CartModels{ID: 5, UserID: 3}, nil).Once()
+
+		result, err := service.GetShippingOptions(2, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrCartNotOwned, err)
+		repo.AssertNotCalled(t, "GetShippingOptions", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Nothing To Ship", func(t *testing.T) {
+		req := &domain.ShippingOptionsRequest{AddressID: 1}
+		addressService.On("GetAddressByID", req.AddressID).Return(address, nil).Once()
+
+		result, err := service.GetShippingOptions(2, req)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		addressService.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_UpdateCartQuantity(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	flashSaleService := flashSaleMocks.NewFlashSaleServiceInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, flashSaleService, nil)
+
+	cartItem := func() *entities.CartModels {
+		return &entities.CartModels{
+			ID: 5, UserID: 2, ProductID: 1, VariantID: 11, Size: "M", Color: "Hitam", Quantity: 1, Price: 90000,
+			Product: entities.ProductModels{
+				ID: 1, Name: "Kemeja", Price: 100000, Discount: 5000,
+				Variants: []entities.ProductVariantModels{
+					{ID: 10, Size: "S", Color: "Hitam", Stock: 10},
+					{ID: 11, Size: "M", Color: "Hitam", Stock: 3},
+				},
+			},
+		}
+	}
+
+	t.Run("Failed Case - Cart Item Of Another User", func(t *testing.T) {
+		repo.On("GetCartByID", uint64(5)).Return(cartItem(), nil).Once()
+
+		result, err := service.UpdateCartQuantity(3, 5, &domain.UpdateCartRequest{Quantity: 2})
+
+		assert.Nil(t, result)
+		assert.Equal(t, domain.ErrCartNotOwned, err)
+		repo.AssertNotCalled(t, "UpdateCartItem", mock.Anything)
+	})
+
+	t.Run("Failed Case - Not Enough Stock", func(t *testing.T) {
+		repo.On("GetCartByID", uint64(5)).Return(cartItem(), nil).Once()
+
+		result, err := service.UpdateCartQuantity(2, 5, &domain.UpdateCartRequest{Quantity: 4})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, product.ErrOutOfStock))
+		repo.AssertNotCalled(t, "UpdateCartItem", mock.Anything)
+	})
+
+	t.Run("Success Case - Price Snapshot Refreshed", func(t *testing.T) {
+		repo.On("GetCartByID", uint64(5)).Return(cartItem(), nil).Once()
+		flashSaleService.On("GetActiveItems", []uint64{1}).Return([]*entities.FlashSaleItemModels{}, nil).Once()
+		repo.On("UpdateCartItem", mock.MatchedBy(func(cart *entities.CartModels) bool {
+			return cart.ID == 5 && cart.VariantID == 11 && cart.Quantity == 3 && cart.Price == 95000
+		})).Return(nil).Once()
+
+		result, err := service.UpdateCartQuantity(2, 5, &domain.UpdateCartRequest{Quantity: 3})
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), result.Quantity)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_MergeGuestCart(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	cartToken := utils.NewCartTokenInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, cartToken)
+
+	products := entities.ProductModels{
+		ID:    1,
+		Price: 100000,
+		Variants: []entities.ProductVariantModels{
+			{ID: 11, Size: "M", Color: "Hitam", Stock: 5},
+			{ID: 12, Size: "L", Color: "Hitam", Stock: 3},
+			{ID: 13, Size: "XL", Color: "Hitam", Stock: 0},
+		},
+	}
+
+	t.Run("Failed Case - Invalid Cart Token", func(t *testing.T) {
+		cartToken.On("ValidateCartToken", "forged").Return("", token.ErrInvalidCartToken).Once()
+
+		err := service.MergeGuestCart(2, "forged")
+
+		assert.True(t, errors.Is(err, token.ErrInvalidCartToken))
+		uow.AssertNotCalled(t, "Transaction", mock.Anything)
+	})
+
+	t.Run("Success Case - Quantities Combined Within Stock", func(t *testing.T) {
+		guestItems := []*entities.GuestCartModels{
+			{ID: 21, GuestID: "guest-1", ProductID: 1, VariantID: 11, Quantity: 4, Price: 100000, Product: products},
+			{ID: 22, GuestID: "guest-1", ProductID: 1, VariantID: 12, Quantity: 7, Price: 100000, Product: products},
+			{ID: 23, GuestID: "guest-1", ProductID: 1, VariantID: 13, Quantity: 1, Price: 100000, Product: products},
+		}
+		cartToken.On("ValidateCartToken", "token-1").Return("guest-1", nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("GetCartByGuestID", "guest-1").Return(guestItems, nil).Once()
+		repo.On("DeleteGuestCartItem", mock.Anything).Return(nil).Times(3)
+		repo.On("GetCartItem", uint64(2), uint64(11)).Return(&entities.CartModels{ID: 5, UserID: 2, VariantID: 11, Quantity: 3}, nil).Once()
+		repo.On("UpdateCartItem", mock.MatchedBy(func(cartItem *entities.CartModels) bool {
+			return cartItem.ID == 5 && cartItem.Quantity == 5
+		})).Return(nil).Once()
+		repo.On("GetCartItem", uint64(2), uint64(12)).Return(nil, errors.New("record not found")).Once()
+		repo.On("CreateCart", mock.MatchedBy(func(cartItem *entities.CartModels) bool {
+			return cartItem.UserID == 2 && cartItem.VariantID == 12 && cartItem.Quantity == 3
+		})).Return(&entities.CartModels{ID: 6}, nil).Once()
+		repo.On("GetCartItem", uint64(2), uint64(13)).Return(nil, errors.New("record not found")).Once()
+
+		err := service.MergeGuestCart(2, "token-1")
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestRevalidateCartItem(t *testing.T) {
+	cartItem := &entities.CartModels{
+		ID: 5, UserID: 2, ProductID: 1, VariantID: 11, Quantity: 2, Price: 95000,
+		Product: entities.ProductModels{
+			ID: 1, Name: "Kemeja", Price: 100000, Discount: 5000,
+			Variants: []entities.ProductVariantModels{{ID: 11, Size: "M", Color: "Hitam", Stock: 1, Weight: 250}},
+		},
+	}
+	saleItems := []*entities.FlashSaleItemModels{{ID: 7, ProductID: 1, VariantID: 11, SalePrice: 80000, Quota: 10}}
+
+	item := revalidateCartItem(cartItem, saleItems)
+
+	assert.Equal(t, uint64(80000), item.Price)
+	assert.Equal(t, uint64(160000), item.Subtotal)
+	assert.Equal(t, uint64(40000), item.Discount)
+	assert.Equal(t, uint64(500), item.Weight)
+	assert.Equal(t, []string{domain.CartIssueOutOfStock, domain.CartIssuePriceChanged}, item.Issues)
+	assert.False(t, item.Available)
+
+	cartItem.Product.Status = product.ProductStatusInactive
+	cartItem.VariantID = 12
+
+	item = revalidateCartItem(cartItem, saleItems)
+
+	assert.Equal(t, []string{domain.CartIssueInactive, domain.CartIssueVariantUnavailable}, item.Issues)
+	assert.False(t, item.Available)
+}
+
+func TestVariantPrice(t *testing.T) {
+	xxlPrice := uint64(120000)
+	products := &entities.ProductModels{ID: 1, Name: "Kemeja", Price: 100000, Discount: 5000}
+	regular := &entities.ProductVariantModels{ID: 11, Size: "M", Color: "Hitam"}
+	xxl := &entities.ProductVariantModels{ID: 12, Size: "XXL", Color: "Hitam", Price: &xxlPrice}
+
+	price, discount, saleItem := variantPrice(products, regular, 1, nil)
+	assert.Equal(t, uint64(95000), price)
+	assert.Equal(t, uint64(5000), discount)
+	assert.Nil(t, saleItem)
+
+	price, discount, _ = variantPrice(products, xxl, 1, nil)
+	assert.Equal(t, uint64(115000), price)
+	assert.Equal(t, uint64(5000), discount)
+
+	saleItems := []*entities.FlashSaleItemModels{{ID: 7, ProductID: 1, VariantID: 12, SalePrice: 110000, Quota: 10}}
+	price, discount, saleItem = variantPrice(products, xxl, 1, saleItems)
+	assert.Equal(t, uint64(110000), price)
+	assert.Equal(t, uint64(10000), discount)
+	assert.NotNil(t, saleItem)
+}
+
+func TestRequestVariant(t *testing.T) {
+	products := &entities.ProductModels{
+		ID: 1, Name: "Kemeja",
+		Variants: []entities.ProductVariantModels{
+			{ID: 11, Size: "M", Color: "Hitam"},
+			{ID: 12, Size: "L", Color: "Hitam"},
+		},
+	}
+
+	variant, err := requestVariant(products, 12, "M", "Hitam")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), variant.ID)
+
+	variant, err = requestVariant(products, 0, "M", "Hitam")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(11), variant.ID)
+
+	_, err = requestVariant(products, 13, "", "")
+	assert.EqualError(t, err, "variant 13 of product Kemeja not found")
+}
+
+func TestOrderService_TrackShipments(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, nil)
+
+	t.Run("Success Case - Tracking Error Is Recorded And Skipped", func(t *testing.T) {
+		shipment := &entities.ShipmentModels{ID: 1, OrderID: "order-1", Courier: "jne", AirwayBill: "RESI-1"}
+		repo.On("GetShipmentsInTransit", 100).Return([]*entities.ShipmentModels{shipment}, nil).Once()
+		repo.On("TrackShipment", "jne", "RESI-1").Return(nil, tracking.ErrWaybillNotFound).Once()
+		repo.On("UpdateShipment", mock.MatchedBy(func(s *entities.ShipmentModels) bool {
+			return s.TrackingError != "" && s.LastCheckedAt != nil
+		})).Return(nil).Once()
+
+		delivered, err := service.TrackShipments()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, delivered)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case - Delivered After The Customer Accepted", func(t *testing.T) {
+		shipment := &entities.ShipmentModels{ID: 2, OrderID: "order-2", Courier: "jne", AirwayBill: "RESI-2"}
+		order := &entities.OrderModels{ID: "order-2", UserID: 2, OrderStatus: string(domain.OrderStatusCompleted)}
+		result := &tracking.Result{
+			Delivered: true,
+			Status:    "DELIVERED",
+			Events:    []tracking.Event{{Description: "Paket telah diterima"}},
+		}
+		repo.On("GetShipmentsInTransit", 100).Return([]*entities.ShipmentModels{shipment}, nil).Once()
+		repo.On("TrackShipment", "jne", "RESI-2").Return(result, nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("CreateShipmentEvents", mock.Anything).Return(int64(1), nil).Once()
+		repo.On("LockOrder", "order-2").Return(order, nil).Once()
+		repo.On("UpdateShipment", mock.MatchedBy(func(s *entities.ShipmentModels) bool {
+			return s.DeliveredAt != nil && s.Status == "DELIVERED"
+		})).Return(nil).Once()
+
+		delivered, err := service.TrackShipments()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, delivered)
+		repo.AssertNotCalled(t, "UpdatePayment", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+}
+
+// gatewayOrderRepository asks a real payment gateway for the status of a transaction and cancels
+// it there, and mocks everything else.
+type gatewayOrderRepository struct {
+	*mocks.OrderRepositoryInterface
+	gateway domain.OrderRepositoryInterface
+}
+
+func (r *gatewayOrderRepository) CheckTransaction(orderID string) (domain.Status, error) {
+	return r.gateway.CheckTransaction(orderID)
+}
+
+func (r *gatewayOrderRepository) CancelTransaction(orderID string) error {
+	return r.gateway.CancelTransaction(orderID)
+}
+
+func TestOrderService_ExpireUnpaidOrders(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	productRepo := productMocks.NewProductRepositoryInterface(t)
+	voucherRepo := voucherMocks.NewVoucherRepositoryInterface(t)
+	flashSaleRepo := flashSaleMocks.NewFlashSaleRepositoryInterface(t)
+	userService := userMocks.NewUserServiceInterface(t)
+	notificationService := notificationMocks.NewNotificationServiceInterface(t)
+	gateway := payment.NewFakeGateway("server-key")
+	orderRepo := &gatewayOrderRepository{
+		OrderRepositoryInterface: repo,
+		gateway:                  repository.NewOrderRepository(nil, gateway, nil, nil),
+	}
+	service := NewOrderService(orderRepo, uow, nil, nil, nil, userService, notificationService, nil, nil)
+
+	t.Run("Success Case - Denied Payment Is Expired", func(t *testing.T) {
+		order := &entities.OrderModels{ID: "order-1", IdOrder: "INV/2026/10/000001", UserID: 2,
+			TotalAmountPaid: 12000, OrderStatus: string(domain.OrderStatusPending), PaymentStatus: string(domain.PaymentStatusPending)}
+		_, err := gateway.CreateCharge(payment.ChargeRequest{OrderID: order.ID, Amount: order.TotalAmountPaid})
+		assert.Nil(t, err)
+		_, err = gateway.Deny(order.ID)
+		assert.Nil(t, err)
+
+		repo.On("GetUnpaidOrdersBefore", mock.Anything, expiryBatchSize).Return([]*entities.OrderModels{order}, nil).Once()
+		repo.On("GetOrderByID", order.ID).Return(order, nil).Twice()
+		uow.On("Transaction", mock.Anything).Return(func(fn func(*domain.UnitOfWork) error) error {
+			return fn(&domain.UnitOfWork{OrderRepo: repo, ProductRepo: productRepo, VoucherRepo: voucherRepo, FlashSaleRepo: flashSaleRepo})
+		}).Once()
+		repo.On("LockOrder", order.ID).Return(order, nil).Twice()
+		repo.On("UpdatePayment", order.ID, string(domain.OrderStatusFailed), string(domain.PaymentStatusFailed)).Return(nil).Once()
+		repo.On("CreateStatusHistory", mock.Anything).Return(nil).Once()
+		productRepo.On("ReleaseReservation", order.ID).Return(nil).Once()
+		voucherRepo.On("ReleaseVoucher", order.ID).Return(nil).Once()
+		flashSaleRepo.On("ReleaseQuota", order.ID).Return(nil).Once()
+		userService.On("GetUserByID", uint64(2)).Return(&entities.UserModels{ID: 2, Name: "Rina"}, nil).Once()
+		notificationService.On("CreateNotification", mock.Anything).Return(&entities.NotificationModels{ID: 1}, nil).Once()
+
+		expired, err := service.ExpireUnpaidOrders(24 * time.Hour)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, expired)
+		result, err := gateway.GetStatus(order.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, "cancel", result.TransactionStatus)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_AutoCompleteOrders(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	userService := userMocks.NewUserServiceInterface(t)
+	notificationService := notificationMocks.NewNotificationServiceInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, userService, notificationService, nil, nil)
+
+	t.Run("Success Case - Accepted Orders Are Skipped", func(t *testing.T) {
+		accepted := &entities.OrderModels{ID: "order-2", UserID: 2, OrderStatus: string(domain.OrderStatusShipped)}
+		repo.On("GetUncompletedOrdersBefore", mock.Anything, 100).Return([]*entities.OrderModels{accepted}, nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("LockOrder", "order-2").Return(&entities.OrderModels{ID: "order-2", OrderStatus: string(domain.OrderStatusCompleted)}, nil).Once()
+
+		completed, err := service.AutoCompleteOrders(7 * 24 * time.Hour)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, completed)
+		repo.AssertNotCalled(t, "OpenReviews", mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case - Completed By System", func(t *testing.T) {
+		shipped := &entities.OrderModels{ID: "order-1", IdOrder: "INV/2026/10/000001", UserID: 2,
+			OrderStatus: string(domain.OrderStatusShipped), PaymentStatus: string(domain.PaymentStatusPaid)}
+		repo.On("GetUncompletedOrdersBefore", mock.Anything, 100).Return([]*entities.OrderModels{shipped}, nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("LockOrder", "order-1").Return(shipped, nil).Once()
+		repo.On("UpdatePayment", "order-1", string(domain.OrderStatusCompleted), string(domain.PaymentStatusPaid)).Return(nil).Once()
+		repo.On("CreateStatusHistory", mock.MatchedBy(func(history *entities.OrderStatusHistoryModels) bool {
+			return history.OrderID == "order-1" &&
+				history.FromStatus == string(domain.OrderStatusShipped) &&
+				history.ToStatus == string(domain.OrderStatusCompleted) &&
+				history.ActorID == domain.SystemActor.ID &&
+				history.ActorRole == domain.SystemActor.Role &&
+				history.Note == "completed automatically by system after 7 days"
+		})).Return(nil).Once()
+		repo.On("OpenReviews", "order-1", mock.Anything).Return(nil).Once()
+		userService.On("GetUserByID", uint64(2)).Return(&entities.UserModels{ID: 2, Name: "Rina"}, nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(shipped, nil).Once()
+		notificationService.On("CreateNotification", mock.MatchedBy(func(req *notification.CreateNotificationRequest) bool {
+			return req.UserID == 2 && req.OrderID == "INV/2026/10/000001" && req.Title == "Status Pesanan" &&
+				req.Message == "Selamat, Rina! Pesanan dengan ID INV/2026/10/000001 sudah sampai tujuan. Semoga Anda puas!"
+		})).Return(&entities.NotificationModels{ID: 1}, nil).Once()
+
+		completed, err := service.AutoCompleteOrders(7 * 24 * time.Hour)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, completed)
+		repo.AssertExpectations(t)
+		notificationService.AssertExpectations(t)
+	})
+}
+
+func TestOrderService_CallBack(t *testing.T) {
+	repo := mocks.NewOrderRepositoryInterface(t)
+	uow := mocks.NewUnitOfWorkInterface(t)
+	service := NewOrderService(repo, uow, nil, nil, nil, nil, nil, nil, nil)
+
+	payload := []byte(`{"order_id":"order-1","transaction_status":"settlement"}`)
+	notification := func(signatureValid bool) *payment.Notification {
+		return &payment.Notification{
+			OrderID:           "order-1",
+			StatusCode:        "200",
+			GrossAmount:       "12000.00",
+			TransactionStatus: "settlement",
+			Status:            payment.StatusPaid,
+			SignatureValid:    signatureValid,
+		}
+	}
+	withOutcome := func(outcome string) interface{} {
+		return mock.MatchedBy(func(event *entities.PaymentEventModels) bool {
+			return event.OrderID == "order-1" && event.Outcome == outcome
+		})
+	}
+
+	t.Run("Failed Case - Invalid Signature", func(t *testing.T) {
+		repo.On("ParseNotification", payload).Return(notification(false), nil).Once()
+		repo.On("CreatePaymentEvent", withOutcome(domain.PaymentEventRejected)).Return(nil).Once()
+
+		err := service.CallBack(payload)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidSignature))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Amount Mismatch", func(t *testing.T) {
+		order := &entities.OrderModels{ID: "order-1", TotalAmountPaid: 2000, OrderStatus: string(domain.OrderStatusPending)}
+		repo.On("ParseNotification", payload).Return(notification(true), nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(order, nil).Once()
+		repo.On("CreatePaymentEvent", withOutcome(domain.PaymentEventRejected)).Return(nil).Once()
+
+		err := service.CallBack(payload)
+
+		assert.True(t, errors.Is(err, domain.ErrAmountMismatch))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case - Duplicate Notification Is A No-op", func(t *testing.T) {
+		order := &entities.OrderModels{
+			ID:              "order-1",
+			TotalAmountPaid: 12000,
+			OrderStatus:     string(domain.OrderStatusPaid),
+			PaymentStatus:   string(domain.PaymentStatusPaid),
+		}
+		repo.On("ParseNotification", payload).Return(notification(true), nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(order, nil).Twice()
+		repo.On("CheckTransaction", "order-1").Return(domain.Status{PaymentStatus: string(domain.PaymentStatusPaid)}, nil).Once()
+		repo.On("LockOrder", "order-1").Return(order, nil).Once()
+		uow.On("Transaction", mock.Anything).Return(runTransaction(repo)).Once()
+		repo.On("CreatePaymentEvent", withOutcome(domain.PaymentEventDuplicate)).Return(nil).Once()
+
+		err := service.CallBack(payload)
+
+		assert.Nil(t, err)
+		repo.AssertNotCalled(t, "UpdatePayment", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOutOfStock is returned when a variant doesn't have enough stock left for a purchase.
+var ErrOutOfStock = errors.New("out of stock")
+
+// ErrCountBelowReserved is returned when a stock-take counts fewer units of a variant than are
+// reserved for unpaid orders.
+var ErrCountBelowReserved = errors.New("counted stock is below the reserved stock")
+
+// ErrProductUnavailable is returned when a product that was deleted or taken off sale is bought.
+var ErrProductUnavailable = errors.New("product is not available")
+
+// ErrVariantNotFound is returned when no variant has the id, SKU or barcode looked for.
+var ErrVariantNotFound = errors.New("variant not found")
+
+// ErrSKUTaken is returned when an SKU is already used by another variant.
+var ErrSKUTaken = errors.New("sku is already used by another variant")
+
+// ErrBarcodeTaken is returned when a barcode is already used by another variant.
+var ErrBarcodeTaken = errors.New("barcode is already used by another variant")
+
+// ErrVariantExists is returned when a product already has a variant with the same options.
+var ErrVariantExists = errors.New("product already has a variant with these options")
+
+// ErrInvalidVariantOptions is returned when the options of a variant are unknown or name an
+// option type twice.
+var ErrInvalidVariantOptions = errors.New("invalid variant options")
+
+// ErrOptionTypeNotFound is returned when a value is added to an option type that doesn't exist.
+var ErrOptionTypeNotFound = errors.New("option type not found")
+
+// ErrOptionExists is returned when an option type or value is created twice.
+var ErrOptionExists = errors.New("option already exists")
+
+// ErrInvalidVariantPrice is returned when a variant's price doesn't cover the product discount.
+var ErrInvalidVariantPrice = errors.New("variant price must be greater than the product discount")
+
+// ErrInvalidImportFile is returned when a product import file can't be read as a whole, e.g. when
+// it has no rows or is missing a column.
+var ErrInvalidImportFile = errors.New("invalid import file")
+
+// The statuses a product can be set to. A product that is Tidak Aktif is taken off sale.
+// UpdateStatusRequest.Status lists them in its oneof tag.
+const (
+	ProductStatusActive   = "Aktif"
+	ProductStatusInactive = "Tidak Aktif"
+)
+
+const (
+	ReservationReserved  = "reserved"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+)
+
+// Reasons recorded in the inventory ledger for a change of stock.
+const (
+	MovementInitial        = "initial"
+	MovementSale           = "sale"
+	MovementCancellation   = "cancellation"
+	MovementReturn         = "return"
+	MovementAdjustment     = "adjustment"
+	MovementStockTake      = "stock_take"
+	MovementReconciliation = "reconciliation"
+	MovementImport         = "import"
+)
+
+// Option types whose values are also kept in a variant's size and color, which orders and carts
+// still show.
+const (
+	OptionTypeSize  = "Size"
+	OptionTypeColor = "Color"
+)
+
+// DefaultLowStockThreshold is the low-stock threshold of a variant created without one.
+const DefaultLowStockThreshold = 5
+
+// MovementActorSystem is the actor role of stock changes nobody made by hand.
+const MovementActorSystem = "system"
+
+// DefaultSKU is the SKU a variant is given when it's created without one.
+func DefaultSKU(productID, variantID uint64) string {
+	return fmt.Sprintf("SKU-%d-%d", productID, variantID)
+}
+
+// Orders the product search can sort its results in.
+const (
+	SearchSortRelevance   = "relevance"
+	SearchSortPriceAsc    = "price_asc"
+	SearchSortPriceDesc   = "price_desc"
+	SearchSortNewest      = "newest"
+	SearchSortBestSelling = "best_selling"
+	SearchSortRating      = "rating"
+)
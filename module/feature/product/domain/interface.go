@@ -3,6 +3,7 @@ package domain
 import (
 	"github.com/gofiber/fiber/v2"
 	"ruti-store/module/entities"
+	"time"
 )
 
 type ProductRepositoryInterface interface {
@@ -17,13 +18,42 @@ type ProductRepositoryInterface interface {
 	GetProductReviews(page, perPage int) ([]*entities.ProductModels, error)
 	AddPhotoProduct(newData *entities.ProductPhotoModels) (*entities.ProductPhotoModels, error)
 	UpdateProductPhoto(productID uint64, newPhotoURL string) error
-	ReduceStockWhenPurchasing(productID, quantity uint64) error
-	IncreaseStock(productID, quantity uint64) error
+	ReduceStockWhenPurchasing(variantID, quantity uint64, movement StockMovement) error
+	IncreaseStock(variantID, quantity uint64, movement StockMovement) error
+	AdjustStock(variantID uint64, delta int64, movement StockMovement) error
+	SetStock(variantID, counted uint64, movement StockMovement) error
+	GetStockMovements(variantID uint64, page, pageSize int) ([]*entities.InventoryMovementModels, int64, error)
+	GetStockDiscrepancies() ([]*StockDiscrepancyResponse, error)
+	ReconcileStock(variantID uint64, movement StockMovement) error
+	UpdateLowStockThreshold(variantID, threshold uint64) error
+	GetLowStockVariants(unnotifiedOnly bool) ([]*LowStockResponse, error)
+	MarkLowStockNotified(variantID uint64, notifiedAt time.Time) (bool, error)
+	UnmarkLowStockNotified(variantID uint64) error
+	ResetLowStockAlerts() error
+	GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error)
+	ReserveStock(orderID string, variantID, quantity uint64) error
+	CommitReservation(orderID string) error
+	ReleaseReservation(orderID string) error
 	GenerateRecommendationProduct() ([]string, error)
 	FindAllProductRecommendation(productsFromAI []string) ([]*entities.ProductModels, error)
-	SearchAndPaginateProducts(name string, page, pageSize int) ([]*entities.ProductModels, int64, error)
+	SearchProducts(req *SearchProductRequest, page, pageSize int) ([]*entities.ProductModels, int64, error)
+	GetSearchFacets(req *SearchProductRequest) (*SearchFacetResponse, error)
 	CreateVariantProduct(newData *entities.ProductVariantModels) (*entities.ProductVariantModels, error)
 	UpdateProductStatus(productID uint64, status string) error
+	GetVariantsBySKU(skus []string) ([]*entities.ProductVariantModels, error)
+	GetVariantsByBarcode(barcodes []string) ([]*entities.ProductVariantModels, error)
+	GetVariantByCode(code string) (*entities.ProductVariantModels, error)
+	UpdateVariantCodes(variantID uint64, sku string, barcode *string) error
+	UpdateVariantPrice(variantID uint64, price *uint64) error
+	GetOptionTypes() ([]*entities.OptionTypeModels, error)
+	GetOptionTypeByID(optionTypeID uint64) (*entities.OptionTypeModels, error)
+	CreateOptionType(newData *entities.OptionTypeModels) (*entities.OptionTypeModels, error)
+	CreateOptionValue(newData *entities.OptionValueModels) (*entities.OptionValueModels, error)
+	GetOptionValuesByIDs(optionValueIDs []uint64) ([]*entities.OptionValueModels, error)
+	GetProductsByNames(names []string) ([]*entities.ProductModels, error)
+	GetCategoriesByNames(names []string) ([]*entities.CategoryModels, error)
+	ImportProducts(products []*ImportProduct, movement StockMovement) error
+	GetProductsForExport() ([]*entities.ProductModels, error)
 }
 
 type ProductServiceInterface interface {
@@ -38,13 +68,30 @@ type ProductServiceInterface interface {
 	GetProductReviews(page, perPage int) ([]*entities.ProductModels, int64, error)
 	AddPhotoProducts(req *AddPhotoProductRequest) (*entities.ProductPhotoModels, error)
 	UpdatePhotoProduct(productID uint64, photo string) error
-	ReduceStockWhenPurchasing(productID, quantity uint64) error
-	IncreaseStock(productID, quantity uint64) error
+	ReduceStockWhenPurchasing(variantID, quantity uint64, movement StockMovement) error
+	IncreaseStock(variantID, quantity uint64, movement StockMovement) error
+	AdjustStock(adminID uint64, req *AdjustStockRequest) (*entities.ProductVariantModels, error)
+	StockTake(adminID uint64, req *StockTakeRequest) (*entities.ProductVariantModels, error)
+	GetStockMovements(variantID uint64, page, pageSize int) ([]*entities.InventoryMovementModels, int64, error)
+	GetStockDiscrepancies() ([]*StockDiscrepancyResponse, error)
+	ReconcileStock(adminID, variantID uint64) error
+	UpdateLowStockThreshold(req *LowStockThresholdRequest) (*entities.ProductVariantModels, error)
+	GetLowStockVariants() ([]*LowStockResponse, error)
+	CheckLowStock() (int, error)
 	GetProductRecommendation() ([]string, error)
 	GetAllProductsRecommendation() ([]*entities.ProductModels, error)
-	SearchAndPaginateProducts(name string, page, pageSize int) ([]*entities.ProductModels, int64, error)
+	SearchProducts(req *SearchProductRequest, page, pageSize int) ([]*entities.ProductModels, int64, error)
+	GetSearchFacets(req *SearchProductRequest) (*SearchFacetResponse, error)
 	CreateVariantProduct(req *CreateVariantRequest) (*entities.ProductVariantModels, error)
 	UpdateStatusProduct(req *UpdateStatusRequest) error
+	UpdateVariantCodes(req *UpdateVariantCodesRequest) (*VariantProductResponse, error)
+	UpdateVariantPrice(req *UpdateVariantPriceRequest) (*VariantProductResponse, error)
+	GetOptionTypes() ([]*entities.OptionTypeModels, error)
+	CreateOptionType(req *CreateOptionTypeRequest) (*entities.OptionTypeModels, error)
+	CreateOptionValue(req *CreateOptionValueRequest) (*entities.OptionValueModels, error)
+	LookupVariant(code string) (*VariantLookupResponse, error)
+	ImportProducts(adminID uint64, records [][]string, dryRun bool) (*ImportProductResponse, error)
+	ExportProducts() ([][]interface{}, error)
 }
 
 type ProductHandlerInterface interface {
@@ -58,6 +105,22 @@ type ProductHandlerInterface interface {
 	UpdatePhotoProduct(c *fiber.Ctx) error
 	GetProductRecommendation(c *fiber.Ctx) error
 	GetAllProductsRecommendation(c *fiber.Ctx) error
+	SearchProducts(c *fiber.Ctx) error
 	CreateVariantProduct(c *fiber.Ctx) error
 	UpdateStatusProduct(c *fiber.Ctx) error
+	AdjustStock(c *fiber.Ctx) error
+	StockTake(c *fiber.Ctx) error
+	GetStockHistory(c *fiber.Ctx) error
+	GetStockDiscrepancies(c *fiber.Ctx) error
+	ReconcileStock(c *fiber.Ctx) error
+	UpdateLowStockThreshold(c *fiber.Ctx) error
+	GetLowStockReport(c *fiber.Ctx) error
+	UpdateVariantCodes(c *fiber.Ctx) error
+	UpdateVariantPrice(c *fiber.Ctx) error
+	GetOptionTypes(c *fiber.Ctx) error
+	CreateOptionType(c *fiber.Ctx) error
+	CreateOptionValue(c *fiber.Ctx) error
+	LookupVariant(c *fiber.Ctx) error
+	ImportProducts(c *fiber.Ctx) error
+	ExportProducts(c *fiber.Ctx) error
 }
@@ -0,0 +1,41 @@
+package domain
+
+import "ruti-store/module/entities"
+
+// VariantPrice is the regular price of a variant: its own price when it overrides the product's,
+// the product's price otherwise.
+func VariantPrice(product *entities.ProductModels, variant *entities.ProductVariantModels) uint64 {
+	if variant != nil && variant.Price != nil {
+		return *variant.Price
+	}
+	return product.Price
+}
+
+// VariantDiscount is the product's discount off the regular price of a variant. It never takes
+// a cheaper variant below zero.
+func VariantDiscount(product *entities.ProductModels, variant *entities.ProductVariantModels) uint64 {
+	price := VariantPrice(product, variant)
+	if product.Discount > price {
+		return price
+	}
+	return product.Discount
+}
+
+// PriceRange returns the lowest and highest regular price of the product's variants, or the
+// product's price twice when its variants aren't loaded.
+func PriceRange(product *entities.ProductModels) (uint64, uint64) {
+	if len(product.Variants) == 0 {
+		return product.Price, product.Price
+	}
+	low, high := VariantPrice(product, &product.Variants[0]), VariantPrice(product, &product.Variants[0])
+	for i := range product.Variants[1:] {
+		price := VariantPrice(product, &product.Variants[i+1])
+		if price < low {
+			low = price
+		}
+		if price > high {
+			high = price
+		}
+	}
+	return low, high
+}
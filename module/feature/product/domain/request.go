@@ -1,5 +1,7 @@
 package domain
 
+import "ruti-store/module/entities"
+
 type CreateProductRequest struct {
 	Name        string   `json:"name" validate:"required"`
 	Price       uint64   `json:"price" validate:"required"`
@@ -29,14 +31,123 @@ type UpdatePhotoProductRequest struct {
 }
 
 type CreateVariantRequest struct {
-	ProductID uint64 `json:"product_id"`
-	Size      string `json:"size"`
-	Color     string `json:"color"`
-	Weight    uint64 `json:"weight"`
-	Stock     uint64 `json:"stock"`
+	ProductID         uint64   `json:"product_id"`
+	SKU               string   `json:"sku" validate:"omitempty,max=64"`
+	Barcode           string   `json:"barcode" validate:"omitempty,ean13"`
+	Size              string   `json:"size"`
+	Color             string   `json:"color"`
+	OptionValueIDs    []uint64 `json:"option_value_ids"`
+	Price             *uint64  `json:"price"`
+	Weight            uint64   `json:"weight"`
+	Stock             uint64   `json:"stock"`
+	LowStockThreshold *uint64  `json:"low_stock_threshold"`
+}
+
+// UpdateVariantPriceRequest overrides the product's price for a variant. Without a price the
+// variant is sold at the product's price again.
+type UpdateVariantPriceRequest struct {
+	VariantID uint64  `json:"variant_id" validate:"required"`
+	Price     *uint64 `json:"price"`
+}
+
+type CreateOptionTypeRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type CreateOptionValueRequest struct {
+	OptionTypeID uint64 `json:"option_type_id" validate:"required"`
+	Value        string `json:"value" validate:"required"`
 }
 
 type UpdateStatusRequest struct {
 	ProductID uint64 `json:"product_id" validate:"required"`
-	Status    string `json:"status" validate:"required"`
+	Status    string `json:"status" validate:"required,oneof=Aktif 'Tidak Aktif'"`
+}
+
+type AdjustStockRequest struct {
+	VariantID uint64 `json:"variant_id" validate:"required"`
+	Quantity  int64  `json:"quantity" validate:"required"`
+	Reason    string `json:"reason" validate:"required"`
+}
+
+// UpdateVariantCodesRequest sets the SKU and barcode of a variant. An empty barcode removes it.
+type UpdateVariantCodesRequest struct {
+	VariantID uint64 `json:"variant_id" validate:"required"`
+	SKU       string `json:"sku" validate:"required,max=64"`
+	Barcode   string `json:"barcode" validate:"omitempty,ean13"`
+}
+
+// LowStockThresholdRequest sets the stock at or below which admins are warned about a variant.
+// A threshold of 0 turns the warning off.
+type LowStockThresholdRequest struct {
+	VariantID uint64 `json:"variant_id" validate:"required"`
+	Threshold uint64 `json:"threshold"`
+}
+
+// StockTakeRequest sets a variant's stock from what was counted in the warehouse, reserved units
+// included.
+type StockTakeRequest struct {
+	VariantID uint64 `json:"variant_id" validate:"required"`
+	Stock     uint64 `json:"stock"`
+	Note      string `json:"note"`
+}
+
+// ProductImportHeaders are the columns of a product import file, which is also the layout of the
+// product export. Each row is one variant along with the product it belongs to; rows of the same
+// product share its name. Categories and images hold comma-separated lists. The stock column only
+// sets the stock of new variants.
+var ProductImportHeaders = []string{
+	"sku", "barcode", "name", "description", "price", "discount", "status",
+	"categories", "size", "color", "stock", "weight", "images",
+}
+
+// ProductImportRow is a row of a product import file. Row is its line number in the file.
+type ProductImportRow struct {
+	Row         int
+	SKU         string
+	Barcode     string
+	Name        string
+	Description string
+	Price       uint64
+	Discount    uint64
+	Status      string
+	Categories  []string
+	Size        string
+	Color       string
+	Stock       uint64
+	Weight      uint64
+	Images      []string
+}
+
+// ImportProduct is a product to upsert in an import with the variants that go into it. A product
+// or variant with an ID of 0 is created, the others are updated.
+type ImportProduct struct {
+	Product     *entities.ProductModels
+	CategoryIDs []uint64
+	Images      []string
+	Variants    []*entities.ProductVariantModels
+}
+
+// StockMovement says why a variant's stock changes, to be recorded in the inventory ledger.
+type StockMovement struct {
+	Reason    string
+	OrderID   string
+	ActorID   uint64
+	ActorRole string
+	Note      string
+}
+
+// SearchProductRequest is the query string of the product search. Categories, sizes and colors
+// can be given more than once to match any of them. Prices are what a customer pays for a
+// variant, after the product's discount.
+type SearchProductRequest struct {
+	Query       string   `query:"q"`
+	CategoryIDs []uint64 `query:"category_id"`
+	MinPrice    *uint64  `query:"min_price"`
+	MaxPrice    *uint64  `query:"max_price"`
+	MinRating   float64  `query:"min_rating" validate:"omitempty,min=0,max=5"`
+	Sizes       []string `query:"size"`
+	Colors      []string `query:"color"`
+	InStock     bool     `query:"in_stock"`
+	Sort        string   `query:"sort" validate:"omitempty,oneof=relevance price_asc price_desc newest best_selling rating"`
 }
@@ -9,6 +9,8 @@ type ProductsResponse struct {
 	ID           uint64                    `json:"id"`
 	Name         string                    `json:"name"`
 	Price        uint64                    `json:"price"`
+	MinPrice     uint64                    `json:"min_price"`
+	MaxPrice     uint64                    `json:"max_price"`
 	Description  string                    `json:"description"`
 	Discount     uint64                    `json:"discount"`
 	Rating       float64                   `json:"rating"`
@@ -17,6 +19,23 @@ type ProductsResponse struct {
 	CreatedAt    time.Time                 `json:"created_at"`
 	Photos       []ProductPhotoResponse    `json:"photos"`
 	Variants     []*VariantProductResponse `json:"variants"`
+	FlashSale    *ProductFlashSaleResponse `json:"flash_sale"`
+}
+
+type ProductFlashSaleResponse struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	SalePrice uint64    `json:"sale_price"`
+	StartAt   time.Time `json:"start_at"`
+	EndAt     time.Time `json:"end_at"`
+	Countdown int64     `json:"countdown"`
+}
+
+type VariantFlashSaleResponse struct {
+	SalePrice uint64 `json:"sale_price"`
+	Quota     uint64 `json:"quota"`
+	Sold      uint64 `json:"sold"`
+	Remaining uint64 `json:"remaining"`
 }
 
 type ProductPhotoResponse struct {
@@ -25,13 +44,20 @@ type ProductPhotoResponse struct {
 }
 
 type VariantProductResponse struct {
-	ID        uint64    `json:"id"`
-	Size      string    `json:"size"`
-	Color     string    `json:"color"`
-	Stock     uint64    `json:"stock"`
-	Weight    uint64    `json:"weight"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	ID            uint64                    `json:"id"`
+	SKU           string                    `json:"sku"`
+	Barcode       *string                   `json:"barcode"`
+	Size          string                    `json:"size"`
+	Color         string                    `json:"color"`
+	Options       []VariantOptionResponse   `json:"options"`
+	Price         uint64                    `json:"price"`
+	Discount      uint64                    `json:"discount"`
+	Stock         uint64                    `json:"stock"`
+	ReservedStock uint64                    `json:"reserved_stock"`
+	Weight        uint64                    `json:"weight"`
+	Status        string                    `json:"status"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	FlashSale     *VariantFlashSaleResponse `json:"flash_sale"`
 }
 
 func ResponseDetailProducts(data *entities.ProductModels) *ProductsResponse {
@@ -46,30 +72,100 @@ func ResponseDetailProducts(data *entities.ProductModels) *ProductsResponse {
 		Status:       data.Status,
 		CreatedAt:    data.CreatedAt,
 		Photos:       getPhotoResponses(data.Photos),
-		Variants:     getVariantResponses(data.Variants),
+		Variants:     getVariantResponses(data),
+	}
+	res.MinPrice, res.MaxPrice = PriceRange(data)
+	return res
+}
+
+// ProductDetailResponse is the product as it is stored, with the price range, the price of each
+// variant and the running flash sale added alongside.
+type ProductDetailResponse struct {
+	*entities.ProductModels
+	MinPrice  uint64                    `json:"min_price"`
+	MaxPrice  uint64                    `json:"max_price"`
+	Variants  []*VariantDetailResponse  `json:"variants"`
+	FlashSale *ProductFlashSaleResponse `json:"flash_sale"`
+}
+
+// VariantDetailResponse is the variant as it is stored, with the price it sells for, its
+// discount, its options and its flash sale added alongside.
+type VariantDetailResponse struct {
+	*entities.ProductVariantModels
+	Price     uint64                    `json:"price"`
+	Discount  uint64                    `json:"discount"`
+	Options   []VariantOptionResponse   `json:"options"`
+	FlashSale *VariantFlashSaleResponse `json:"flash_sale"`
+}
+
+func ResponseProductDetail(data *entities.ProductModels) *ProductDetailResponse {
+	res := &ProductDetailResponse{
+		ProductModels: data,
+		Variants:      make([]*VariantDetailResponse, len(data.Variants)),
+	}
+	res.MinPrice, res.MaxPrice = PriceRange(data)
+	for i := range data.Variants {
+		variant := &data.Variants[i]
+		res.Variants[i] = &VariantDetailResponse{
+			ProductVariantModels: variant,
+			Price:                VariantPrice(data, variant),
+			Discount:             VariantDiscount(data, variant),
+			Options:              getVariantOptionResponses(variant.OptionValues),
+		}
 	}
 	return res
 }
-func ResponseDetailVariantProducts(data *entities.ProductVariantModels) *VariantProductResponse {
+
+// VariantOptionResponse is the value a variant has for one option type.
+type VariantOptionResponse struct {
+	OptionTypeID  uint64 `json:"option_type_id"`
+	Type          string `json:"type"`
+	OptionValueID uint64 `json:"option_value_id"`
+	Value         string `json:"value"`
+}
+
+func ResponseDetailVariantProducts(product *entities.ProductModels, data *entities.ProductVariantModels) *VariantProductResponse {
 	res := &VariantProductResponse{
-		ID:        data.ID,
-		Size:      data.Size,
-		Color:     data.Color,
-		Stock:     data.Stock,
-		Weight:    data.Weight,
-		CreatedAt: data.CreatedAt,
+		ID:            data.ID,
+		SKU:           data.SKU,
+		Barcode:       data.Barcode,
+		Size:          data.Size,
+		Color:         data.Color,
+		Options:       getVariantOptionResponses(data.OptionValues),
+		Price:         VariantPrice(product, data),
+		Discount:      VariantDiscount(product, data),
+		Stock:         data.Stock,
+		ReservedStock: data.ReservedStock,
+		Weight:        data.Weight,
+		CreatedAt:     data.CreatedAt,
 	}
 	return res
 }
 
-func getVariantResponses(variants []entities.ProductVariantModels) []*VariantProductResponse {
-	variantResponses := make([]*VariantProductResponse, len(variants))
-	for i, variant := range variants {
-		variantResponses[i] = ResponseDetailVariantProducts(&variant)
+func getVariantResponses(product *entities.ProductModels) []*VariantProductResponse {
+	variantResponses := make([]*VariantProductResponse, len(product.Variants))
+	for i := range product.Variants {
+		variantResponses[i] = ResponseDetailVariantProducts(product, &product.Variants[i])
 	}
 	return variantResponses
 }
 
+func getVariantOptionResponses(values []*entities.OptionValueModels) []VariantOptionResponse {
+	responses := make([]VariantOptionResponse, 0, len(values))
+	for _, value := range values {
+		option := VariantOptionResponse{
+			OptionTypeID:  value.OptionTypeID,
+			OptionValueID: value.ID,
+			Value:         value.Value,
+		}
+		if value.OptionType != nil {
+			option.Type = value.OptionType.Name
+		}
+		responses = append(responses, option)
+	}
+	return responses
+}
+
 func ResponseArrayProducts(data []*entities.ProductModels) []*ProductsResponse {
 	res := make([]*ProductsResponse, 0)
 
@@ -86,6 +182,7 @@ func ResponseArrayProducts(data []*entities.ProductModels) []*ProductsResponse {
 			CreatedAt:    product.CreatedAt,
 			Photos:       getPhotoResponses(product.Photos),
 		}
+		productRes.MinPrice, productRes.MaxPrice = PriceRange(product)
 		res = append(res, productRes)
 	}
 
@@ -103,6 +200,62 @@ func getPhotoResponses(photos []entities.ProductPhotoModels) []ProductPhotoRespo
 	return responses
 }
 
+// ApplyFlashSale shows the cheapest running flash sale item of the product on res, with the
+// seconds left until the sale ends, and the sale price of each variant on the sale. items may
+// hold the items of other products too.
+func ApplyFlashSale(res *ProductsResponse, items []*entities.FlashSaleItemModels, now time.Time) {
+	res.FlashSale = productFlashSale(res.ID, items, now)
+	for _, variant := range res.Variants {
+		variant.FlashSale = variantFlashSale(res.ID, variant.ID, items)
+	}
+}
+
+// ApplyFlashSaleDetail is ApplyFlashSale for the product detail.
+func ApplyFlashSaleDetail(res *ProductDetailResponse, items []*entities.FlashSaleItemModels, now time.Time) {
+	res.FlashSale = productFlashSale(res.ID, items, now)
+	for _, variant := range res.Variants {
+		variant.FlashSale = variantFlashSale(res.ID, variant.ID, items)
+	}
+}
+
+func productFlashSale(productID uint64, items []*entities.FlashSaleItemModels, now time.Time) *ProductFlashSaleResponse {
+	var res *ProductFlashSaleResponse
+	for _, item := range items {
+		if item.ProductID != productID || item.FlashSale == nil || item.Sold >= item.Quota {
+			continue
+		}
+		if res == nil || item.SalePrice < res.SalePrice {
+			res = &ProductFlashSaleResponse{
+				ID:        item.FlashSale.ID,
+				Name:      item.FlashSale.Name,
+				SalePrice: item.SalePrice,
+				StartAt:   item.FlashSale.StartAt,
+				EndAt:     item.FlashSale.EndAt,
+				Countdown: int64(item.FlashSale.EndAt.Sub(now).Seconds()),
+			}
+		}
+	}
+	return res
+}
+
+func variantFlashSale(productID, variantID uint64, items []*entities.FlashSaleItemModels) *VariantFlashSaleResponse {
+	var res *VariantFlashSaleResponse
+	for _, item := range items {
+		if item.ProductID != productID || item.VariantID != variantID || item.FlashSale == nil || item.Sold >= item.Quota {
+			continue
+		}
+		if res == nil || item.SalePrice < res.SalePrice {
+			res = &VariantFlashSaleResponse{
+				SalePrice: item.SalePrice,
+				Quota:     item.Quota,
+				Sold:      item.Sold,
+				Remaining: item.Quota - item.Sold,
+			}
+		}
+	}
+	return res
+}
+
 type ReviewProductFormatter struct {
 	ID          uint64  `json:"id"`
 	Name        string  `json:"name"`
@@ -138,3 +291,107 @@ func ResponseAddPhotoProduct(data *entities.ProductPhotoModels) *AddPhotoProduct
 	}
 	return res
 }
+
+// StockDiscrepancyResponse is a variant whose stock doesn't match the sum of its inventory ledger.
+type StockDiscrepancyResponse struct {
+	VariantID   uint64 `json:"variant_id"`
+	ProductID   uint64 `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Size        string `json:"size"`
+	Color       string `json:"color"`
+	Stock       uint64 `json:"stock"`
+	LedgerStock int64  `json:"ledger_stock"`
+	Difference  int64  `json:"difference"`
+}
+
+// LowStockResponse is a variant whose stock dropped to or below its low-stock threshold.
+type LowStockResponse struct {
+	VariantID         uint64 `json:"variant_id"`
+	ProductID         uint64 `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	Size              string `json:"size"`
+	Color             string `json:"color"`
+	Stock             uint64 `json:"stock"`
+	LowStockThreshold uint64 `json:"low_stock_threshold"`
+}
+
+// ImportRowError lists what is wrong with a row of a product import file.
+type ImportRowError struct {
+	Row    int      `json:"row"`
+	SKU    string   `json:"sku"`
+	Errors []string `json:"errors"`
+}
+
+// ImportProductResponse is the outcome of a product import. Nothing is imported while any row has
+// errors; on a dry run the counts are what the import would do.
+type ImportProductResponse struct {
+	DryRun          bool             `json:"dry_run"`
+	TotalRows       int              `json:"total_rows"`
+	ProductsCreated int              `json:"products_created"`
+	ProductsUpdated int              `json:"products_updated"`
+	VariantsCreated int              `json:"variants_created"`
+	VariantsUpdated int              `json:"variants_updated"`
+	Errors          []ImportRowError `json:"errors"`
+}
+
+// VariantLookupResponse is a variant found by its SKU or barcode, with what's needed to sell or
+// pick it.
+type VariantLookupResponse struct {
+	VariantID     uint64  `json:"variant_id"`
+	SKU           string  `json:"sku"`
+	Barcode       *string `json:"barcode"`
+	ProductID     uint64  `json:"product_id"`
+	ProductName   string  `json:"product_name"`
+	ProductStatus string  `json:"product_status"`
+	Size          string  `json:"size"`
+	Color         string  `json:"color"`
+	Price         uint64  `json:"price"`
+	Discount      uint64  `json:"discount"`
+	Stock         uint64  `json:"stock"`
+	ReservedStock uint64  `json:"reserved_stock"`
+	Weight        uint64  `json:"weight"`
+}
+
+func ResponseVariantLookup(product *entities.ProductModels, variant *entities.ProductVariantModels) *VariantLookupResponse {
+	return &VariantLookupResponse{
+		VariantID:     variant.ID,
+		SKU:           variant.SKU,
+		Barcode:       variant.Barcode,
+		ProductID:     product.ID,
+		ProductName:   product.Name,
+		ProductStatus: product.Status,
+		Size:          variant.Size,
+		Color:         variant.Color,
+		Price:         VariantPrice(product, variant),
+		Discount:      VariantDiscount(product, variant),
+		Stock:         variant.Stock,
+		ReservedStock: variant.ReservedStock,
+		Weight:        variant.Weight,
+	}
+}
+
+// SearchProductResponse is a page of the product search with the facets of all its results.
+type SearchProductResponse struct {
+	Products []*ProductsResponse  `json:"products"`
+	Facets   *SearchFacetResponse `json:"facets"`
+}
+
+// SearchFacetResponse counts the products each filter value would leave. A facet ignores its own
+// filter, so the other values of a filter already picked are still counted.
+type SearchFacetResponse struct {
+	Categories []*FacetCountResponse `json:"categories"`
+	Sizes      []*FacetCountResponse `json:"sizes"`
+	Colors     []*FacetCountResponse `json:"colors"`
+	Ratings    []*FacetCountResponse `json:"ratings"`
+	InStock    int64                 `json:"in_stock"`
+	MinPrice   uint64                `json:"min_price"`
+	MaxPrice   uint64                `json:"max_price"`
+}
+
+// FacetCountResponse is a filter value and the number of products it would leave. ID is only set
+// for categories; a rating's value is the lowest rating it matches.
+type FacetCountResponse struct {
+	ID    uint64 `json:"id,omitempty"`
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
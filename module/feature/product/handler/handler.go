@@ -1,23 +1,31 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"mime/multipart"
 	"ruti-store/module/entities"
+	flashsale "ruti-store/module/feature/flashsale/domain"
 	"ruti-store/module/feature/product/domain"
+	"ruti-store/utils/export"
 	"ruti-store/utils/response"
 	"ruti-store/utils/upload"
 	"ruti-store/utils/validator"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type ProductHandler struct {
-	service domain.ProductServiceInterface
+	service          domain.ProductServiceInterface
+	flashSaleService flashsale.FlashSaleServiceInterface
 }
 
-func NewProductHandler(service domain.ProductServiceInterface) domain.ProductHandlerInterface {
+func NewProductHandler(service domain.ProductServiceInterface, flashSaleService flashsale.FlashSaleServiceInterface) domain.ProductHandlerInterface {
 	return &ProductHandler{
-		service: service,
+		service:          service,
+		flashSaleService: flashSaleService,
 	}
 }
 
@@ -38,7 +46,7 @@ func (h *ProductHandler) GetAllProducts(c *fiber.Ctx) error {
 	var totalItems int64
 
 	if searchQuery != "" {
-		result, totalItems, err = h.service.SearchAndPaginateProducts(searchQuery, currentPage, pageSize)
+		result, totalItems, err = h.service.SearchProducts(&domain.SearchProductRequest{Query: searchQuery}, currentPage, pageSize)
 		if err != nil {
 			return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get products: "+err.Error())
 		}
@@ -54,8 +62,24 @@ func (h *ProductHandler) GetAllProducts(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
 	}
 
+	productIDs := make([]uint64, 0, len(result))
+	for _, product := range result {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	flashSaleItems, err := h.flashSaleService.GetActiveItems(productIDs)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get flash sales: "+err.Error())
+	}
+
+	products := domain.ResponseArrayProducts(result)
+	now := time.Now()
+	for _, product := range products {
+		domain.ApplyFlashSale(product, flashSaleItems, now)
+	}
+
 	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get pagination",
-		domain.ResponseArrayProducts(result), currentPage, int(totalItems), totalPages, nextPage, prevPage)
+		products, currentPage, int(totalItems), totalPages, nextPage, prevPage)
 }
 
 func (h *ProductHandler) GetProductByID(c *fiber.Ctx) error {
@@ -70,7 +94,15 @@ func (h *ProductHandler) GetProductByID(c *fiber.Ctx) error {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 
-	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved product by ID", result)
+	flashSaleItems, err := h.flashSaleService.GetActiveItems([]uint64{result.ID})
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get flash sales: "+err.Error())
+	}
+
+	product := domain.ResponseProductDetail(result)
+	domain.ApplyFlashSaleDetail(product, flashSaleItems, time.Now())
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Successfully retrieved product by ID", product)
 }
 
 func (h *ProductHandler) CreateProduct(c *fiber.Ctx) error {
@@ -126,6 +158,9 @@ func (h *ProductHandler) UpdateProduct(c *fiber.Ctx) error {
 
 	err = h.service.UpdateProduct(productID, req)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidVariantPrice) {
+			return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+		}
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 
@@ -289,6 +324,66 @@ func (h *ProductHandler) GetAllProductsRecommendation(c *fiber.Ctx) error {
 	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get pagination product recommendation", domain.ResponseArrayProducts(result))
 }
 
+func (h *ProductHandler) SearchProducts(c *fiber.Ctx) error {
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	req := new(domain.SearchProductRequest)
+	if err := c.QueryParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse query parameters")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Minimum price must not be above maximum price")
+	}
+
+	result, totalItems, err := h.service.SearchProducts(req, currentPage, pageSize)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to search products: "+err.Error())
+	}
+
+	facets, err := h.service.GetSearchFacets(req)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get search facets: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetProductsPage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	productIDs := make([]uint64, 0, len(result))
+	for _, product := range result {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	flashSaleItems, err := h.flashSaleService.GetActiveItems(productIDs)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get flash sales: "+err.Error())
+	}
+
+	products := domain.ResponseArrayProducts(result)
+	now := time.Now()
+	for _, product := range products {
+		domain.ApplyFlashSale(product, flashSaleItems, now)
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success search products",
+		&domain.SearchProductResponse{Products: products, Facets: facets},
+		currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
 func (h *ProductHandler) CreateVariantProduct(c *fiber.Ctx) error {
 	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
 	if !ok || currentUser == nil {
@@ -309,6 +404,12 @@ func (h *ProductHandler) CreateVariantProduct(c *fiber.Ctx) error {
 
 	result, err := h.service.CreateVariantProduct(req)
 	if err != nil {
+		if errors.Is(err, domain.ErrSKUTaken) || errors.Is(err, domain.ErrBarcodeTaken) || errors.Is(err, domain.ErrVariantExists) {
+			return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidVariantOptions) || errors.Is(err, domain.ErrInvalidVariantPrice) {
+			return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+		}
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
 
@@ -340,3 +441,446 @@ func (h *ProductHandler) UpdateStatusProduct(c *fiber.Ctx) error {
 
 	return response.SuccessBuildWithoutResponse(c, fiber.StatusCreated, "Success update status product")
 }
+
+func (h *ProductHandler) AdjustStock(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.AdjustStockRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.AdjustStock(currentUser.ID, req)
+	if errors.Is(err, domain.ErrOutOfStock) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success adjust stock", result)
+}
+
+func (h *ProductHandler) UpdateLowStockThreshold(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.LowStockThresholdRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateLowStockThreshold(req)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success update low stock threshold", result)
+}
+
+func (h *ProductHandler) GetLowStockReport(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	result, err := h.service.GetLowStockVariants()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get low stock report", result)
+}
+
+func (h *ProductHandler) StockTake(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.StockTakeRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.StockTake(currentUser.ID, req)
+	if errors.Is(err, domain.ErrCountBelowReserved) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success record stock take", result)
+}
+
+func (h *ProductHandler) GetStockHistory(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	variantID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	result, totalItems, err := h.service.GetStockMovements(variantID, currentPage, pageSize)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get stock history: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetProductsPage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get stock history",
+		result, currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
+func (h *ProductHandler) GetStockDiscrepancies(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	result, err := h.service.GetStockDiscrepancies()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get stock discrepancies", result)
+}
+
+func (h *ProductHandler) ReconcileStock(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	variantID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	if err := h.service.ReconcileStock(currentUser.ID, variantID); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success reconcile stock")
+}
+
+func (h *ProductHandler) ImportProducts(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	dryRun := false
+	if c.Query("dry_run") != "" {
+		parsed, err := strconv.ParseBool(c.Query("dry_run"))
+		if err != nil {
+			return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid dry_run value")
+		}
+		dryRun = parsed
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "File is required")
+	}
+	fileToRead, err := file.Open()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Error opening file: "+err.Error())
+	}
+	defer func(fileToRead multipart.File) {
+		_ = fileToRead.Close()
+	}(fileToRead)
+
+	records, err := export.ReadSpreadsheet(fileToRead, file.Filename)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Error reading file: "+err.Error())
+	}
+
+	result, err := h.service.ImportProducts(currentUser.ID, records, dryRun)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidImportFile) {
+			return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	if len(result.Errors) > 0 {
+		return response.SuccessBuildResponse(c, fiber.StatusUnprocessableEntity, "Import file has invalid rows", result)
+	}
+	if dryRun {
+		return response.SuccessBuildResponse(c, fiber.StatusOK, "Import file is valid", result)
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success import products", result)
+}
+
+func (h *ProductHandler) ExportProducts(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	format := c.Query("format", "xlsx")
+	if format != "xlsx" && format != "csv" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid format. Use xlsx or csv.")
+	}
+
+	data, err := h.service.ExportProducts()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	fileName := fmt.Sprintf("Produk_%s.%s", time.Now().Format("2006-01-02"), format)
+	if format == "csv" {
+		err = export.ExportCsv(c, data, domain.ProductImportHeaders, fileName)
+	} else {
+		err = export.ExportSheet(c, data, domain.ProductImportHeaders, fileName)
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Error exporting products: "+err.Error())
+	}
+
+	return nil
+}
+
+func (h *ProductHandler) UpdateVariantCodes(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.UpdateVariantCodesRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateVariantCodes(req)
+	if err != nil {
+		if errors.Is(err, domain.ErrVariantNotFound) {
+			return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrSKUTaken) || errors.Is(err, domain.ErrBarcodeTaken) {
+			return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success update variant codes", result)
+}
+
+func (h *ProductHandler) LookupVariant(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	code := strings.TrimSpace(c.Query("code"))
+	if code == "" {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "SKU or barcode is required")
+	}
+
+	result, err := h.service.LookupVariant(code)
+	if err != nil {
+		if errors.Is(err, domain.ErrVariantNotFound) {
+			return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get variant", result)
+}
+
+func (h *ProductHandler) UpdateVariantPrice(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.UpdateVariantPriceRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateVariantPrice(req)
+	if err != nil {
+		if errors.Is(err, domain.ErrVariantNotFound) {
+			return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidVariantPrice) {
+			return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success update variant price", result)
+}
+
+func (h *ProductHandler) GetOptionTypes(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	result, err := h.service.GetOptionTypes()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get option types", result)
+}
+
+func (h *ProductHandler) CreateOptionType(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.CreateOptionTypeRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateOptionType(req)
+	if err != nil {
+		if errors.Is(err, domain.ErrOptionExists) {
+			return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create option type", result)
+}
+
+func (h *ProductHandler) CreateOptionValue(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.CreateOptionValueRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateOptionValue(req)
+	if err != nil {
+		if errors.Is(err, domain.ErrOptionTypeNotFound) {
+			return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrOptionExists) {
+			return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+		}
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create option value", result)
+}
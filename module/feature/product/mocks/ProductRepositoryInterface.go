@@ -0,0 +1,1211 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/product/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ProductRepositoryInterface is an autogenerated mock type for the ProductRepositoryInterface type
+type ProductRepositoryInterface struct {
+	mock.Mock
+}
+
+// AddPhotoProduct provides a mock function with given fields: newData
+func (_m *ProductRepositoryInterface) AddPhotoProduct(newData *entities.ProductPhotoModels) (*entities.ProductPhotoModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPhotoProduct")
+	}
+
+	var r0 *entities.ProductPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ProductPhotoModels) (*entities.ProductPhotoModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ProductPhotoModels) *entities.ProductPhotoModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ProductPhotoModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdjustStock provides a mock function with given fields: variantID, delta, movement
+func (_m *ProductRepositoryInterface) AdjustStock(variantID uint64, delta int64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, delta, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, int64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, delta, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommitReservation provides a mock function with given fields: orderID
+func (_m *ProductRepositoryInterface) CommitReservation(orderID string) error {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateOptionType provides a mock function with given fields: newData
+func (_m *ProductRepositoryInterface) CreateOptionType(newData *entities.OptionTypeModels) (*entities.OptionTypeModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOptionType")
+	}
+
+	var r0 *entities.OptionTypeModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.OptionTypeModels) (*entities.OptionTypeModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.OptionTypeModels) *entities.OptionTypeModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptionTypeModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.OptionTypeModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOptionValue provides a mock function with given fields: newData
+func (_m *ProductRepositoryInterface) CreateOptionValue(newData *entities.OptionValueModels) (*entities.OptionValueModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOptionValue")
+	}
+
+	var r0 *entities.OptionValueModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.OptionValueModels) (*entities.OptionValueModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.OptionValueModels) *entities.OptionValueModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptionValueModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.OptionValueModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProduct provides a mock function with given fields: product, categoryIDs
+func (_m *ProductRepositoryInterface) CreateProduct(product *entities.ProductModels, categoryIDs []uint64) (*entities.ProductModels, error) {
+	ret := _m.Called(product, categoryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ProductModels, []uint64) (*entities.ProductModels, error)); ok {
+		return rf(product, categoryIDs)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ProductModels, []uint64) *entities.ProductModels); ok {
+		r0 = rf(product, categoryIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ProductModels, []uint64) error); ok {
+		r1 = rf(product, categoryIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVariantProduct provides a mock function with given fields: newData
+func (_m *ProductRepositoryInterface) CreateVariantProduct(newData *entities.ProductVariantModels) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariantProduct")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ProductVariantModels) (*entities.ProductVariantModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ProductVariantModels) *entities.ProductVariantModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ProductVariantModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProduct provides a mock function with given fields: productID
+func (_m *ProductRepositoryInterface) DeleteProduct(productID uint64) error {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAllProductRecommendation provides a mock function with given fields: productsFromAI
+func (_m *ProductRepositoryInterface) FindAllProductRecommendation(productsFromAI []string) ([]*entities.ProductModels, error) {
+	ret := _m.Called(productsFromAI)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllProductRecommendation")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*entities.ProductModels, error)); ok {
+		return rf(productsFromAI)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*entities.ProductModels); ok {
+		r0 = rf(productsFromAI)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(productsFromAI)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenerateRecommendationProduct provides a mock function with no fields
+func (_m *ProductRepositoryInterface) GenerateRecommendationProduct() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRecommendationProduct")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategoriesByNames provides a mock function with given fields: names
+func (_m *ProductRepositoryInterface) GetCategoriesByNames(names []string) ([]*entities.CategoryModels, error) {
+	ret := _m.Called(names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoriesByNames")
+	}
+
+	var r0 []*entities.CategoryModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*entities.CategoryModels, error)); ok {
+		return rf(names)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*entities.CategoryModels); ok {
+		r0 = rf(names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CategoryModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLowStockVariants provides a mock function with given fields: unnotifiedOnly
+func (_m *ProductRepositoryInterface) GetLowStockVariants(unnotifiedOnly bool) ([]*domain.LowStockResponse, error) {
+	ret := _m.Called(unnotifiedOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowStockVariants")
+	}
+
+	var r0 []*domain.LowStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bool) ([]*domain.LowStockResponse, error)); ok {
+		return rf(unnotifiedOnly)
+	}
+	if rf, ok := ret.Get(0).(func(bool) []*domain.LowStockResponse); ok {
+		r0 = rf(unnotifiedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LowStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(unnotifiedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOptionTypeByID provides a mock function with given fields: optionTypeID
+func (_m *ProductRepositoryInterface) GetOptionTypeByID(optionTypeID uint64) (*entities.OptionTypeModels, error) {
+	ret := _m.Called(optionTypeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptionTypeByID")
+	}
+
+	var r0 *entities.OptionTypeModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.OptionTypeModels, error)); ok {
+		return rf(optionTypeID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.OptionTypeModels); ok {
+		r0 = rf(optionTypeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptionTypeModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(optionTypeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOptionTypes provides a mock function with no fields
+func (_m *ProductRepositoryInterface) GetOptionTypes() ([]*entities.OptionTypeModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptionTypes")
+	}
+
+	var r0 []*entities.OptionTypeModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.OptionTypeModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.OptionTypeModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OptionTypeModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOptionValuesByIDs provides a mock function with given fields: optionValueIDs
+func (_m *ProductRepositoryInterface) GetOptionValuesByIDs(optionValueIDs []uint64) ([]*entities.OptionValueModels, error) {
+	ret := _m.Called(optionValueIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptionValuesByIDs")
+	}
+
+	var r0 []*entities.OptionValueModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint64) ([]*entities.OptionValueModels, error)); ok {
+		return rf(optionValueIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uint64) []*entities.OptionValueModels); ok {
+		r0 = rf(optionValueIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OptionValueModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint64) error); ok {
+		r1 = rf(optionValueIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedProducts provides a mock function with given fields: page, pageSize
+func (_m *ProductRepositoryInterface) GetPaginatedProducts(page int, pageSize int) ([]*entities.ProductModels, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedProducts")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.ProductModels, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.ProductModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByID provides a mock function with given fields: productID
+func (_m *ProductRepositoryInterface) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductModels, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductModels); ok {
+		r0 = rf(productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReviews provides a mock function with given fields: page, perPage
+func (_m *ProductRepositoryInterface) GetProductReviews(page int, perPage int) ([]*entities.ProductModels, error) {
+	ret := _m.Called(page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductReviews")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.ProductModels, error)); ok {
+		return rf(page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.ProductModels); ok {
+		r0 = rf(page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsByNames provides a mock function with given fields: names
+func (_m *ProductRepositoryInterface) GetProductsByNames(names []string) ([]*entities.ProductModels, error) {
+	ret := _m.Called(names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsByNames")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*entities.ProductModels, error)); ok {
+		return rf(names)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*entities.ProductModels); ok {
+		r0 = rf(names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsForExport provides a mock function with no fields
+func (_m *ProductRepositoryInterface) GetProductsForExport() ([]*entities.ProductModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsForExport")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.ProductModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.ProductModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSearchFacets provides a mock function with given fields: req
+func (_m *ProductRepositoryInterface) GetSearchFacets(req *domain.SearchProductRequest) (*domain.SearchFacetResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchFacets")
+	}
+
+	var r0 *domain.SearchFacetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest) (*domain.SearchFacetResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest) *domain.SearchFacetResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SearchFacetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.SearchProductRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockDiscrepancies provides a mock function with no fields
+func (_m *ProductRepositoryInterface) GetStockDiscrepancies() ([]*domain.StockDiscrepancyResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockDiscrepancies")
+	}
+
+	var r0 []*domain.StockDiscrepancyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*domain.StockDiscrepancyResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*domain.StockDiscrepancyResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StockDiscrepancyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockMovements provides a mock function with given fields: variantID, page, pageSize
+func (_m *ProductRepositoryInterface) GetStockMovements(variantID uint64, page int, pageSize int) ([]*entities.InventoryMovementModels, int64, error) {
+	ret := _m.Called(variantID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockMovements")
+	}
+
+	var r0 []*entities.InventoryMovementModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.InventoryMovementModels, int64, error)); ok {
+		return rf(variantID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.InventoryMovementModels); ok {
+		r0 = rf(variantID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.InventoryMovementModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int64); ok {
+		r1 = rf(variantID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) error); ok {
+		r2 = rf(variantID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTotalItems provides a mock function with no fields
+func (_m *ProductRepositoryInterface) GetTotalItems() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalItems")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantByCode provides a mock function with given fields: code
+func (_m *ProductRepositoryInterface) GetVariantByCode(code string) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantByCode")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.ProductVariantModels, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.ProductVariantModels); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantByID provides a mock function with given fields: variantID
+func (_m *ProductRepositoryInterface) GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantByID")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductVariantModels, error)); ok {
+		return rf(variantID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductVariantModels); ok {
+		r0 = rf(variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantsByBarcode provides a mock function with given fields: barcodes
+func (_m *ProductRepositoryInterface) GetVariantsByBarcode(barcodes []string) ([]*entities.ProductVariantModels, error) {
+	ret := _m.Called(barcodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantsByBarcode")
+	}
+
+	var r0 []*entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*entities.ProductVariantModels, error)); ok {
+		return rf(barcodes)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*entities.ProductVariantModels); ok {
+		r0 = rf(barcodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(barcodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantsBySKU provides a mock function with given fields: skus
+func (_m *ProductRepositoryInterface) GetVariantsBySKU(skus []string) ([]*entities.ProductVariantModels, error) {
+	ret := _m.Called(skus)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantsBySKU")
+	}
+
+	var r0 []*entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*entities.ProductVariantModels, error)); ok {
+		return rf(skus)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*entities.ProductVariantModels); ok {
+		r0 = rf(skus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(skus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportProducts provides a mock function with given fields: products, movement
+func (_m *ProductRepositoryInterface) ImportProducts(products []*domain.ImportProduct, movement domain.StockMovement) error {
+	ret := _m.Called(products, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*domain.ImportProduct, domain.StockMovement) error); ok {
+		r0 = rf(products, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncreaseStock provides a mock function with given fields: variantID, quantity, movement
+func (_m *ProductRepositoryInterface) IncreaseStock(variantID uint64, quantity uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, quantity, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, quantity, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkLowStockNotified provides a mock function with given fields: variantID, notifiedAt
+func (_m *ProductRepositoryInterface) MarkLowStockNotified(variantID uint64, notifiedAt time.Time) (bool, error) {
+	ret := _m.Called(variantID, notifiedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkLowStockNotified")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, time.Time) (bool, error)); ok {
+		return rf(variantID, notifiedAt)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, time.Time) bool); ok {
+		r0 = rf(variantID, notifiedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, time.Time) error); ok {
+		r1 = rf(variantID, notifiedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileStock provides a mock function with given fields: variantID, movement
+func (_m *ProductRepositoryInterface) ReconcileStock(variantID uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReduceStockWhenPurchasing provides a mock function with given fields: variantID, quantity, movement
+func (_m *ProductRepositoryInterface) ReduceStockWhenPurchasing(variantID uint64, quantity uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, quantity, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReduceStockWhenPurchasing")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, quantity, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseReservation provides a mock function with given fields: orderID
+func (_m *ProductRepositoryInterface) ReleaseReservation(orderID string) error {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveStock provides a mock function with given fields: orderID, variantID, quantity
+func (_m *ProductRepositoryInterface) ReserveStock(orderID string, variantID uint64, quantity uint64) error {
+	ret := _m.Called(orderID, variantID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint64, uint64) error); ok {
+		r0 = rf(orderID, variantID, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetLowStockAlerts provides a mock function with no fields
+func (_m *ProductRepositoryInterface) ResetLowStockAlerts() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetLowStockAlerts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchProducts provides a mock function with given fields: req, page, pageSize
+func (_m *ProductRepositoryInterface) SearchProducts(req *domain.SearchProductRequest, page int, pageSize int) ([]*entities.ProductModels, int64, error) {
+	ret := _m.Called(req, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchProducts")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest, int, int) ([]*entities.ProductModels, int64, error)); ok {
+		return rf(req, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest, int, int) []*entities.ProductModels); ok {
+		r0 = rf(req, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.SearchProductRequest, int, int) int64); ok {
+		r1 = rf(req, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(*domain.SearchProductRequest, int, int) error); ok {
+		r2 = rf(req, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetStock provides a mock function with given fields: variantID, counted, movement
+func (_m *ProductRepositoryInterface) SetStock(variantID uint64, counted uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, counted, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, counted, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnmarkLowStockNotified provides a mock function with given fields: variantID
+func (_m *ProductRepositoryInterface) UnmarkLowStockNotified(variantID uint64) error {
+	ret := _m.Called(variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkLowStockNotified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(variantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLowStockThreshold provides a mock function with given fields: variantID, threshold
+func (_m *ProductRepositoryInterface) UpdateLowStockThreshold(variantID uint64, threshold uint64) error {
+	ret := _m.Called(variantID, threshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLowStockThreshold")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(variantID, threshold)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProduct provides a mock function with given fields: productID, newData, categoryIDs
+func (_m *ProductRepositoryInterface) UpdateProduct(productID uint64, newData *entities.ProductModels, categoryIDs []uint64) error {
+	ret := _m.Called(productID, newData, categoryIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *entities.ProductModels, []uint64) error); ok {
+		r0 = rf(productID, newData, categoryIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProductPhoto provides a mock function with given fields: productID, newPhotoURL
+func (_m *ProductRepositoryInterface) UpdateProductPhoto(productID uint64, newPhotoURL string) error {
+	ret := _m.Called(productID, newPhotoURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductPhoto")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(productID, newPhotoURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProductRating provides a mock function with given fields: productID, newRating
+func (_m *ProductRepositoryInterface) UpdateProductRating(productID uint64, newRating float64) error {
+	ret := _m.Called(productID, newRating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductRating")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, float64) error); ok {
+		r0 = rf(productID, newRating)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProductStatus provides a mock function with given fields: productID, status
+func (_m *ProductRepositoryInterface) UpdateProductStatus(productID uint64, status string) error {
+	ret := _m.Called(productID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(productID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTotalReview provides a mock function with given fields: productID
+func (_m *ProductRepositoryInterface) UpdateTotalReview(productID uint64) error {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTotalReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVariantCodes provides a mock function with given fields: variantID, sku, barcode
+func (_m *ProductRepositoryInterface) UpdateVariantCodes(variantID uint64, sku string, barcode *string) error {
+	ret := _m.Called(variantID, sku, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariantCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string, *string) error); ok {
+		r0 = rf(variantID, sku, barcode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVariantPrice provides a mock function with given fields: variantID, price
+func (_m *ProductRepositoryInterface) UpdateVariantPrice(variantID uint64, price *uint64) error {
+	ret := _m.Called(variantID, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariantPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *uint64) error); ok {
+		r0 = rf(variantID, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewProductRepositoryInterface creates a new instance of ProductRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductRepositoryInterface {
+	mock := &ProductRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1009 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/product/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProductServiceInterface is an autogenerated mock type for the ProductServiceInterface type
+type ProductServiceInterface struct {
+	mock.Mock
+}
+
+// AddPhotoProducts provides a mock function with given fields: req
+func (_m *ProductServiceInterface) AddPhotoProducts(req *domain.AddPhotoProductRequest) (*entities.ProductPhotoModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPhotoProducts")
+	}
+
+	var r0 *entities.ProductPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.AddPhotoProductRequest) (*entities.ProductPhotoModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.AddPhotoProductRequest) *entities.ProductPhotoModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.AddPhotoProductRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdjustStock provides a mock function with given fields: adminID, req
+func (_m *ProductServiceInterface) AdjustStock(adminID uint64, req *domain.AdjustStockRequest) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.AdjustStockRequest) (*entities.ProductVariantModels, error)); ok {
+		return rf(adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.AdjustStockRequest) *entities.ProductVariantModels); ok {
+		r0 = rf(adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.AdjustStockRequest) error); ok {
+		r1 = rf(adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckLowStock provides a mock function with no fields
+func (_m *ProductServiceInterface) CheckLowStock() (int, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckLowStock")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOptionType provides a mock function with given fields: req
+func (_m *ProductServiceInterface) CreateOptionType(req *domain.CreateOptionTypeRequest) (*entities.OptionTypeModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOptionType")
+	}
+
+	var r0 *entities.OptionTypeModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateOptionTypeRequest) (*entities.OptionTypeModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateOptionTypeRequest) *entities.OptionTypeModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptionTypeModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateOptionTypeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOptionValue provides a mock function with given fields: req
+func (_m *ProductServiceInterface) CreateOptionValue(req *domain.CreateOptionValueRequest) (*entities.OptionValueModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOptionValue")
+	}
+
+	var r0 *entities.OptionValueModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateOptionValueRequest) (*entities.OptionValueModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateOptionValueRequest) *entities.OptionValueModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptionValueModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateOptionValueRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateProduct provides a mock function with given fields: req
+func (_m *ProductServiceInterface) CreateProduct(req *domain.CreateProductRequest) (*entities.ProductModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateProductRequest) (*entities.ProductModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateProductRequest) *entities.ProductModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateProductRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVariantProduct provides a mock function with given fields: req
+func (_m *ProductServiceInterface) CreateVariantProduct(req *domain.CreateVariantRequest) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariantProduct")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateVariantRequest) (*entities.ProductVariantModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateVariantRequest) *entities.ProductVariantModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateVariantRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProduct provides a mock function with given fields: productID
+func (_m *ProductServiceInterface) DeleteProduct(productID uint64) error {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportProducts provides a mock function with no fields
+func (_m *ProductServiceInterface) ExportProducts() ([][]interface{}, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProducts")
+	}
+
+	var r0 [][]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([][]interface{}, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() [][]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllProducts provides a mock function with given fields: page, pageSize
+func (_m *ProductServiceInterface) GetAllProducts(page int, pageSize int) ([]*entities.ProductModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProducts")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.ProductModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.ProductModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllProductsRecommendation provides a mock function with no fields
+func (_m *ProductServiceInterface) GetAllProductsRecommendation() ([]*entities.ProductModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProductsRecommendation")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.ProductModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.ProductModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLowStockVariants provides a mock function with no fields
+func (_m *ProductServiceInterface) GetLowStockVariants() ([]*domain.LowStockResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLowStockVariants")
+	}
+
+	var r0 []*domain.LowStockResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*domain.LowStockResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*domain.LowStockResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LowStockResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOptionTypes provides a mock function with no fields
+func (_m *ProductServiceInterface) GetOptionTypes() ([]*entities.OptionTypeModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptionTypes")
+	}
+
+	var r0 []*entities.OptionTypeModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.OptionTypeModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.OptionTypeModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OptionTypeModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByID provides a mock function with given fields: productID
+func (_m *ProductServiceInterface) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductModels, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductModels); ok {
+		r0 = rf(productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductRecommendation provides a mock function with no fields
+func (_m *ProductServiceInterface) GetProductRecommendation() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductRecommendation")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductReviews provides a mock function with given fields: page, perPage
+func (_m *ProductServiceInterface) GetProductReviews(page int, perPage int) ([]*entities.ProductModels, int64, error) {
+	ret := _m.Called(page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductReviews")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.ProductModels, int64, error)); ok {
+		return rf(page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.ProductModels); ok {
+		r0 = rf(page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, perPage)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, perPage)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetProductsPage provides a mock function with given fields: currentPage, pageSize, totalItems
+func (_m *ProductServiceInterface) GetProductsPage(currentPage int, pageSize int, totalItems int) (int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize, totalItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductsPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(int, int, int) (int, int, int, error)); ok {
+		return rf(currentPage, pageSize, totalItems)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) int); ok {
+		r0 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) int); ok {
+		r1 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, int) int); ok {
+		r2 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int, int) error); ok {
+		r3 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetSearchFacets provides a mock function with given fields: req
+func (_m *ProductServiceInterface) GetSearchFacets(req *domain.SearchProductRequest) (*domain.SearchFacetResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSearchFacets")
+	}
+
+	var r0 *domain.SearchFacetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest) (*domain.SearchFacetResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest) *domain.SearchFacetResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SearchFacetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.SearchProductRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockDiscrepancies provides a mock function with no fields
+func (_m *ProductServiceInterface) GetStockDiscrepancies() ([]*domain.StockDiscrepancyResponse, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockDiscrepancies")
+	}
+
+	var r0 []*domain.StockDiscrepancyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*domain.StockDiscrepancyResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*domain.StockDiscrepancyResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StockDiscrepancyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockMovements provides a mock function with given fields: variantID, page, pageSize
+func (_m *ProductServiceInterface) GetStockMovements(variantID uint64, page int, pageSize int) ([]*entities.InventoryMovementModels, int64, error) {
+	ret := _m.Called(variantID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockMovements")
+	}
+
+	var r0 []*entities.InventoryMovementModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.InventoryMovementModels, int64, error)); ok {
+		return rf(variantID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.InventoryMovementModels); ok {
+		r0 = rf(variantID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.InventoryMovementModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int64); ok {
+		r1 = rf(variantID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) error); ok {
+		r2 = rf(variantID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ImportProducts provides a mock function with given fields: adminID, records, dryRun
+func (_m *ProductServiceInterface) ImportProducts(adminID uint64, records [][]string, dryRun bool) (*domain.ImportProductResponse, error) {
+	ret := _m.Called(adminID, records, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportProducts")
+	}
+
+	var r0 *domain.ImportProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, [][]string, bool) (*domain.ImportProductResponse, error)); ok {
+		return rf(adminID, records, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, [][]string, bool) *domain.ImportProductResponse); ok {
+		r0 = rf(adminID, records, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, [][]string, bool) error); ok {
+		r1 = rf(adminID, records, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncreaseStock provides a mock function with given fields: variantID, quantity, movement
+func (_m *ProductServiceInterface) IncreaseStock(variantID uint64, quantity uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, quantity, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, quantity, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LookupVariant provides a mock function with given fields: code
+func (_m *ProductServiceInterface) LookupVariant(code string) (*domain.VariantLookupResponse, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupVariant")
+	}
+
+	var r0 *domain.VariantLookupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*domain.VariantLookupResponse, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *domain.VariantLookupResponse); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VariantLookupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileStock provides a mock function with given fields: adminID, variantID
+func (_m *ProductServiceInterface) ReconcileStock(adminID uint64, variantID uint64) error {
+	ret := _m.Called(adminID, variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(adminID, variantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReduceStockWhenPurchasing provides a mock function with given fields: variantID, quantity, movement
+func (_m *ProductServiceInterface) ReduceStockWhenPurchasing(variantID uint64, quantity uint64, movement domain.StockMovement) error {
+	ret := _m.Called(variantID, quantity, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReduceStockWhenPurchasing")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, domain.StockMovement) error); ok {
+		r0 = rf(variantID, quantity, movement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchProducts provides a mock function with given fields: req, page, pageSize
+func (_m *ProductServiceInterface) SearchProducts(req *domain.SearchProductRequest, page int, pageSize int) ([]*entities.ProductModels, int64, error) {
+	ret := _m.Called(req, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchProducts")
+	}
+
+	var r0 []*entities.ProductModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest, int, int) ([]*entities.ProductModels, int64, error)); ok {
+		return rf(req, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.SearchProductRequest, int, int) []*entities.ProductModels); ok {
+		r0 = rf(req, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.SearchProductRequest, int, int) int64); ok {
+		r1 = rf(req, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(*domain.SearchProductRequest, int, int) error); ok {
+		r2 = rf(req, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StockTake provides a mock function with given fields: adminID, req
+func (_m *ProductServiceInterface) StockTake(adminID uint64, req *domain.StockTakeRequest) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(adminID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StockTake")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.StockTakeRequest) (*entities.ProductVariantModels, error)); ok {
+		return rf(adminID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.StockTakeRequest) *entities.ProductVariantModels); ok {
+		r0 = rf(adminID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.StockTakeRequest) error); ok {
+		r1 = rf(adminID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLowStockThreshold provides a mock function with given fields: req
+func (_m *ProductServiceInterface) UpdateLowStockThreshold(req *domain.LowStockThresholdRequest) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLowStockThreshold")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.LowStockThresholdRequest) (*entities.ProductVariantModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.LowStockThresholdRequest) *entities.ProductVariantModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.LowStockThresholdRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePhotoProduct provides a mock function with given fields: productID, photo
+func (_m *ProductServiceInterface) UpdatePhotoProduct(productID uint64, photo string) error {
+	ret := _m.Called(productID, photo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePhotoProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, string) error); ok {
+		r0 = rf(productID, photo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProduct provides a mock function with given fields: productID, req
+func (_m *ProductServiceInterface) UpdateProduct(productID uint64, req *domain.UpdateProductRequest) error {
+	ret := _m.Called(productID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateProductRequest) error); ok {
+		r0 = rf(productID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProductRating provides a mock function with given fields: productID, newRating
+func (_m *ProductServiceInterface) UpdateProductRating(productID uint64, newRating float64) error {
+	ret := _m.Called(productID, newRating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductRating")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, float64) error); ok {
+		r0 = rf(productID, newRating)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateStatusProduct provides a mock function with given fields: req
+func (_m *ProductServiceInterface) UpdateStatusProduct(req *domain.UpdateStatusRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatusProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.UpdateStatusRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTotalReview provides a mock function with given fields: productID
+func (_m *ProductServiceInterface) UpdateTotalReview(productID uint64) error {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTotalReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVariantCodes provides a mock function with given fields: req
+func (_m *ProductServiceInterface) UpdateVariantCodes(req *domain.UpdateVariantCodesRequest) (*domain.VariantProductResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariantCodes")
+	}
+
+	var r0 *domain.VariantProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.UpdateVariantCodesRequest) (*domain.VariantProductResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.UpdateVariantCodesRequest) *domain.VariantProductResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VariantProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.UpdateVariantCodesRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateVariantPrice provides a mock function with given fields: req
+func (_m *ProductServiceInterface) UpdateVariantPrice(req *domain.UpdateVariantPriceRequest) (*domain.VariantProductResponse, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVariantPrice")
+	}
+
+	var r0 *domain.VariantProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.UpdateVariantPriceRequest) (*domain.VariantProductResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.UpdateVariantPriceRequest) *domain.VariantProductResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VariantProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.UpdateVariantPriceRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProductServiceInterface creates a new instance of ProductServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductServiceInterface {
+	mock := &ProductServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
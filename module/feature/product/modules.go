@@ -2,34 +2,53 @@ package product
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/gorm"
+	flashsale "ruti-store/module/feature/flashsale/domain"
+	flashSaleRepository "ruti-store/module/feature/flashsale/repository"
+	flashSaleService "ruti-store/module/feature/flashsale/service"
 	"ruti-store/module/feature/middleware"
+	notification "ruti-store/module/feature/notification/domain"
+	notificationRepository "ruti-store/module/feature/notification/repository"
+	notificationService "ruti-store/module/feature/notification/service"
 	"ruti-store/module/feature/product/domain"
 	"ruti-store/module/feature/product/handler"
 	"ruti-store/module/feature/product/repository"
 	"ruti-store/module/feature/product/service"
 	user "ruti-store/module/feature/user/domain"
 	assistant "ruti-store/utils/assitant"
+	"ruti-store/utils/scheduler"
 	"ruti-store/utils/token"
+	"time"
 )
 
 var (
-	repo   domain.ProductRepositoryInterface
-	serv   domain.ProductServiceInterface
-	hand   domain.ProductHandlerInterface
-	openAi assistant.AssistantServiceInterface
+	repo          domain.ProductRepositoryInterface
+	serv          domain.ProductServiceInterface
+	hand          domain.ProductHandlerInterface
+	openAi        assistant.AssistantServiceInterface
+	flashSaleRepo flashsale.FlashSaleRepositoryInterface
+	flashSaleServ flashsale.FlashSaleServiceInterface
+
+	notificationRepo notification.NotificationRepositoryInterface
+	notificationServ notification.NotificationServiceInterface
 )
 
 func InitializeProduct(db *gorm.DB) {
 	openAi = assistant.NewAssistantService()
 	repo = repository.NewProductRepository(db, openAi)
-	serv = service.NewProductService(repo)
-	hand = handler.NewProductHandler(serv)
+	notificationRepo = notificationRepository.NewNotificationRepository(db)
+	notificationServ = notificationService.NewNotificationService(notificationRepo)
+	serv = service.NewProductService(repo, notificationServ)
+	flashSaleRepo = flashSaleRepository.NewFlashSaleRepository(db)
+	flashSaleServ = flashSaleService.NewFlashSaleService(flashSaleRepo)
+	hand = handler.NewProductHandler(serv, flashSaleServ)
 }
 
 func SetupRoutesProduct(app *fiber.App, jwt token.JWTInterface, userService user.UserServiceInterface) {
 	api := app.Group("/api/v1/product")
 	api.Get("/list", hand.GetAllProducts)
+	api.Get("/search", hand.SearchProducts)
 	api.Get("/details/:id", hand.GetProductByID)
 	api.Post("/create", middleware.AuthMiddleware(jwt, userService), hand.CreateProduct)
 	api.Put("/update/:id", middleware.AuthMiddleware(jwt, userService), hand.UpdateProduct)
@@ -41,4 +60,33 @@ func SetupRoutesProduct(app *fiber.App, jwt token.JWTInterface, userService user
 	api.Get("/recommendation-user", hand.GetAllProductsRecommendation)
 	api.Post("/create/variant", middleware.AuthMiddleware(jwt, userService), hand.CreateVariantProduct)
 	api.Post("/update/status", middleware.AuthMiddleware(jwt, userService), hand.UpdateStatusProduct)
+	api.Post("/variant/stock", middleware.AuthMiddleware(jwt, userService), hand.AdjustStock)
+	api.Post("/variant/stock-take", middleware.AuthMiddleware(jwt, userService), hand.StockTake)
+	api.Get("/variant/stock/history/:id", middleware.AuthMiddleware(jwt, userService), hand.GetStockHistory)
+	api.Get("/variant/stock/reconcile", middleware.AuthMiddleware(jwt, userService), hand.GetStockDiscrepancies)
+	api.Post("/variant/stock/reconcile/:id", middleware.AuthMiddleware(jwt, userService), hand.ReconcileStock)
+	api.Put("/variant/low-stock-threshold", middleware.AuthMiddleware(jwt, userService), hand.UpdateLowStockThreshold)
+	api.Get("/variant/low-stock", middleware.AuthMiddleware(jwt, userService), hand.GetLowStockReport)
+	api.Put("/variant/price", middleware.AuthMiddleware(jwt, userService), hand.UpdateVariantPrice)
+	api.Get("/option-types", middleware.AuthMiddleware(jwt, userService), hand.GetOptionTypes)
+	api.Post("/option-types", middleware.AuthMiddleware(jwt, userService), hand.CreateOptionType)
+	api.Post("/option-types/values", middleware.AuthMiddleware(jwt, userService), hand.CreateOptionValue)
+	api.Put("/variant/codes", middleware.AuthMiddleware(jwt, userService), hand.UpdateVariantCodes)
+	api.Get("/variant/lookup", middleware.AuthMiddleware(jwt, userService), hand.LookupVariant)
+	api.Post("/import", middleware.AuthMiddleware(jwt, userService), hand.ImportProducts)
+	api.Get("/export", middleware.AuthMiddleware(jwt, userService), hand.ExportProducts)
+}
+
+func SetupProductJobs(jobs scheduler.SchedulerInterface) {
+	jobs.Register(scheduler.Job{
+		Name:     "product:low-stock",
+		Interval: 15 * time.Minute,
+		Run: func() error {
+			notified, err := serv.CheckLowStock()
+			if notified > 0 {
+				log.Infof("warned admins of %d low-stock variants", notified)
+			}
+			return err
+		},
+	})
 }
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"github.com/sashabaranov/go-openai"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"ruti-store/module/entities"
+	notificationRepository "ruti-store/module/feature/notification/repository"
+	order "ruti-store/module/feature/order/domain"
 	"ruti-store/module/feature/product/domain"
 	assistant "ruti-store/utils/assitant"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -42,7 +46,8 @@ func (r *ProductRepository) GetPaginatedProducts(page, pageSize int) ([]*entitie
 
 	if err := r.db.Where("deleted_at IS NULL").
 		Order("created_at DESC").
-		Offset(offset).Limit(pageSize).Preload("Photos").Find(&products).Error; err != nil {
+		Offset(offset).Limit(pageSize).Preload("Photos").
+		Preload("Variants", "deleted_at IS NULL").Find(&products).Error; err != nil {
 		return nil, err
 	}
 
@@ -55,6 +60,7 @@ func (r *ProductRepository) GetProductByID(productID uint64) (*entities.ProductM
 	if err := r.db.Preload("Photos").
 		Preload("Categories", "deleted_at IS NULL").
 		Preload("Variants").
+		Preload("Variants.OptionValues.OptionType").
 		Where("id = ? AND deleted_at IS NULL", productID).
 		First(&product).Error; err != nil {
 		return nil, err
@@ -192,22 +198,357 @@ func (r *ProductRepository) UpdateProductPhoto(productID uint64, newPhotoURL str
 	return nil
 }
 
-func (r *ProductRepository) ReduceStockWhenPurchasing(variantID, quantity uint64) error {
+func (r *ProductRepository) ReduceStockWhenPurchasing(variantID, quantity uint64, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.ProductVariantModels{}).
+			Where("id = ? AND stock >= ?", variantID, quantity).
+			Update("stock", gorm.Expr("stock - ?", quantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrOutOfStock
+		}
+		return recordMovement(tx, variantID, -int64(quantity), movement)
+	})
+}
+
+func (r *ProductRepository) IncreaseStock(variantID, quantity uint64, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var variant entities.ProductVariantModels
+		if err := tx.Model(&variant).Where("id = ?", variantID).Update("stock", gorm.Expr("stock + ?", quantity)).Error; err != nil {
+			return err
+		}
+		if err := recordMovement(tx, variantID, int64(quantity), movement); err != nil {
+			return err
+		}
+		return notifyBackInStock(tx, variantID)
+	})
+}
+
+// AdjustStock changes the variant's stock by delta. A negative delta can't take the stock
+// below zero.
+func (r *ProductRepository) AdjustStock(variantID uint64, delta int64, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&entities.ProductVariantModels{}).Where("id = ? AND deleted_at IS NULL", variantID)
+		if delta < 0 {
+			query = query.Where("stock >= ?", -delta)
+		}
+		result := query.Update("stock", gorm.Expr("stock + ?", delta))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrOutOfStock
+		}
+		if err := recordMovement(tx, variantID, delta, movement); err != nil {
+			return err
+		}
+		return notifyBackInStock(tx, variantID)
+	})
+}
+
+// SetStock sets the variant's stock from what a stock-take counted and records the difference
+// with the previous stock in the ledger. The count includes the units reserved for unpaid orders,
+// which are still in the warehouse, so only the rest is put on sale.
+func (r *ProductRepository) SetStock(variantID, counted uint64, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var variant entities.ProductVariantModels
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", variantID).
+			First(&variant).Error; err != nil {
+			return err
+		}
+		if counted < variant.ReservedStock {
+			return fmt.Errorf("%w: %d counted, %d reserved", domain.ErrCountBelowReserved, counted, variant.ReservedStock)
+		}
+		stock := counted - variant.ReservedStock
+		if err := tx.Model(&variant).Update("stock", stock).Error; err != nil {
+			return err
+		}
+		if err := recordMovement(tx, variantID, int64(stock)-int64(variant.Stock), movement); err != nil {
+			return err
+		}
+		return notifyBackInStock(tx, variantID)
+	})
+}
+
+// recordMovement adds a stock change of the variant made inside tx to the inventory ledger,
+// along with the stock it left the variant at.
+func recordMovement(tx *gorm.DB, variantID uint64, delta int64, movement domain.StockMovement) error {
 	var variant entities.ProductVariantModels
-	if err := r.db.Model(&variant).Where("id = ?", variantID).Update("stock", gorm.Expr("stock - ?", quantity)).Error; err != nil {
+	if err := tx.Where("id = ?", variantID).First(&variant).Error; err != nil {
 		return err
 	}
-	return nil
+	if movement.ActorRole == "" {
+		movement.ActorRole = domain.MovementActorSystem
+	}
+
+	entry := &entities.InventoryMovementModels{
+		VariantID:  variantID,
+		Delta:      delta,
+		StockAfter: variant.Stock,
+		Reason:     movement.Reason,
+		OrderID:    movement.OrderID,
+		ActorID:    movement.ActorID,
+		ActorRole:  movement.ActorRole,
+		Note:       movement.Note,
+		CreatedAt:  time.Now(),
+	}
+	return tx.Create(entry).Error
 }
 
-func (r *ProductRepository) IncreaseStock(variantID, quantity uint64) error {
+func (r *ProductRepository) GetStockMovements(variantID uint64, page, pageSize int) ([]*entities.InventoryMovementModels, int64, error) {
+	var movements []*entities.InventoryMovementModels
+	var totalItems int64
+
+	query := r.db.Model(&entities.InventoryMovementModels{}).Where("variant_id = ?", variantID)
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&movements).Error; err != nil {
+		return nil, 0, err
+	}
+	return movements, totalItems, nil
+}
+
+// GetStockDiscrepancies returns the variants whose stock isn't what their ledger entries add up to.
+func (r *ProductRepository) GetStockDiscrepancies() ([]*domain.StockDiscrepancyResponse, error) {
+	var discrepancies []*domain.StockDiscrepancyResponse
+	if err := r.db.Raw(`
+		SELECT v.id AS variant_id, v.product_id, p.name AS product_name, v.size, v.color, v.stock,
+			COALESCE(SUM(m.delta), 0) AS ledger_stock,
+			v.stock - COALESCE(SUM(m.delta), 0) AS difference
+		FROM variants v
+		JOIN product p ON p.id = v.product_id
+		LEFT JOIN inventory_movements m ON m.variant_id = v.id
+		WHERE v.deleted_at IS NULL
+		GROUP BY v.id, p.name
+		HAVING v.stock <> COALESCE(SUM(m.delta), 0)
+		ORDER BY v.id`).
+		Scan(&discrepancies).Error; err != nil {
+		return nil, err
+	}
+	return discrepancies, nil
+}
+
+// ReconcileStock records the difference between the variant's stock and its ledger as a ledger
+// entry, so the ledger adds up to the stock again. The stock itself isn't changed.
+func (r *ProductRepository) ReconcileStock(variantID uint64, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var variant entities.ProductVariantModels
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", variantID).
+			First(&variant).Error; err != nil {
+			return err
+		}
+
+		var ledgerStock int64
+		if err := tx.Model(&entities.InventoryMovementModels{}).
+			Where("variant_id = ?", variantID).
+			Select("COALESCE(SUM(delta), 0)").
+			Scan(&ledgerStock).Error; err != nil {
+			return err
+		}
+		if difference := int64(variant.Stock) - ledgerStock; difference != 0 {
+			return recordMovement(tx, variantID, difference, movement)
+		}
+		return nil
+	})
+}
+
+// notifyBackInStock lets the users waiting on a sold-out variant know it can be bought again.
+// Each alert is only sent once, and nothing happens while the variant is still sold out. The
+// notifications are created by the notification repository in the same transaction, so an alert
+// is only marked as sent along with its notification.
+func notifyBackInStock(tx *gorm.DB, variantID uint64) error {
 	var variant entities.ProductVariantModels
-	if err := r.db.Model(&variant).Where("id = ?", variantID).Update("stock", gorm.Expr("stock + ?", quantity)).Error; err != nil {
+	if err := tx.Where("id = ?", variantID).First(&variant).Error; err != nil {
 		return err
 	}
+	if variant.Stock == 0 {
+		return nil
+	}
+
+	var alerts []*entities.StockAlertModels
+	if err := tx.Preload("Product").
+		Where("variant_id = ? AND notified_at IS NULL", variantID).
+		Find(&alerts).Error; err != nil {
+		return err
+	}
+
+	notifications := notificationRepository.NewNotificationRepository(tx)
+	now := time.Now()
+	for _, alert := range alerts {
+		notification := &entities.NotificationModels{
+			UserID:    alert.UserID,
+			Title:     "Stok Tersedia",
+			Message:   fmt.Sprintf("%s (%s/%s) sudah tersedia kembali. Segera pesan sebelum kehabisan!", alert.Product.Name, variant.Size, variant.Color),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if _, err := notifications.CreateNotification(notification); err != nil {
+			return err
+		}
+		if err := tx.Model(alert).Update("notified_at", now).Error; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// UpdateLowStockThreshold sets the variant's low-stock threshold. The variant is checked again
+// on the next run, as the new threshold may put it below or above the line.
+func (r *ProductRepository) UpdateLowStockThreshold(variantID, threshold uint64) error {
+	return r.db.Model(&entities.ProductVariantModels{}).
+		Where("id = ? AND deleted_at IS NULL", variantID).
+		Updates(map[string]interface{}{
+			"low_stock_threshold":   threshold,
+			"low_stock_notified_at": nil,
+		}).Error
+}
+
+// GetLowStockVariants returns the variants on sale whose stock is at or below their threshold,
+// emptiest first. With unnotifiedOnly it leaves out the ones the admins were already warned of.
+func (r *ProductRepository) GetLowStockVariants(unnotifiedOnly bool) ([]*domain.LowStockResponse, error) {
+	var variants []*domain.LowStockResponse
+	query := r.db.Table("variants v").
+		Select("v.id AS variant_id, v.product_id, p.name AS product_name, v.size, v.color, v.stock, v.low_stock_threshold").
+		Joins("JOIN product p ON p.id = v.product_id").
+		Where("v.deleted_at IS NULL AND p.deleted_at IS NULL").
+		Where("v.low_stock_threshold > 0 AND v.stock <= v.low_stock_threshold")
+	if unnotifiedOnly {
+		query = query.Where("v.low_stock_notified_at IS NULL")
+	}
+	if err := query.Order("v.stock ASC, v.id ASC").Scan(&variants).Error; err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// MarkLowStockNotified records that the admins are being warned of the variant's low stock. It
+// reports false when the variant was already marked, e.g. by a run of the check on another replica.
+func (r *ProductRepository) MarkLowStockNotified(variantID uint64, notifiedAt time.Time) (bool, error) {
+	result := r.db.Model(&entities.ProductVariantModels{}).
+		Where("id = ? AND low_stock_notified_at IS NULL", variantID).
+		Update("low_stock_notified_at", notifiedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UnmarkLowStockNotified takes back the mark of a variant whose warning couldn't be sent, so the
+// next run warns of it again.
+func (r *ProductRepository) UnmarkLowStockNotified(variantID uint64) error {
+	return r.db.Model(&entities.ProductVariantModels{}).
+		Where("id = ?", variantID).
+		Update("low_stock_notified_at", nil).Error
+}
+
+// ResetLowStockAlerts re-arms the warning of variants that were restocked above their threshold.
+func (r *ProductRepository) ResetLowStockAlerts() error {
+	return r.db.Model(&entities.ProductVariantModels{}).
+		Where("low_stock_notified_at IS NOT NULL AND stock > low_stock_threshold").
+		Update("low_stock_notified_at", nil).Error
+}
+
+func (r *ProductRepository) GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error) {
+	var variant *entities.ProductVariantModels
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", variantID).First(&variant).Error; err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// ReserveStock moves quantity from the variant's stock into its reserved stock. The update is
+// conditional on enough stock being left, so concurrent checkouts can't oversell a variant.
+func (r *ProductRepository) ReserveStock(orderID string, variantID, quantity uint64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.ProductVariantModels{}).
+			Where("id = ? AND stock >= ?", variantID, quantity).
+			Updates(map[string]interface{}{
+				"stock":          gorm.Expr("stock - ?", quantity),
+				"reserved_stock": gorm.Expr("reserved_stock + ?", quantity),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrOutOfStock
+		}
+
+		sale := domain.StockMovement{Reason: domain.MovementSale, OrderID: orderID}
+		if err := recordMovement(tx, variantID, -int64(quantity), sale); err != nil {
+			return err
+		}
+
+		reservation := &entities.StockReservationModels{
+			OrderID:   orderID,
+			VariantID: variantID,
+			Quantity:  quantity,
+			Status:    domain.ReservationReserved,
+			CreatedAt: time.Now(),
+		}
+		return tx.Create(reservation).Error
+	})
+}
+
+// CommitReservation turns the order's reservations into sold stock once it has been paid.
+func (r *ProductRepository) CommitReservation(orderID string) error {
+	return r.settleReservations(orderID, domain.ReservationCommitted, false)
+}
+
+// ReleaseReservation puts the order's reserved stock back on sale. Reservations that were
+// already committed or released are left alone, so calling it twice doesn't restock twice.
+func (r *ProductRepository) ReleaseReservation(orderID string) error {
+	return r.settleReservations(orderID, domain.ReservationReleased, true)
+}
+
+func (r *ProductRepository) settleReservations(orderID, status string, restock bool) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var reservations []*entities.StockReservationModels
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("order_id = ? AND status = ?", orderID, domain.ReservationReserved).
+			Find(&reservations).Error; err != nil {
+			return err
+		}
+
+		for _, reservation := range reservations {
+			updates := map[string]interface{}{
+				"reserved_stock": gorm.Expr("GREATEST(reserved_stock - ?, 0)", reservation.Quantity),
+			}
+			if restock {
+				updates["stock"] = gorm.Expr("stock + ?", reservation.Quantity)
+			}
+			if err := tx.Model(&entities.ProductVariantModels{}).
+				Where("id = ?", reservation.VariantID).
+				Updates(updates).Error; err != nil {
+				return err
+			}
+			if restock {
+				cancellation := domain.StockMovement{Reason: domain.MovementCancellation, OrderID: orderID}
+				if err := recordMovement(tx, reservation.VariantID, int64(reservation.Quantity), cancellation); err != nil {
+					return err
+				}
+				if err := notifyBackInStock(tx, reservation.VariantID); err != nil {
+					return err
+				}
+			}
+
+			if err := tx.Model(reservation).Updates(map[string]interface{}{
+				"status":     status,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (r *ProductRepository) GetAllOrders() ([]*entities.OrderModels, error) {
 	var orders []*entities.OrderModels
 
@@ -312,30 +653,254 @@ func (r *ProductRepository) FindAllProductRecommendation(productsFromAI []string
 	return matchingProducts, nil
 }
 
-func (r *ProductRepository) SearchAndPaginateProducts(name string, page, pageSize int) ([]*entities.ProductModels, int64, error) {
-	var products []*entities.ProductModels
+// SearchProducts returns a page of the products matching the search, in the order it asks for.
+// The query matches the words of a product's name and description, or a name spelled close to it.
+func (r *ProductRepository) SearchProducts(req *domain.SearchProductRequest, page, pageSize int) ([]*entities.ProductModels, int64, error) {
 	var totalItems int64
-
-	if err := r.db.Where("deleted_at IS NULL").
-		Where("name LIKE ?", "%"+name+"%").
-		Model(&entities.ProductModels{}).Count(&totalItems).Error; err != nil {
+	if err := searchFilters(r.db.Table("product p"), req, "", false).
+		Count(&totalItems).Error; err != nil {
 		return nil, 0, err
 	}
 
+	var productIDs []uint64
 	offset := (page - 1) * pageSize
+	if err := searchOrder(searchFilters(r.db.Table("product p"), req, "", false), req).
+		Offset(offset).Limit(pageSize).
+		Pluck("p.id", &productIDs).Error; err != nil {
+		return nil, 0, err
+	}
+	if len(productIDs) == 0 {
+		return []*entities.ProductModels{}, totalItems, nil
+	}
 
-	if err := r.db.Where("deleted_at IS NULL").
-		Where("name LIKE ?", "%"+name+"%").
-		Order("created_at DESC").
-		Offset(offset).Limit(pageSize).Preload("Photos").Find(&products).Error; err != nil {
+	var found []*entities.ProductModels
+	if err := r.db.Where("id IN ?", productIDs).
+		Preload("Photos").
+		Preload("Variants", "deleted_at IS NULL").Find(&found).Error; err != nil {
 		return nil, 0, err
 	}
 
+	byID := make(map[uint64]*entities.ProductModels, len(found))
+	for _, product := range found {
+		byID[product.ID] = product
+	}
+	products := make([]*entities.ProductModels, 0, len(found))
+	for _, productID := range productIDs {
+		if product, ok := byID[productID]; ok {
+			products = append(products, product)
+		}
+	}
 	return products, totalItems, nil
 }
 
+// GetSearchFacets counts, for every value of every filter, the products of the search it would
+// leave.
+func (r *ProductRepository) GetSearchFacets(req *domain.SearchProductRequest) (*domain.SearchFacetResponse, error) {
+	facets := &domain.SearchFacetResponse{}
+
+	if err := searchFilters(r.db.Table("product p"), req, facetCategory, false).
+		Select("c.id, c.name AS value, COUNT(DISTINCT p.id) AS count").
+		Joins("JOIN product_categories pc ON pc.product_models_id = p.id").
+		Joins("JOIN category c ON c.id = pc.category_models_id AND c.deleted_at IS NULL").
+		Group("c.id, c.name").
+		Order("count DESC, value ASC").
+		Scan(&facets.Categories).Error; err != nil {
+		return nil, err
+	}
+
+	if err := searchFilters(r.db.Table("product p").Joins(searchVariantJoin), req, facetSize, true).
+		Select("v.size AS value, COUNT(DISTINCT p.id) AS count").
+		Where("v.size <> ''").
+		Group("v.size").
+		Order("count DESC, value ASC").
+		Scan(&facets.Sizes).Error; err != nil {
+		return nil, err
+	}
+
+	if err := searchFilters(r.db.Table("product p").Joins(searchVariantJoin), req, facetColor, true).
+		Select("v.color AS value, COUNT(DISTINCT p.id) AS count").
+		Where("v.color <> ''").
+		Group("v.color").
+		Order("count DESC, value ASC").
+		Scan(&facets.Colors).Error; err != nil {
+		return nil, err
+	}
+
+	if err := searchFilters(r.db.Table("product p").Joins(searchVariantJoin), req, facetStock, true).
+		Where("v.stock > 0").
+		Select("COUNT(DISTINCT p.id)").
+		Scan(&facets.InStock).Error; err != nil {
+		return nil, err
+	}
+
+	var prices struct {
+		MinPrice uint64
+		MaxPrice uint64
+	}
+	if err := searchFilters(r.db.Table("product p").Joins(searchVariantJoin), req, facetPrice, true).
+		Select("COALESCE(MIN(" + variantSellingPrice + "), 0) AS min_price, COALESCE(MAX(" + variantSellingPrice + "), 0) AS max_price").
+		Scan(&prices).Error; err != nil {
+		return nil, err
+	}
+	facets.MinPrice, facets.MaxPrice = prices.MinPrice, prices.MaxPrice
+
+	var ratings []struct {
+		Rating int
+		Count  int64
+	}
+	if err := searchFilters(r.db.Table("product p"), req, facetRating, false).
+		Select("FLOOR(p.rating)::int AS rating, COUNT(*) AS count").
+		Group("FLOOR(p.rating)").
+		Scan(&ratings).Error; err != nil {
+		return nil, err
+	}
+	for stars := 4; stars >= 1; stars-- {
+		bucket := &domain.FacetCountResponse{Value: strconv.Itoa(stars)}
+		for _, rating := range ratings {
+			if rating.Rating >= stars {
+				bucket.Count += rating.Count
+			}
+		}
+		facets.Ratings = append(facets.Ratings, bucket)
+	}
+
+	return facets, nil
+}
+
+// Filters a search facet leaves out of its own counts.
+const (
+	facetCategory = "category"
+	facetSize     = "size"
+	facetColor    = "color"
+	facetStock    = "stock"
+	facetPrice    = "price"
+	facetRating   = "rating"
+)
+
+const searchVariantJoin = "JOIN variants v ON v.product_id = p.id AND v.deleted_at IS NULL"
+
+// variantSellingPrice is what a customer pays for the variant v of the product p.
+const variantSellingPrice = "COALESCE(v.price, p.price) - LEAST(p.discount, COALESCE(v.price, p.price))"
+
+// searchFilters narrows a query on the products p down to the search, leaving out the filter
+// named by skip. The size, color, stock and price filters must all hold for one variant: the
+// variant v the query joined, or any variant when it joined none.
+func searchFilters(query *gorm.DB, req *domain.SearchProductRequest, skip string, joined bool) *gorm.DB {
+	query = query.Where("p.deleted_at IS NULL")
+
+	if text := strings.TrimSpace(req.Query); text != "" {
+		query = query.Where("(p.search_vector @@ websearch_to_tsquery('simple', ?) OR ? <% p.name OR p.name ILIKE ?)",
+			text, text, "%"+text+"%")
+	}
+	if skip != facetCategory && len(req.CategoryIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM product_categories pc WHERE pc.product_models_id = p.id AND pc.category_models_id IN ?)",
+			req.CategoryIDs)
+	}
+	if skip != facetRating && req.MinRating > 0 {
+		query = query.Where("p.rating >= ?", req.MinRating)
+	}
+
+	var conditions []string
+	var args []interface{}
+	if skip != facetSize && len(req.Sizes) > 0 {
+		conditions = append(conditions, "LOWER(v.size) IN ?")
+		args = append(args, lowerAll(req.Sizes))
+	}
+	if skip != facetColor && len(req.Colors) > 0 {
+		conditions = append(conditions, "LOWER(v.color) IN ?")
+		args = append(args, lowerAll(req.Colors))
+	}
+	if skip != facetStock && req.InStock {
+		conditions = append(conditions, "v.stock > 0")
+	}
+	if skip != facetPrice && req.MinPrice != nil {
+		conditions = append(conditions, variantSellingPrice+" >= ?")
+		args = append(args, *req.MinPrice)
+	}
+	if skip != facetPrice && req.MaxPrice != nil {
+		conditions = append(conditions, variantSellingPrice+" <= ?")
+		args = append(args, *req.MaxPrice)
+	}
+	if len(conditions) == 0 {
+		return query
+	}
+	if joined {
+		return query.Where(strings.Join(conditions, " AND "), args...)
+	}
+	return query.Where("EXISTS (SELECT 1 FROM variants v WHERE v.product_id = p.id AND v.deleted_at IS NULL AND "+
+		strings.Join(conditions, " AND ")+")", args...)
+}
+
+// searchOrder sorts the products of a search. Results are sorted by relevance when there's a
+// query and by newest otherwise, unless the search asks for another order.
+func searchOrder(query *gorm.DB, req *domain.SearchProductRequest) *gorm.DB {
+	text := strings.TrimSpace(req.Query)
+	sort := req.Sort
+	if sort == "" || (sort == domain.SearchSortRelevance && text == "") {
+		sort = domain.SearchSortNewest
+		if text != "" {
+			sort = domain.SearchSortRelevance
+		}
+	}
+
+	// Ties are broken by the newest product. Orders that take arguments are added as a clause,
+	// which holds the whole ORDER BY.
+	const newest = "p.created_at DESC, p.id DESC"
+	lowestPrice := "(SELECT MIN(" + variantSellingPrice + ") FROM variants v WHERE v.product_id = p.id AND v.deleted_at IS NULL)"
+	switch sort {
+	case domain.SearchSortRelevance:
+		return query.Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "ts_rank(p.search_vector, websearch_to_tsquery('simple', ?)) + word_similarity(?, p.name) DESC, " + newest,
+			Vars: []interface{}{text, text},
+		}})
+	case domain.SearchSortPriceAsc:
+		return query.Order(lowestPrice + " ASC NULLS LAST, " + newest)
+	case domain.SearchSortPriceDesc:
+		return query.Order(lowestPrice + " DESC NULLS LAST, " + newest)
+	case domain.SearchSortBestSelling:
+		return query.Clauses(clause.OrderBy{Expression: clause.Expr{
+			SQL: `(SELECT COALESCE(SUM(od.quantity - od.returned_quantity), 0)
+				FROM order_details od JOIN orders o ON o.id = od.order_id
+				WHERE od.product_id = p.id AND o.payment_status = ?) DESC, ` + newest,
+			Vars: []interface{}{string(order.PaymentStatusPaid)},
+		}})
+	case domain.SearchSortRating:
+		return query.Order("p.rating DESC, p.total_reviews DESC, " + newest)
+	default:
+		return query.Order(newest)
+	}
+}
+
+func lowerAll(values []string) []string {
+	lowered := make([]string, 0, len(values))
+	for _, value := range values {
+		lowered = append(lowered, strings.ToLower(strings.TrimSpace(value)))
+	}
+	return lowered
+}
+
 func (r *ProductRepository) CreateVariantProduct(newData *entities.ProductVariantModels) (*entities.ProductVariantModels, error) {
-	if err := r.db.Create(newData).Error; err != nil {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if len(newData.OptionValues) == 0 {
+			values, err := sizeColorOptions(tx, newData.Size, newData.Color)
+			if err != nil {
+				return err
+			}
+			newData.OptionValues = values
+		}
+		if err := tx.Create(newData).Error; err != nil {
+			return err
+		}
+		if newData.SKU == "" {
+			newData.SKU = domain.DefaultSKU(newData.ProductID, newData.ID)
+			if err := tx.Model(newData).Update("sku", newData.SKU).Error; err != nil {
+				return err
+			}
+		}
+		initial := domain.StockMovement{Reason: domain.MovementInitial}
+		return recordMovement(tx, newData.ID, int64(newData.Stock), initial)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -352,3 +917,278 @@ func (r *ProductRepository) UpdateProductStatus(productID uint64, status string)
 
 	return nil
 }
+
+func (r *ProductRepository) GetVariantsBySKU(skus []string) ([]*entities.ProductVariantModels, error) {
+	var variants []*entities.ProductVariantModels
+	if err := r.db.Where("sku IN ? AND deleted_at IS NULL", skus).Find(&variants).Error; err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+func (r *ProductRepository) GetVariantsByBarcode(barcodes []string) ([]*entities.ProductVariantModels, error) {
+	var variants []*entities.ProductVariantModels
+	if err := r.db.Where("barcode IN ? AND deleted_at IS NULL", barcodes).Find(&variants).Error; err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// GetVariantByCode returns the variant whose SKU or barcode is code.
+func (r *ProductRepository) GetVariantByCode(code string) (*entities.ProductVariantModels, error) {
+	var variant *entities.ProductVariantModels
+	if err := r.db.Where("(sku = ? OR barcode = ?) AND deleted_at IS NULL", code, code).
+		First(&variant).Error; err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+func (r *ProductRepository) UpdateVariantCodes(variantID uint64, sku string, barcode *string) error {
+	return r.db.Model(&entities.ProductVariantModels{}).
+		Where("id = ? AND deleted_at IS NULL", variantID).
+		Updates(map[string]interface{}{
+			"sku":        sku,
+			"barcode":    barcode,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// GetProductsByNames returns the products on the store whose name is one of names, ignoring case.
+func (r *ProductRepository) GetProductsByNames(names []string) ([]*entities.ProductModels, error) {
+	var products []*entities.ProductModels
+	if err := r.db.Preload("Variants", "deleted_at IS NULL").
+		Where("LOWER(name) IN ? AND deleted_at IS NULL", names).
+		Order("id ASC").
+		Find(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// GetCategoriesByNames returns the categories whose name is one of names, ignoring case.
+func (r *ProductRepository) GetCategoriesByNames(names []string) ([]*entities.CategoryModels, error) {
+	var categories []*entities.CategoryModels
+	if err := r.db.Where("LOWER(name) IN ? AND deleted_at IS NULL", names).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// ImportProducts upserts the products of an import and their variants in one transaction, so an
+// import is either applied as a whole or not at all. Stock changes of existing variants are
+// recorded in the ledger with the given movement.
+func (r *ProductRepository) ImportProducts(products []*domain.ImportProduct, movement domain.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, item := range products {
+			product := item.Product
+			if product.ID == 0 {
+				if err := tx.Omit(clause.Associations).Create(product).Error; err != nil {
+					return err
+				}
+			} else if err := tx.Model(&entities.ProductModels{}).
+				Where("id = ?", product.ID).
+				Updates(map[string]interface{}{
+					"name":        product.Name,
+					"description": product.Description,
+					"price":       product.Price,
+					"discount":    product.Discount,
+					"status":      product.Status,
+					"updated_at":  product.UpdatedAt,
+				}).Error; err != nil {
+				return err
+			}
+
+			categories := make([]*entities.CategoryModels, len(item.CategoryIDs))
+			for i, categoryID := range item.CategoryIDs {
+				categories[i] = &entities.CategoryModels{ID: categoryID}
+			}
+			if err := tx.Model(product).Association("Categories").Replace(categories); err != nil {
+				return err
+			}
+
+			if err := importPhotos(tx, product.ID, item.Images); err != nil {
+				return err
+			}
+
+			for _, variant := range item.Variants {
+				variant.ProductID = product.ID
+				if err := importVariant(tx, variant, movement); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// importPhotos adds the images of an import the product doesn't have yet.
+func importPhotos(tx *gorm.DB, productID uint64, images []string) error {
+	var existing []string
+	if err := tx.Model(&entities.ProductPhotoModels{}).
+		Where("product_id = ?", productID).
+		Pluck("url", &existing).Error; err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(existing))
+	for _, url := range existing {
+		known[url] = true
+	}
+	for _, url := range images {
+		if known[url] {
+			continue
+		}
+		known[url] = true
+		if err := tx.Create(&entities.ProductPhotoModels{ProductID: productID, URL: url}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importVariant creates or updates a variant of an import. Only a new variant takes its stock from
+// the import: the stock of an existing one keeps moving with sales while the file is edited, so
+// it is left alone and changed through adjustments and stock-takes.
+func importVariant(tx *gorm.DB, variant *entities.ProductVariantModels, movement domain.StockMovement) error {
+	if variant.ID == 0 {
+		values, err := sizeColorOptions(tx, variant.Size, variant.Color)
+		if err != nil {
+			return err
+		}
+		variant.OptionValues = values
+		if err := tx.Create(variant).Error; err != nil {
+			return err
+		}
+		initial := movement
+		initial.Reason = domain.MovementInitial
+		return recordMovement(tx, variant.ID, int64(variant.Stock), initial)
+	}
+
+	if err := tx.Model(&entities.ProductVariantModels{}).
+		Where("id = ? AND deleted_at IS NULL", variant.ID).
+		Updates(map[string]interface{}{
+			"product_id": variant.ProductID,
+			"size":       variant.Size,
+			"color":      variant.Color,
+			"barcode":    variant.Barcode,
+			"weight":     variant.Weight,
+			"updated_at": variant.UpdatedAt,
+		}).Error; err != nil {
+		return err
+	}
+	return syncSizeColorOptions(tx, variant)
+}
+
+func (r *ProductRepository) GetProductsForExport() ([]*entities.ProductModels, error) {
+	var products []*entities.ProductModels
+	if err := r.db.Preload("Photos").
+		Preload("Categories", "deleted_at IS NULL").
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("id ASC")
+		}).
+		Where("deleted_at IS NULL").
+		Order("id ASC").
+		Find(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func (r *ProductRepository) UpdateVariantPrice(variantID uint64, price *uint64) error {
+	return r.db.Model(&entities.ProductVariantModels{}).
+		Where("id = ? AND deleted_at IS NULL", variantID).
+		Updates(map[string]interface{}{
+			"price":      price,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (r *ProductRepository) GetOptionTypes() ([]*entities.OptionTypeModels, error) {
+	var optionTypes []*entities.OptionTypeModels
+	if err := r.db.Preload("Values", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Order("id ASC").Find(&optionTypes).Error; err != nil {
+		return nil, err
+	}
+	return optionTypes, nil
+}
+
+func (r *ProductRepository) GetOptionTypeByID(optionTypeID uint64) (*entities.OptionTypeModels, error) {
+	var optionType *entities.OptionTypeModels
+	if err := r.db.Preload("Values").Where("id = ?", optionTypeID).First(&optionType).Error; err != nil {
+		return nil, err
+	}
+	return optionType, nil
+}
+
+func (r *ProductRepository) CreateOptionType(newData *entities.OptionTypeModels) (*entities.OptionTypeModels, error) {
+	if err := r.db.Create(newData).Error; err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (r *ProductRepository) CreateOptionValue(newData *entities.OptionValueModels) (*entities.OptionValueModels, error) {
+	if err := r.db.Omit(clause.Associations).Create(newData).Error; err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (r *ProductRepository) GetOptionValuesByIDs(optionValueIDs []uint64) ([]*entities.OptionValueModels, error) {
+	var values []*entities.OptionValueModels
+	if err := r.db.Preload("OptionType").Where("id IN ?", optionValueIDs).Find(&values).Error; err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// sizeColorOptions returns the Size and Color option values of a size and color, creating the
+// ones that don't exist yet.
+func sizeColorOptions(tx *gorm.DB, size, color string) ([]*entities.OptionValueModels, error) {
+	var values []*entities.OptionValueModels
+	for _, option := range []struct{ typeName, value string }{
+		{domain.OptionTypeSize, size},
+		{domain.OptionTypeColor, color},
+	} {
+		if option.value == "" {
+			continue
+		}
+
+		now := time.Now()
+		var optionType entities.OptionTypeModels
+		if err := tx.Where(entities.OptionTypeModels{Name: option.typeName}).
+			Attrs(entities.OptionTypeModels{CreatedAt: now, UpdatedAt: now}).
+			FirstOrCreate(&optionType).Error; err != nil {
+			return nil, err
+		}
+
+		var value entities.OptionValueModels
+		if err := tx.Where(entities.OptionValueModels{OptionTypeID: optionType.ID, Value: option.value}).
+			Attrs(entities.OptionValueModels{CreatedAt: now, UpdatedAt: now}).
+			FirstOrCreate(&value).Error; err != nil {
+			return nil, err
+		}
+		values = append(values, &value)
+	}
+	return values, nil
+}
+
+// syncSizeColorOptions points the variant's Size and Color option values at its size and color,
+// leaving its values of other option types alone.
+func syncSizeColorOptions(tx *gorm.DB, variant *entities.ProductVariantModels) error {
+	values, err := sizeColorOptions(tx, variant.Size, variant.Color)
+	if err != nil {
+		return err
+	}
+
+	var kept []*entities.OptionValueModels
+	if err := tx.Joins("JOIN variant_option_values vo ON vo.option_value_id = option_values.id").
+		Joins("JOIN option_types t ON t.id = option_values.option_type_id").
+		Where("vo.variant_id = ? AND t.name NOT IN ?", variant.ID, []string{domain.OptionTypeSize, domain.OptionTypeColor}).
+		Find(&kept).Error; err != nil {
+		return err
+	}
+	return tx.Model(variant).Association("OptionValues").Replace(append(kept, values...))
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	order "ruti-store/module/feature/order/domain"
+	"ruti-store/module/feature/product/domain"
+)
+
+// searchSQL renders the query the build function makes on the products, without a database.
+func searchSQL(t *testing.T, build func(query *gorm.DB) *gorm.DB) string {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	assert.Nil(t, err)
+
+	return db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var productIDs []uint64
+		return build(tx.Table("product p")).Find(&productIDs)
+	})
+}
+
+func TestSearchFilters(t *testing.T) {
+	minPrice, maxPrice := uint64(50000), uint64(150000)
+	req := &domain.SearchProductRequest{
+		Query:       "kemeja",
+		CategoryIDs: []uint64{3},
+		MinPrice:    &minPrice,
+		MaxPrice:    &maxPrice,
+		MinRating:   4,
+		Sizes:       []string{" XL "},
+		Colors:      []string{"Navy"},
+		InStock:     true,
+	}
+
+	t.Run("Success Case - No Filters", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchFilters(query, &domain.SearchProductRequest{}, "", false)
+		})
+
+		assert.Contains(t, sql, "p.deleted_at IS NULL")
+		assert.NotContains(t, sql, "search_vector")
+		assert.NotContains(t, sql, "variants v")
+	})
+
+	t.Run("Success Case - Every Filter", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchFilters(query, req, "", false)
+		})
+
+		assert.Contains(t, sql, "p.search_vector @@ websearch_to_tsquery('simple', 'kemeja')")
+		assert.Contains(t, sql, "p.name ILIKE '%kemeja%'")
+		assert.Contains(t, sql, "pc.category_models_id IN (3)")
+		assert.Contains(t, sql, "p.rating >= 4")
+		assert.Contains(t, sql, "LOWER(v.size) IN ('xl')")
+		assert.Contains(t, sql, "LOWER(v.color) IN ('navy')")
+		assert.Contains(t, sql, "v.stock > 0")
+		assert.Contains(t, sql, variantSellingPrice+" >= 50000")
+		assert.Contains(t, sql, variantSellingPrice+" <= 150000")
+	})
+
+	t.Run("Success Case - Variant Filters Held By One Variant", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchFilters(query, req, "", false)
+		})
+
+		assert.Contains(t, sql, "EXISTS (SELECT 1 FROM variants v WHERE v.product_id = p.id AND v.deleted_at IS NULL AND LOWER(v.size) IN ('xl') AND LOWER(v.color) IN ('navy') AND v.stock > 0")
+	})
+
+	t.Run("Success Case - Variant Filters On The Joined Variant", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchFilters(query.Joins(searchVariantJoin), req, "", true)
+		})
+
+		assert.NotContains(t, sql, "EXISTS (SELECT 1 FROM variants v")
+		assert.Contains(t, sql, "LOWER(v.size) IN ('xl') AND LOWER(v.color) IN ('navy') AND v.stock > 0")
+	})
+}
+
+func TestSearchFilters_FacetSkipping(t *testing.T) {
+	minPrice := uint64(50000)
+	req := &domain.SearchProductRequest{
+		CategoryIDs: []uint64{3},
+		MinPrice:    &minPrice,
+		MinRating:   4,
+		Sizes:       []string{"XL"},
+		Colors:      []string{"Navy"},
+		InStock:     true,
+	}
+	filters := map[string]string{
+		facetCategory: "pc.category_models_id IN",
+		facetRating:   "p.rating >=",
+		facetSize:     "LOWER(v.size) IN",
+		facetColor:    "LOWER(v.color) IN",
+		facetStock:    "v.stock > 0",
+		facetPrice:    variantSellingPrice + " >=",
+	}
+
+	for skip := range filters {
+		t.Run("Success Case - Skip "+skip, func(t *testing.T) {
+			sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+				return searchFilters(query, req, skip, false)
+			})
+
+			for facet, filter := range filters {
+				if facet == skip {
+					assert.NotContains(t, sql, filter)
+				} else {
+					assert.Contains(t, sql, filter)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchOrder(t *testing.T) {
+	const newest = "ORDER BY p.created_at DESC, p.id DESC"
+
+	t.Run("Success Case - Newest Without A Query", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchOrder(query, &domain.SearchProductRequest{})
+		})
+
+		assert.Contains(t, sql, newest)
+	})
+
+	t.Run("Success Case - Relevance With A Query", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchOrder(query, &domain.SearchProductRequest{Query: "kemeja"})
+		})
+
+		assert.Contains(t, sql, "ORDER BY ts_rank(p.search_vector, websearch_to_tsquery('simple', 'kemeja'))")
+	})
+
+	t.Run("Success Case - Relevance Without A Query Falls Back To Newest", func(t *testing.T) {
+		sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+			return searchOrder(query, &domain.SearchProductRequest{Sort: domain.SearchSortRelevance})
+		})
+
+		assert.Contains(t, sql, newest)
+		assert.NotContains(t, sql, "ts_rank")
+	})
+
+	t.Run("Success Case - Requested Orders", func(t *testing.T) {
+		orders := map[string]string{
+			domain.SearchSortPriceAsc:    "ASC NULLS LAST, p.created_at DESC",
+			domain.SearchSortPriceDesc:   "DESC NULLS LAST, p.created_at DESC",
+			domain.SearchSortBestSelling: "o.payment_status = '" + string(order.PaymentStatusPaid) + "') DESC",
+			domain.SearchSortRating:      "ORDER BY p.rating DESC, p.total_reviews DESC",
+			domain.SearchSortNewest:      newest,
+		}
+		for sort, expected := range orders {
+			sql := searchSQL(t, func(query *gorm.DB) *gorm.DB {
+				return searchOrder(query, &domain.SearchProductRequest{Query: "kemeja", Sort: sort})
+			})
+
+			assert.Contains(t, sql, expected, sort)
+			assert.NotContains(t, sql, "ts_rank", sort)
+		}
+	})
+}
@@ -0,0 +1,471 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/product/domain"
+	"ruti-store/utils/validator"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSKULength is the size of the variants' sku column.
+const maxSKULength = 64
+
+// ImportProducts checks every row of a product import and, unless it's a dry run or any row has
+// errors, upserts the products and their variants by SKU.
+func (s *ProductService) ImportProducts(adminID uint64, records [][]string, dryRun bool) (*domain.ImportProductResponse, error) {
+	rows, errs, err := parseImportRows(records)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := s.planImport(rows, errs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportProductResponse{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Errors:    errs.list(),
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	for _, item := range products {
+		if item.Product.ID == 0 {
+			result.ProductsCreated++
+		} else {
+			result.ProductsUpdated++
+		}
+		for _, variant := range item.Variants {
+			if variant.ID == 0 {
+				result.VariantsCreated++
+			} else {
+				result.VariantsUpdated++
+			}
+		}
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	movement := domain.StockMovement{
+		Reason:    domain.MovementImport,
+		ActorID:   adminID,
+		ActorRole: "admin",
+	}
+	if err := s.repo.ImportProducts(products, movement); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExportProducts returns the product catalogue laid out like an import file, one row per variant.
+func (s *ProductService) ExportProducts() ([][]interface{}, error) {
+	products, err := s.repo.GetProductsForExport()
+	if err != nil {
+		return nil, err
+	}
+
+	var data [][]interface{}
+	for _, product := range products {
+		categories := make([]string, len(product.Categories))
+		for i, category := range product.Categories {
+			categories[i] = category.Name
+		}
+		images := make([]string, len(product.Photos))
+		for i, photo := range product.Photos {
+			images[i] = photo.URL
+		}
+
+		for _, variant := range product.Variants {
+			barcode := ""
+			if variant.Barcode != nil {
+				barcode = *variant.Barcode
+			}
+			data = append(data, []interface{}{
+				variant.SKU,
+				barcode,
+				product.Name,
+				product.Description,
+				product.Price,
+				product.Discount,
+				product.Status,
+				strings.Join(categories, ", "),
+				variant.Size,
+				variant.Color,
+				variant.Stock,
+				variant.Weight,
+				strings.Join(images, ", "),
+			})
+		}
+	}
+	return data, nil
+}
+
+// importErrors collects the errors of an import by row.
+type importErrors map[int]*domain.ImportRowError
+
+func (e importErrors) add(row *domain.ProductImportRow, format string, args ...interface{}) {
+	rowError, ok := e[row.Row]
+	if !ok {
+		rowError = &domain.ImportRowError{Row: row.Row, SKU: row.SKU}
+		e[row.Row] = rowError
+	}
+	rowError.Errors = append(rowError.Errors, fmt.Sprintf(format, args...))
+}
+
+func (e importErrors) list() []domain.ImportRowError {
+	list := make([]domain.ImportRowError, 0, len(e))
+	for _, rowError := range e {
+		list = append(list, *rowError)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Row < list[j].Row
+	})
+	return list
+}
+
+// parseImportRows reads the rows of an import file after its header and checks each row on its
+// own. Blank rows are skipped, but still count for the row numbers.
+func parseImportRows(records [][]string) ([]*domain.ProductImportRow, importErrors, error) {
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%w: the file is empty", domain.ErrInvalidImportFile)
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		header = strings.TrimPrefix(header, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(header))] = i
+	}
+	for _, header := range domain.ProductImportHeaders {
+		if _, ok := columns[header]; !ok {
+			return nil, nil, fmt.Errorf("%w: missing column %q", domain.ErrInvalidImportFile, header)
+		}
+	}
+
+	errs := make(importErrors)
+	rows := make([]*domain.ProductImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		cell := func(header string) string {
+			if index := columns[header]; index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+			return ""
+		}
+
+		row := &domain.ProductImportRow{
+			Row:         i + 2,
+			SKU:         cell("sku"),
+			Barcode:     cell("barcode"),
+			Name:        cell("name"),
+			Description: cell("description"),
+			Status:      cell("status"),
+			Categories:  splitList(cell("categories")),
+			Size:        cell("size"),
+			Color:       cell("color"),
+			Images:      splitList(cell("images")),
+		}
+		rows = append(rows, row)
+
+		switch {
+		case row.SKU == "":
+			errs.add(row, "sku is required")
+		case len(row.SKU) > maxSKULength:
+			errs.add(row, "sku can't be longer than %d characters", maxSKULength)
+		}
+		if row.Barcode != "" && !validator.IsEAN13(row.Barcode) {
+			errs.add(row, "barcode %s is not a valid EAN-13 barcode", row.Barcode)
+		}
+		for _, header := range []string{"name", "description", "size", "color"} {
+			if cell(header) == "" {
+				errs.add(row, "%s is required", header)
+			}
+		}
+		if len(row.Categories) == 0 {
+			errs.add(row, "categories is required")
+		}
+
+		var priceOK, weightOK bool
+		row.Price, priceOK = parseImportNumber(errs, row, "price", cell("price"), true)
+		row.Discount, _ = parseImportNumber(errs, row, "discount", cell("discount"), false)
+		row.Stock, _ = parseImportNumber(errs, row, "stock", cell("stock"), true)
+		row.Weight, weightOK = parseImportNumber(errs, row, "weight", cell("weight"), true)
+		if priceOK && row.Price == 0 {
+			errs.add(row, "price must be greater than 0")
+		}
+		if priceOK && row.Discount > 0 && row.Discount >= row.Price {
+			errs.add(row, "discount must be lower than price")
+		}
+		if weightOK && row.Weight == 0 {
+			errs.add(row, "weight must be greater than 0")
+		}
+
+		for _, image := range row.Images {
+			link, err := url.ParseRequestURI(image)
+			if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+				errs.add(row, "image %s is not a valid URL", image)
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("%w: the file has no rows to import", domain.ErrInvalidImportFile)
+	}
+	return rows, errs, nil
+}
+
+// parseImportNumber reads a number cell of the row, adding an error when it isn't a number or a
+// required cell is empty. It reports whether the cell held a number.
+func parseImportNumber(errs importErrors, row *domain.ProductImportRow, header, value string, required bool) (uint64, bool) {
+	if value == "" {
+		if required {
+			errs.add(row, "%s is required", header)
+		}
+		return 0, false
+	}
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		errs.add(row, "%s must be a whole number of at least 0", header)
+		return 0, false
+	}
+	return number, true
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// planImport groups the rows into products by name and checks them against each other and the
+// store: SKUs and variants must be unique, rows of a product must agree on its details and the
+// categories must exist. Rows whose SKU is already on the store update that variant and its
+// product; otherwise the product is matched by name, or created.
+func (s *ProductService) planImport(rows []*domain.ProductImportRow, errs importErrors) ([]*domain.ImportProduct, error) {
+	var names, skus, barcodes, categoryNames []string
+	groups := make(map[string][]*domain.ProductImportRow)
+	skuRows := make(map[string]int)
+	barcodeRows := make(map[string]*domain.ProductImportRow)
+	for _, row := range rows {
+		if row.Barcode != "" {
+			if first, ok := barcodeRows[row.Barcode]; ok {
+				errs.add(row, "barcode %s is already used on row %d", row.Barcode, first.Row)
+			} else {
+				barcodeRows[row.Barcode] = row
+				barcodes = append(barcodes, row.Barcode)
+			}
+		}
+		if row.SKU != "" {
+			if first, ok := skuRows[row.SKU]; ok {
+				errs.add(row, "sku %s is already used on row %d", row.SKU, first)
+			} else {
+				skuRows[row.SKU] = row.Row
+				skus = append(skus, row.SKU)
+			}
+		}
+		for _, category := range row.Categories {
+			categoryNames = append(categoryNames, strings.ToLower(category))
+		}
+		if row.Name == "" {
+			continue
+		}
+		key := strings.ToLower(row.Name)
+		if _, ok := groups[key]; !ok {
+			names = append(names, key)
+		}
+		groups[key] = append(groups[key], row)
+	}
+
+	categoryIDs := make(map[string]uint64)
+	if len(categoryNames) > 0 {
+		categories, err := s.repo.GetCategoriesByNames(categoryNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range categories {
+			categoryIDs[strings.ToLower(category.Name)] = category.ID
+		}
+	}
+
+	variants := make(map[string]*entities.ProductVariantModels)
+	if len(skus) > 0 {
+		existing, err := s.repo.GetVariantsBySKU(skus)
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range existing {
+			variants[variant.SKU] = variant
+		}
+	}
+
+	if len(barcodes) > 0 {
+		existing, err := s.repo.GetVariantsByBarcode(barcodes)
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range existing {
+			if row := barcodeRows[*variant.Barcode]; variant.SKU != row.SKU {
+				errs.add(row, "barcode %s is already used by sku %s", row.Barcode, variant.SKU)
+			}
+		}
+	}
+
+	productsByName := make(map[string]*entities.ProductModels)
+	if len(names) > 0 {
+		existing, err := s.repo.GetProductsByNames(names)
+		if err != nil {
+			return nil, err
+		}
+		for _, product := range existing {
+			key := strings.ToLower(product.Name)
+			if _, ok := productsByName[key]; !ok {
+				productsByName[key] = product
+			}
+		}
+	}
+
+	now := time.Now()
+	products := make([]*domain.ImportProduct, 0, len(names))
+	for _, key := range names {
+		groupRows := groups[key]
+		first := groupRows[0]
+
+		var product *entities.ProductModels
+		ownerRow := 0
+		for _, row := range groupRows {
+			variant, ok := variants[row.SKU]
+			if !ok {
+				continue
+			}
+			if product == nil {
+				found, err := s.repo.GetProductByID(variant.ProductID)
+				if err != nil {
+					errs.add(row, "sku %s belongs to a product that was deleted", row.SKU)
+					continue
+				}
+				product, ownerRow = found, row.Row
+			} else if variant.ProductID != product.ID {
+				errs.add(row, "sku %s belongs to another product than row %d", row.SKU, ownerRow)
+			}
+		}
+		if product == nil {
+			product = productsByName[key]
+		}
+
+		item := &domain.ImportProduct{
+			Product: &entities.ProductModels{
+				Name:        first.Name,
+				Description: first.Description,
+				Price:       first.Price,
+				Discount:    first.Discount,
+				Status:      first.Status,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
+		}
+		if product != nil {
+			item.Product.ID = product.ID
+			item.Product.CreatedAt = product.CreatedAt
+			if item.Product.Status == "" {
+				item.Product.Status = product.Status
+			}
+		}
+
+		seenCategories := make(map[uint64]bool)
+		seenImages := make(map[string]bool)
+		seenVariants := make(map[string]int)
+		for _, row := range groupRows {
+			if row != first {
+				checkSameProduct(errs, row, first)
+			}
+
+			for _, name := range row.Categories {
+				categoryID, ok := categoryIDs[strings.ToLower(name)]
+				if !ok {
+					errs.add(row, "category %s doesn't exist", name)
+					continue
+				}
+				if !seenCategories[categoryID] {
+					seenCategories[categoryID] = true
+					item.CategoryIDs = append(item.CategoryIDs, categoryID)
+				}
+			}
+			for _, image := range row.Images {
+				if !seenImages[image] {
+					seenImages[image] = true
+					item.Images = append(item.Images, image)
+				}
+			}
+
+			option := strings.ToLower(row.Size + "/" + row.Color)
+			if previous, ok := seenVariants[option]; ok {
+				errs.add(row, "size %s and color %s are already used on row %d", row.Size, row.Color, previous)
+			} else {
+				seenVariants[option] = row.Row
+			}
+			if product != nil {
+				for _, existing := range product.Variants {
+					if _, imported := skuRows[existing.SKU]; imported && existing.SKU != "" {
+						continue
+					}
+					if strings.ToLower(existing.Size+"/"+existing.Color) == option {
+						errs.add(row, "the product already has a variant of size %s and color %s", row.Size, row.Color)
+					}
+				}
+			}
+
+			variant := &entities.ProductVariantModels{
+				SKU:               row.SKU,
+				Size:              row.Size,
+				Color:             row.Color,
+				Stock:             row.Stock,
+				Weight:            row.Weight,
+				LowStockThreshold: domain.DefaultLowStockThreshold,
+				CreatedAt:         now,
+				UpdatedAt:         now,
+			}
+			if row.Barcode != "" {
+				barcode := row.Barcode
+				variant.Barcode = &barcode
+			}
+			if existing, ok := variants[row.SKU]; ok {
+				variant.ID = existing.ID
+			}
+			item.Variants = append(item.Variants, variant)
+		}
+
+		products = append(products, item)
+	}
+	return products, nil
+}
+
+// checkSameProduct reports the product details of row that differ from the first row of the
+// same product.
+func checkSameProduct(errs importErrors, row, first *domain.ProductImportRow) {
+	if row.Description != first.Description {
+		errs.add(row, "description differs from row %d of the same product", first.Row)
+	}
+	if row.Price != first.Price {
+		errs.add(row, "price differs from row %d of the same product", first.Row)
+	}
+	if row.Discount != first.Discount {
+		errs.add(row, "discount differs from row %d of the same product", first.Row)
+	}
+	if row.Status != first.Status {
+		errs.add(row, "status differs from row %d of the same product", first.Row)
+	}
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/product/domain"
+	"ruti-store/module/feature/product/mocks"
+)
+
+// importRecord is a valid row of an import file, in the order of domain.ProductImportHeaders,
+// with the given columns changed.
+func importRecord(changes map[string]string) []string {
+	values := map[string]string{
+		"sku":         "KL-M-HTM",
+		"barcode":     "4006381333931",
+		"name":        "Kemeja Linen",
+		"description": "Kemeja linen lengan panjang",
+		"price":       "150000",
+		"discount":    "10000",
+		"status":      domain.ProductStatusActive,
+		"categories":  "Kemeja, Pria",
+		"size":        "M",
+		"color":       "Hitam",
+		"stock":       "10",
+		"weight":      "250",
+		"images":      "https://example.com/kemeja.jpg",
+	}
+	for header, value := range changes {
+		values[header] = value
+	}
+	record := make([]string, len(domain.ProductImportHeaders))
+	for i, header := range domain.ProductImportHeaders {
+		record[i] = values[header]
+	}
+	return record
+}
+
+func TestParseImportRows(t *testing.T) {
+	header := append([]string{}, domain.ProductImportHeaders...)
+	bomHeader := append([]string{}, domain.ProductImportHeaders...)
+	bomHeader[0] = "\ufeff" + bomHeader[0]
+
+	tests := []struct {
+		name      string
+		records   [][]string
+		fileError bool
+		rows      []int
+		errors    map[int][]string
+	}{
+		{
+			name:      "Failed Case - Missing Header",
+			records:   [][]string{header[1:], importRecord(nil)[1:]},
+			fileError: true,
+		},
+		{
+			name:    "Success Case - BOM Prefixed Header",
+			records: [][]string{bomHeader, importRecord(nil)},
+			rows:    []int{2},
+		},
+		{
+			name: "Success Case - Blank Rows Keep Row Numbers",
+			records: [][]string{
+				header,
+				importRecord(nil),
+				{"", " "},
+				importRecord(map[string]string{"sku": "KL-L-HTM", "barcode": "", "size": "L"}),
+			},
+			rows: []int{2, 4},
+		},
+		{
+			name:      "Failed Case - Only Blank Rows",
+			records:   [][]string{header, {""}},
+			fileError: true,
+		},
+		{
+			name: "Failed Case - Non Numeric Price And Weight",
+			records: [][]string{header, importRecord(map[string]string{
+				"price": "150rb", "weight": "-1",
+			})},
+			rows: []int{2},
+			errors: map[int][]string{2: {
+				"price must be a whole number of at least 0",
+				"weight must be a whole number of at least 0",
+			}},
+		},
+		{
+			name: "Failed Case - Zero Price And Weight",
+			records: [][]string{header, importRecord(map[string]string{
+				"price": "0", "discount": "", "weight": "0",
+			})},
+			rows: []int{2},
+			errors: map[int][]string{2: {
+				"price must be greater than 0",
+				"weight must be greater than 0",
+			}},
+		},
+		{
+			name:    "Failed Case - Discount Not Below Price",
+			records: [][]string{header, importRecord(map[string]string{"discount": "150000"})},
+			rows:    []int{2},
+			errors:  map[int][]string{2: {"discount must be lower than price"}},
+		},
+		{
+			name: "Failed Case - Bad Image URL",
+			records: [][]string{header, importRecord(map[string]string{
+				"images": "https://example.com/a.jpg, kemeja.jpg, ftp://example.com/b.jpg",
+			})},
+			rows: []int{2},
+			errors: map[int][]string{2: {
+				"image kemeja.jpg is not a valid URL",
+				"image ftp://example.com/b.jpg is not a valid URL",
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rows, errs, err := parseImportRows(test.records)
+
+			if test.fileError {
+				assert.True(t, errors.Is(err, domain.ErrInvalidImportFile))
+				assert.Nil(t, rows)
+				return
+			}
+			assert.Nil(t, err)
+
+			numbers := make([]int, len(rows))
+			for i, row := range rows {
+				numbers[i] = row.Row
+			}
+			assert.Equal(t, test.rows, numbers)
+
+			assert.Len(t, errs, len(test.errors))
+			for row, messages := range test.errors {
+				if assert.Contains(t, errs, row) {
+					assert.Equal(t, messages, errs[row].Errors)
+				}
+			}
+		})
+	}
+}
+
+func importRow(row int, sku, name, size, color string) *domain.ProductImportRow {
+	return &domain.ProductImportRow{
+		Row:         row,
+		SKU:         sku,
+		Name:        name,
+		Description: "Bahan katun",
+		Price:       100000,
+		Categories:  []string{"Kemeja"},
+		Size:        size,
+		Color:       color,
+		Stock:       5,
+		Weight:      200,
+	}
+}
+
+func TestProductService_PlanImport(t *testing.T) {
+	categories := []*entities.CategoryModels{{ID: 3, Name: "Kemeja"}}
+	barcode := "4006381333931"
+
+	t.Run("Success Case - Update By SKU And Create By Name", func(t *testing.T) {
+		repo := mocks.NewProductRepositoryInterface(t)
+		service := &ProductService{repo: repo}
+
+		rows := []*domain.ProductImportRow{
+			importRow(2, "KL-M-HTM", "Kemeja Linen", "M", "Hitam"),
+			importRow(3, "KL-L-HTM", "Kemeja Linen", "L", "Hitam"),
+			importRow(4, "KP-M-PTH", "Kaos Polos", "M", "Putih"),
+			importRow(5, "TP-ALL-HTM", "Topi", "All Size", "Hitam"),
+		}
+		linen := &entities.ProductModels{ID: 1, Name: "Kemeja Linen", Status: domain.ProductStatusActive, Variants: []entities.ProductVariantModels{
+			{ID: 11, ProductID: 1, SKU: "KL-M-HTM", Size: "M", Color: "Hitam"},
+		}}
+		repo.On("GetCategoriesByNames", []string{"kemeja", "kemeja", "kemeja", "kemeja"}).Return(categories, nil).Once()
+		repo.On("GetVariantsBySKU", []string{"KL-M-HTM", "KL-L-HTM", "KP-M-PTH", "TP-ALL-HTM"}).
+			Return([]*entities.ProductVariantModels{{ID: 11, ProductID: 1, SKU: "KL-M-HTM"}}, nil).Once()
+		repo.On("GetProductsByNames", []string{"kemeja linen", "kaos polos", "topi"}).
+			Return([]*entities.ProductModels{{ID: 2, Name: "Kaos Polos", Status: domain.ProductStatusActive}}, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(linen, nil).Once()
+
+		errs := make(importErrors)
+		products, err := service.planImport(rows, errs)
+
+		assert.Nil(t, err)
+		assert.Empty(t, errs)
+		if assert.Len(t, products, 3) {
+			assert.Equal(t, uint64(1), products[0].Product.ID)
+			assert.Equal(t, uint64(11), products[0].Variants[0].ID)
+			assert.Equal(t, uint64(0), products[0].Variants[1].ID)
+			assert.Equal(t, uint64(2), products[1].Product.ID)
+			assert.Equal(t, uint64(0), products[1].Variants[0].ID)
+			assert.Equal(t, uint64(0), products[2].Product.ID)
+			assert.Equal(t, []uint64{3}, products[2].CategoryIDs)
+		}
+	})
+
+	t.Run("Failed Case - SKU Of Another Product", func(t *testing.T) {
+		repo := mocks.NewProductRepositoryInterface(t)
+		service := &ProductService{repo: repo}
+
+		rows := []*domain.ProductImportRow{
+			importRow(2, "KL-M-HTM", "Kemeja Linen", "M", "Hitam"),
+			importRow(3, "KP-M-PTH", "Kemeja Linen", "M", "Putih"),
+		}
+		repo.On("GetCategoriesByNames", mock.Anything).Return(categories, nil).Once()
+		repo.On("GetVariantsBySKU", mock.Anything).Return([]*entities.ProductVariantModels{
+			{ID: 11, ProductID: 1, SKU: "KL-M-HTM"},
+			{ID: 21, ProductID: 2, SKU: "KP-M-PTH"},
+		}, nil).Once()
+		repo.On("GetProductsByNames", mock.Anything).Return(nil, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(&entities.ProductModels{ID: 1, Name: "Kemeja Linen"}, nil).Once()
+
+		errs := make(importErrors)
+		_, err := service.planImport(rows, errs)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"sku KP-M-PTH belongs to another product than row 2"}, errs[3].Errors)
+		assert.NotContains(t, errs, 2)
+	})
+
+	t.Run("Failed Case - Duplicate SKU And Barcode", func(t *testing.T) {
+		repo := mocks.NewProductRepositoryInterface(t)
+		service := &ProductService{repo: repo}
+
+		rows := []*domain.ProductImportRow{
+			importRow(2, "KL-M-HTM", "Kemeja Linen", "M", "Hitam"),
+			importRow(3, "KL-M-HTM", "Kemeja Linen", "L", "Hitam"),
+		}
+		rows[0].Barcode, rows[1].Barcode = barcode, barcode
+		repo.On("GetCategoriesByNames", mock.Anything).Return(categories, nil).Once()
+		repo.On("GetVariantsBySKU", []string{"KL-M-HTM"}).Return(nil, nil).Once()
+		repo.On("GetVariantsByBarcode", []string{barcode}).Return(nil, nil).Once()
+		repo.On("GetProductsByNames", []string{"kemeja linen"}).Return(nil, nil).Once()
+
+		errs := make(importErrors)
+		_, err := service.planImport(rows, errs)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			"barcode 4006381333931 is already used on row 2",
+			"sku KL-M-HTM is already used on row 2",
+		}, errs[3].Errors)
+		assert.NotContains(t, errs, 2)
+	})
+
+	t.Run("Failed Case - Duplicate Size And Color", func(t *testing.T) {
+		repo := mocks.NewProductRepositoryInterface(t)
+		service := &ProductService{repo: repo}
+
+		rows := []*domain.ProductImportRow{
+			importRow(2, "KL-M-HTM", "Kemeja Linen", "M", "Hitam"),
+			importRow(3, "KL-M-HTM-2", "kemeja linen", "m", "hitam"),
+		}
+		repo.On("GetCategoriesByNames", mock.Anything).Return(categories, nil).Once()
+		repo.On("GetVariantsBySKU", mock.Anything).Return(nil, nil).Once()
+		repo.On("GetProductsByNames", []string{"kemeja linen"}).Return(nil, nil).Once()
+
+		errs := make(importErrors)
+		products, err := service.planImport(rows, errs)
+
+		assert.Nil(t, err)
+		assert.Len(t, products, 1)
+		assert.Equal(t, []string{"size m and color hitam are already used on row 2"}, errs[3].Errors)
+	})
+
+	t.Run("Failed Case - Barcode Of Another SKU", func(t *testing.T) {
+		repo := mocks.NewProductRepositoryInterface(t)
+		service := &ProductService{repo: repo}
+
+		rows := []*domain.ProductImportRow{importRow(2, "KL-L-HTM", "Kemeja Linen", "L", "Hitam")}
+		rows[0].Barcode = barcode
+		repo.On("GetCategoriesByNames", mock.Anything).Return(categories, nil).Once()
+		repo.On("GetVariantsBySKU", mock.Anything).Return(nil, nil).Once()
+		repo.On("GetVariantsByBarcode", []string{barcode}).
+			Return([]*entities.ProductVariantModels{{ID: 11, SKU: "KL-M-HTM", Barcode: &barcode}}, nil).Once()
+		repo.On("GetProductsByNames", mock.Anything).Return(nil, nil).Once()
+
+		errs := make(importErrors)
+		_, err := service.planImport(rows, errs)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"barcode 4006381333931 is already used by sku KL-M-HTM"}, errs[2].Errors)
+	})
+}
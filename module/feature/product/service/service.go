@@ -2,19 +2,25 @@ package service
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"ruti-store/module/entities"
+	notification "ruti-store/module/feature/notification/domain"
 	"ruti-store/module/feature/product/domain"
+	"sort"
+	"strings"
 	"time"
 )
 
 type ProductService struct {
-	repo domain.ProductRepositoryInterface
+	repo                domain.ProductRepositoryInterface
+	notificationService notification.NotificationServiceInterface
 }
 
-func NewProductService(repo domain.ProductRepositoryInterface) domain.ProductServiceInterface {
+func NewProductService(repo domain.ProductRepositoryInterface, notificationService notification.NotificationServiceInterface) domain.ProductServiceInterface {
 	return &ProductService{
-		repo: repo,
+		repo:                repo,
+		notificationService: notificationService,
 	}
 }
 
@@ -78,6 +84,9 @@ func (s *ProductService) UpdateProduct(productID uint64, req *domain.UpdateProdu
 	if err != nil {
 		return errors.New("product not found")
 	}
+	if err := checkVariantPrices(product, req.Price, req.Discount); err != nil {
+		return err
+	}
 
 	newData := &entities.ProductModels{
 		Name:        req.Name,
@@ -93,6 +102,26 @@ func (s *ProductService) UpdateProduct(productID uint64, req *domain.UpdateProdu
 	}
 	return nil
 }
+
+// checkVariantPrices rejects a product price or discount that would leave a variant priced at or
+// below the discount. A zero price or discount is left unchanged by the update.
+func checkVariantPrices(product *entities.ProductModels, price, discount uint64) error {
+	updated := *product
+	if price != 0 {
+		updated.Price = price
+	}
+	if discount != 0 {
+		updated.Discount = discount
+	}
+	for i := range updated.Variants {
+		variant := &updated.Variants[i]
+		if variant.DeletedAt == nil && domain.VariantPrice(&updated, variant) <= updated.Discount {
+			return domain.ErrInvalidVariantPrice
+		}
+	}
+	return nil
+}
+
 func (s *ProductService) DeleteProduct(productID uint64) error {
 	product, err := s.repo.GetProductByID(productID)
 	if err != nil {
@@ -173,35 +202,170 @@ func (s *ProductService) UpdatePhotoProduct(productID uint64, photo string) erro
 	return nil
 }
 
-func (s *ProductService) ReduceStockWhenPurchasing(productID, quantity uint64) error {
-	products, err := s.repo.GetProductByID(productID)
+func (s *ProductService) ReduceStockWhenPurchasing(variantID, quantity uint64, movement domain.StockMovement) error {
+	variant, err := s.repo.GetVariantByID(variantID)
 	if err != nil {
-		return errors.New("product not found")
+		return errors.New("variant not found")
 	}
 
-	//if products.Stock < quantity {
-	//	return errors.New("stock not enough")
-	//}
+	if variant.Stock < quantity {
+		return domain.ErrOutOfStock
+	}
 
-	if err := s.repo.ReduceStockWhenPurchasing(products.ID, quantity); err != nil {
+	if err := s.repo.ReduceStockWhenPurchasing(variant.ID, quantity, movement); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *ProductService) IncreaseStock(productID, quantity uint64) error {
-	products, err := s.repo.GetProductByID(productID)
+func (s *ProductService) IncreaseStock(variantID, quantity uint64, movement domain.StockMovement) error {
+	variant, err := s.repo.GetVariantByID(variantID)
 	if err != nil {
-		return errors.New("product not found")
+		return errors.New("variant not found")
 	}
 
-	err = s.repo.IncreaseStock(products.ID, quantity)
+	err = s.repo.IncreaseStock(variant.ID, quantity, movement)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// AdjustStock adds req.Quantity to the variant's stock, or removes it when it is negative.
+func (s *ProductService) AdjustStock(adminID uint64, req *domain.AdjustStockRequest) (*entities.ProductVariantModels, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, errors.New("variant not found")
+	}
+
+	movement := domain.StockMovement{
+		Reason:    domain.MovementAdjustment,
+		ActorID:   adminID,
+		ActorRole: "admin",
+		Note:      req.Reason,
+	}
+	if err := s.repo.AdjustStock(variant.ID, req.Quantity, movement); err != nil {
+		return nil, err
+	}
+	return s.repo.GetVariantByID(variant.ID)
+}
+
+// StockTake sets the variant's stock from what was counted, leaving the reserved units out.
+func (s *ProductService) StockTake(adminID uint64, req *domain.StockTakeRequest) (*entities.ProductVariantModels, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, errors.New("variant not found")
+	}
+
+	movement := domain.StockMovement{
+		Reason:    domain.MovementStockTake,
+		ActorID:   adminID,
+		ActorRole: "admin",
+		Note:      req.Note,
+	}
+	if err := s.repo.SetStock(variant.ID, req.Stock, movement); err != nil {
+		return nil, err
+	}
+	return s.repo.GetVariantByID(variant.ID)
+}
+
+func (s *ProductService) GetStockMovements(variantID uint64, page, pageSize int) ([]*entities.InventoryMovementModels, int64, error) {
+	variant, err := s.repo.GetVariantByID(variantID)
+	if err != nil {
+		return nil, 0, errors.New("variant not found")
+	}
+
+	result, totalItems, err := s.repo.GetStockMovements(variant.ID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, totalItems, nil
+}
+
+func (s *ProductService) GetStockDiscrepancies() ([]*domain.StockDiscrepancyResponse, error) {
+	result, err := s.repo.GetStockDiscrepancies()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReconcileStock makes the variant's ledger add up to its current stock again, taking the stock
+// as the truth.
+func (s *ProductService) ReconcileStock(adminID, variantID uint64) error {
+	variant, err := s.repo.GetVariantByID(variantID)
+	if err != nil {
+		return errors.New("variant not found")
+	}
+
+	movement := domain.StockMovement{
+		Reason:    domain.MovementReconciliation,
+		ActorID:   adminID,
+		ActorRole: "admin",
+		Note:      "ledger reconciled with the current stock",
+	}
+	return s.repo.ReconcileStock(variant.ID, movement)
+}
+
+func (s *ProductService) UpdateLowStockThreshold(req *domain.LowStockThresholdRequest) (*entities.ProductVariantModels, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, errors.New("variant not found")
+	}
+
+	if err := s.repo.UpdateLowStockThreshold(variant.ID, req.Threshold); err != nil {
+		return nil, err
+	}
+	return s.repo.GetVariantByID(variant.ID)
+}
+
+func (s *ProductService) GetLowStockVariants() ([]*domain.LowStockResponse, error) {
+	result, err := s.repo.GetLowStockVariants(false)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CheckLowStock warns the admins of variants whose stock dropped to or below their threshold.
+// Each variant is only warned of once until it is restocked above the threshold: it is marked
+// before the admins are notified, and the mark is taken back when notifying them fails. It
+// returns how many variants the admins were warned of.
+func (s *ProductService) CheckLowStock() (int, error) {
+	if err := s.repo.ResetLowStockAlerts(); err != nil {
+		return 0, err
+	}
+
+	variants, err := s.repo.GetLowStockVariants(true)
+	if err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for _, variant := range variants {
+		req := &notification.CreateAdminNotificationRequest{
+			Title:   "Stok Menipis",
+			Message: fmt.Sprintf("Stok %s (%s/%s) tinggal %d, sudah mencapai batas minimum %d. Segera lakukan restock!", variant.ProductName, variant.Size, variant.Color, variant.Stock, variant.LowStockThreshold),
+		}
+		marked, err := s.repo.MarkLowStockNotified(variant.VariantID, time.Now())
+		if err != nil {
+			return notified, err
+		}
+		if !marked {
+			continue
+		}
+		if err := s.notificationService.NotifyAdmins(req); err != nil {
+			if unmarkErr := s.repo.UnmarkLowStockNotified(variant.VariantID); unmarkErr != nil {
+				return notified, fmt.Errorf("%v; failed to unmark variant %d: %v", err, variant.VariantID, unmarkErr)
+			}
+			return notified, err
+		}
+		notified++
+	}
+
+	return notified, nil
+}
+
 func (s *ProductService) GetProductRecommendation() ([]string, error) {
 	result, err := s.repo.GenerateRecommendationProduct()
 	if err != nil {
@@ -223,22 +387,63 @@ func (s *ProductService) GetAllProductsRecommendation() ([]*entities.ProductMode
 	return result, nil
 }
 
-func (s *ProductService) SearchAndPaginateProducts(name string, page, pageSize int) ([]*entities.ProductModels, int64, error) {
-	result, totalItems, err := s.repo.SearchAndPaginateProducts(name, page, pageSize)
+func (s *ProductService) SearchProducts(req *domain.SearchProductRequest, page, pageSize int) ([]*entities.ProductModels, int64, error) {
+	result, totalItems, err := s.repo.SearchProducts(req, page, pageSize)
 	if err != nil {
 		return nil, 0, err
 	}
 	return result, totalItems, nil
 }
 
+func (s *ProductService) GetSearchFacets(req *domain.SearchProductRequest) (*domain.SearchFacetResponse, error) {
+	facets, err := s.repo.GetSearchFacets(req)
+	if err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
+
 func (s *ProductService) CreateVariantProduct(req *domain.CreateVariantRequest) (*entities.ProductVariantModels, error) {
+	product, err := s.repo.GetProductByID(req.ProductID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+	if req.Price != nil && *req.Price <= product.Discount {
+		return nil, domain.ErrInvalidVariantPrice
+	}
+
+	barcode, err := s.checkVariantCodes(0, req.SKU, req.Barcode)
+	if err != nil {
+		return nil, err
+	}
+
 	newData := &entities.ProductVariantModels{
-		ProductID: req.ProductID,
-		Size:      req.Size,
-		Color:     req.Color,
-		Stock:     req.Stock,
-		Weight:    req.Weight,
+		ProductID:         req.ProductID,
+		SKU:               req.SKU,
+		Barcode:           barcode,
+		Size:              req.Size,
+		Color:             req.Color,
+		Price:             req.Price,
+		Stock:             req.Stock,
+		Weight:            req.Weight,
+		LowStockThreshold: domain.DefaultLowStockThreshold,
+	}
+	if req.LowStockThreshold != nil {
+		newData.LowStockThreshold = *req.LowStockThreshold
+	}
+	if len(req.OptionValueIDs) > 0 {
+		if err := s.applyOptionValues(newData, req.OptionValueIDs); err != nil {
+			return nil, err
+		}
 	}
+
+	signature := variantSignature(newData)
+	for i := range product.Variants {
+		if product.Variants[i].DeletedAt == nil && variantSignature(&product.Variants[i]) == signature {
+			return nil, domain.ErrVariantExists
+		}
+	}
+
 	result, err := s.repo.CreateVariantProduct(newData)
 	if err != nil {
 		return nil, err
@@ -257,3 +462,195 @@ func (s *ProductService) UpdateStatusProduct(req *domain.UpdateStatusRequest) er
 	}
 	return nil
 }
+
+// UpdateVariantCodes sets the SKU and barcode of a variant, which have to be unique among all
+// variants.
+func (s *ProductService) UpdateVariantCodes(req *domain.UpdateVariantCodesRequest) (*domain.VariantProductResponse, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, domain.ErrVariantNotFound
+	}
+
+	barcode, err := s.checkVariantCodes(variant.ID, req.SKU, req.Barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateVariantCodes(variant.ID, req.SKU, barcode); err != nil {
+		return nil, err
+	}
+	return s.variantResponse(variant.ID)
+}
+
+// variantResponse formats a variant with the prices of its product.
+func (s *ProductService) variantResponse(variantID uint64) (*domain.VariantProductResponse, error) {
+	variant, err := s.repo.GetVariantByID(variantID)
+	if err != nil {
+		return nil, err
+	}
+	product, err := s.repo.GetProductByID(variant.ProductID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+	return domain.ResponseDetailVariantProducts(product, variant), nil
+}
+
+// checkVariantCodes rejects an SKU or barcode another variant than variantID already has, and
+// returns the barcode to store, nil when there's none.
+func (s *ProductService) checkVariantCodes(variantID uint64, sku, barcode string) (*string, error) {
+	if sku != "" {
+		variants, err := s.repo.GetVariantsBySKU([]string{sku})
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range variants {
+			if variant.ID != variantID {
+				return nil, domain.ErrSKUTaken
+			}
+		}
+	}
+
+	if barcode == "" {
+		return nil, nil
+	}
+	variants, err := s.repo.GetVariantsByBarcode([]string{barcode})
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if variant.ID != variantID {
+			return nil, domain.ErrBarcodeTaken
+		}
+	}
+	return &barcode, nil
+}
+
+// LookupVariant finds a variant by its SKU or barcode, as scanned or typed in the store or
+// warehouse.
+func (s *ProductService) LookupVariant(code string) (*domain.VariantLookupResponse, error) {
+	variant, err := s.repo.GetVariantByCode(code)
+	if err != nil {
+		return nil, domain.ErrVariantNotFound
+	}
+
+	product, err := s.repo.GetProductByID(variant.ProductID)
+	if err != nil {
+		return nil, domain.ErrVariantNotFound
+	}
+	return domain.ResponseVariantLookup(product, variant), nil
+}
+
+// applyOptionValues gives the variant the option values, at most one of each option type. Its
+// size and color are taken from its Size and Color values.
+func (s *ProductService) applyOptionValues(variant *entities.ProductVariantModels, optionValueIDs []uint64) error {
+	values, err := s.repo.GetOptionValuesByIDs(optionValueIDs)
+	if err != nil {
+		return err
+	}
+	if len(values) != len(optionValueIDs) {
+		return fmt.Errorf("%w: unknown or repeated option value", domain.ErrInvalidVariantOptions)
+	}
+
+	seen := make(map[uint64]bool)
+	for _, value := range values {
+		if seen[value.OptionTypeID] {
+			return fmt.Errorf("%w: more than one %s", domain.ErrInvalidVariantOptions, value.OptionType.Name)
+		}
+		seen[value.OptionTypeID] = true
+
+		switch {
+		case strings.EqualFold(value.OptionType.Name, domain.OptionTypeSize):
+			variant.Size = value.Value
+		case strings.EqualFold(value.OptionType.Name, domain.OptionTypeColor):
+			variant.Color = value.Value
+		}
+	}
+	variant.OptionValues = values
+	return nil
+}
+
+// variantSignature describes the options of a variant, so variants of a product with the same
+// options can be told apart from the others.
+func variantSignature(variant *entities.ProductVariantModels) string {
+	options := map[string]string{
+		strings.ToLower(domain.OptionTypeSize):  strings.ToLower(variant.Size),
+		strings.ToLower(domain.OptionTypeColor): strings.ToLower(variant.Color),
+	}
+	for _, value := range variant.OptionValues {
+		if value.OptionType != nil {
+			options[strings.ToLower(value.OptionType.Name)] = strings.ToLower(value.Value)
+		}
+	}
+
+	parts := make([]string, 0, len(options))
+	for name, value := range options {
+		if value != "" {
+			parts = append(parts, name+"="+value)
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+// UpdateVariantPrice overrides the product's price for a variant, or removes the override.
+func (s *ProductService) UpdateVariantPrice(req *domain.UpdateVariantPriceRequest) (*domain.VariantProductResponse, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, domain.ErrVariantNotFound
+	}
+	product, err := s.repo.GetProductByID(variant.ProductID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+	if req.Price != nil && *req.Price <= product.Discount {
+		return nil, domain.ErrInvalidVariantPrice
+	}
+
+	if err := s.repo.UpdateVariantPrice(variant.ID, req.Price); err != nil {
+		return nil, err
+	}
+	return s.variantResponse(variant.ID)
+}
+
+func (s *ProductService) GetOptionTypes() ([]*entities.OptionTypeModels, error) {
+	return s.repo.GetOptionTypes()
+}
+
+func (s *ProductService) CreateOptionType(req *domain.CreateOptionTypeRequest) (*entities.OptionTypeModels, error) {
+	optionTypes, err := s.repo.GetOptionTypes()
+	if err != nil {
+		return nil, err
+	}
+	for _, optionType := range optionTypes {
+		if strings.EqualFold(optionType.Name, req.Name) {
+			return nil, domain.ErrOptionExists
+		}
+	}
+
+	newData := &entities.OptionTypeModels{
+		Name:      req.Name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	return s.repo.CreateOptionType(newData)
+}
+
+func (s *ProductService) CreateOptionValue(req *domain.CreateOptionValueRequest) (*entities.OptionValueModels, error) {
+	optionType, err := s.repo.GetOptionTypeByID(req.OptionTypeID)
+	if err != nil {
+		return nil, domain.ErrOptionTypeNotFound
+	}
+	for _, value := range optionType.Values {
+		if strings.EqualFold(value.Value, req.Value) {
+			return nil, domain.ErrOptionExists
+		}
+	}
+
+	newData := &entities.OptionValueModels{
+		OptionTypeID: optionType.ID,
+		Value:        req.Value,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	return s.repo.CreateOptionValue(newData)
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	notification "ruti-store/module/feature/notification/domain"
+	notificationMocks "ruti-store/module/feature/notification/mocks"
+	"ruti-store/module/feature/product/domain"
+	"ruti-store/module/feature/product/mocks"
+	"ruti-store/utils/validator"
+)
+
+func TestProductService_UpdateStatusProduct(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	t.Run("Success Case - Declared Statuses Are Valid", func(t *testing.T) {
+		for _, status := range []string{domain.ProductStatusActive, domain.ProductStatusInactive} {
+			req := &domain.UpdateStatusRequest{ProductID: 1, Status: status}
+			assert.Nil(t, validator.ValidateStruct(req), status)
+		}
+	})
+
+	t.Run("Failed Case - Unknown Status", func(t *testing.T) {
+		req := &domain.UpdateStatusRequest{ProductID: 1, Status: "Nonaktif"}
+
+		assert.NotNil(t, validator.ValidateStruct(req))
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		repo.On("GetProductByID", uint64(1)).Return(&entities.ProductModels{ID: 1}, nil).Once()
+		repo.On("UpdateProductStatus", uint64(1), domain.ProductStatusInactive).Return(nil).Once()
+
+		err := service.UpdateStatusProduct(&domain.UpdateStatusRequest{ProductID: 1, Status: domain.ProductStatusInactive})
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Product Not Found", func(t *testing.T) {
+		repo.On("GetProductByID", uint64(2)).Return(nil, errors.New("record not found")).Once()
+
+		err := service.UpdateStatusProduct(&domain.UpdateStatusRequest{ProductID: 2, Status: domain.ProductStatusActive})
+
+		assert.EqualError(t, err, "product not found")
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_UpdateProduct(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	override := uint64(40000)
+	product := &entities.ProductModels{
+		ID:       1,
+		Price:    100000,
+		Discount: 10000,
+		Variants: []entities.ProductVariantModels{
+			{ID: 11, ProductID: 1},
+			{ID: 12, ProductID: 1, Price: &override},
+		},
+	}
+
+	t.Run("Success Case", func(t *testing.T) {
+		req := &domain.UpdateProductRequest{Name: "Kemeja", Price: 120000, Discount: 30000}
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+		repo.On("UpdateProduct", uint64(1), mock.MatchedBy(func(data *entities.ProductModels) bool {
+			return data.Price == 120000 && data.Discount == 30000
+		}), req.CategoryID).Return(nil).Once()
+
+		err := service.UpdateProduct(1, req)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Discount Above A Variant Price", func(t *testing.T) {
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		err := service.UpdateProduct(1, &domain.UpdateProductRequest{Name: "Kemeja", Discount: 40000})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidVariantPrice)
+		// Only the success case reaches the update.
+		repo.AssertNumberOfCalls(t, "UpdateProduct", 1)
+	})
+
+	t.Run("Failed Case - Price Below The Discount", func(t *testing.T) {
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		err := service.UpdateProduct(1, &domain.UpdateProductRequest{Name: "Kemeja", Price: 10000})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidVariantPrice)
+		repo.AssertNumberOfCalls(t, "UpdateProduct", 1)
+	})
+}
+
+func TestProductService_UpdateVariantCodes(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	override := uint64(80000)
+	product := &entities.ProductModels{ID: 1, Price: 100000, Discount: 5000}
+	variant := &entities.ProductVariantModels{ID: 11, ProductID: 1, SKU: "KMJ-M", Price: &override}
+
+	t.Run("Success Case - Formatted With The Product Prices", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(11)).Return(variant, nil).Twice()
+		repo.On("GetVariantsBySKU", []string{"KMJ-M"}).Return([]*entities.ProductVariantModels{variant}, nil).Once()
+		repo.On("UpdateVariantCodes", uint64(11), "KMJ-M", (*string)(nil)).Return(nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		result, err := service.UpdateVariantCodes(&domain.UpdateVariantCodesRequest{VariantID: 11, SKU: "KMJ-M"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "KMJ-M", result.SKU)
+		assert.Equal(t, uint64(80000), result.Price)
+		assert.Equal(t, uint64(5000), result.Discount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - SKU Taken", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(11)).Return(variant, nil).Once()
+		repo.On("GetVariantsBySKU", []string{"KMJ-L"}).
+			Return([]*entities.ProductVariantModels{{ID: 12, SKU: "KMJ-L"}}, nil).Once()
+
+		result, err := service.UpdateVariantCodes(&domain.UpdateVariantCodesRequest{VariantID: 11, SKU: "KMJ-L"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrSKUTaken)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_UpdateVariantPrice(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	product := &entities.ProductModels{ID: 1, Price: 100000, Discount: 20000}
+	variant := &entities.ProductVariantModels{ID: 11, ProductID: 1}
+
+	t.Run("Success Case - Formatted With The Product Prices", func(t *testing.T) {
+		price := uint64(150000)
+		updated := &entities.ProductVariantModels{ID: 11, ProductID: 1, Price: &price}
+		repo.On("GetVariantByID", uint64(11)).Return(variant, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Twice()
+		repo.On("UpdateVariantPrice", uint64(11), &price).Return(nil).Once()
+		repo.On("GetVariantByID", uint64(11)).Return(updated, nil).Once()
+
+		result, err := service.UpdateVariantPrice(&domain.UpdateVariantPriceRequest{VariantID: 11, Price: &price})
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(150000), result.Price)
+		assert.Equal(t, uint64(20000), result.Discount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Price Not Above The Discount", func(t *testing.T) {
+		price := uint64(20000)
+		repo.On("GetVariantByID", uint64(11)).Return(variant, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(product, nil).Once()
+
+		result, err := service.UpdateVariantPrice(&domain.UpdateVariantPriceRequest{VariantID: 11, Price: &price})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, domain.ErrInvalidVariantPrice)
+		// Only the success case reaches the update.
+		repo.AssertNumberOfCalls(t, "UpdateVariantPrice", 1)
+	})
+}
+
+func TestProductService_StockTake(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	t.Run("Failed Case - Variant Not Found", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(9)).Return(nil, errors.New("record not found")).Once()
+
+		result, err := service.StockTake(1, &domain.StockTakeRequest{VariantID: 9, Stock: 10})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "variant not found")
+		repo.AssertNotCalled(t, "SetStock", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed Case - Count Below Reserved Stock", func(t *testing.T) {
+		variant := &entities.ProductVariantModels{ID: 3, Stock: 4, ReservedStock: 5}
+		repo.On("GetVariantByID", uint64(3)).Return(variant, nil).Once()
+		repo.On("SetStock", uint64(3), uint64(2), mock.Anything).Return(domain.ErrCountBelowReserved).Once()
+
+		result, err := service.StockTake(1, &domain.StockTakeRequest{VariantID: 3, Stock: 2})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrCountBelowReserved))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		variant := &entities.ProductVariantModels{ID: 3, Stock: 4, ReservedStock: 5}
+		counted := &entities.ProductVariantModels{ID: 3, Stock: 7, ReservedStock: 5}
+		repo.On("GetVariantByID", uint64(3)).Return(variant, nil).Once()
+		repo.On("SetStock", uint64(3), uint64(12), mock.MatchedBy(func(movement domain.StockMovement) bool {
+			return movement.Reason == domain.MovementStockTake && movement.ActorID == 1 && movement.Note == "opname Oktober"
+		})).Return(nil).Once()
+		repo.On("GetVariantByID", uint64(3)).Return(counted, nil).Once()
+
+		result, err := service.StockTake(1, &domain.StockTakeRequest{VariantID: 3, Stock: 12, Note: "opname Oktober"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(7), result.Stock)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_ReconcileStock(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	t.Run("Failed Case - Variant Not Found", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(9)).Return(nil, errors.New("record not found")).Once()
+
+		err := service.ReconcileStock(1, 9)
+
+		assert.EqualError(t, err, "variant not found")
+		repo.AssertNotCalled(t, "ReconcileStock", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(3)).Return(&entities.ProductVariantModels{ID: 3}, nil).Once()
+		repo.On("ReconcileStock", uint64(3), mock.MatchedBy(func(movement domain.StockMovement) bool {
+			return movement.Reason == domain.MovementReconciliation && movement.ActorID == 1 && movement.ActorRole == "admin"
+		})).Return(nil).Once()
+
+		err := service.ReconcileStock(1, 3)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_GetStockMovements(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	t.Run("Failed Case - Variant Not Found", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(9)).Return(nil, errors.New("record not found")).Once()
+
+		result, total, err := service.GetStockMovements(9, 1, 10)
+
+		assert.Nil(t, result)
+		assert.Equal(t, int64(0), total)
+		assert.EqualError(t, err, "variant not found")
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		movements := []*entities.InventoryMovementModels{
+			{ID: 2, VariantID: 3, Delta: -1, StockAfter: 9, Reason: domain.MovementSale},
+			{ID: 1, VariantID: 3, Delta: 10, StockAfter: 10, Reason: domain.MovementStockTake},
+		}
+		repo.On("GetVariantByID", uint64(3)).Return(&entities.ProductVariantModels{ID: 3}, nil).Once()
+		repo.On("GetStockMovements", uint64(3), 1, 10).Return(movements, int64(2), nil).Once()
+
+		result, total, err := service.GetStockMovements(3, 1, 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, movements, result)
+		assert.Equal(t, int64(2), total)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_GetStockDiscrepancies(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	service := NewProductService(repo, nil)
+
+	t.Run("Failed Case - Query Error", func(t *testing.T) {
+		repo.On("GetStockDiscrepancies").Return(nil, errors.New("connection refused")).Once()
+
+		result, err := service.GetStockDiscrepancies()
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "connection refused")
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		discrepancies := []*domain.StockDiscrepancyResponse{
+			{VariantID: 3, ProductID: 1, ProductName: "Kemeja Linen", Stock: 7, LedgerStock: 5, Difference: 2},
+		}
+		repo.On("GetStockDiscrepancies").Return(discrepancies, nil).Once()
+
+		result, err := service.GetStockDiscrepancies()
+
+		assert.Nil(t, err)
+		assert.Equal(t, discrepancies, result)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestProductService_CheckLowStock(t *testing.T) {
+	repo := mocks.NewProductRepositoryInterface(t)
+	notificationService := notificationMocks.NewNotificationServiceInterface(t)
+	service := NewProductService(repo, notificationService)
+
+	low := []*domain.LowStockResponse{
+		{VariantID: 3, ProductName: "Kemeja Linen", Size: "M", Color: "Hitam", Stock: 2, LowStockThreshold: 5},
+		{VariantID: 4, ProductName: "Kemeja Linen", Size: "L", Color: "Hitam", Stock: 4, LowStockThreshold: 5},
+	}
+
+	t.Run("Success Case - Warned Once Per Variant", func(t *testing.T) {
+		repo.On("ResetLowStockAlerts").Return(nil).Once()
+		repo.On("GetLowStockVariants", true).Return(low, nil).Once()
+		repo.On("MarkLowStockNotified", uint64(3), mock.Anything).Return(true, nil).Once()
+		// Another run already warned of variant 4.
+		repo.On("MarkLowStockNotified", uint64(4), mock.Anything).Return(false, nil).Once()
+		notificationService.On("NotifyAdmins", mock.MatchedBy(func(req *notification.CreateAdminNotificationRequest) bool {
+			return strings.Contains(req.Message, "(M/Hitam) tinggal 2")
+		})).Return(nil).Once()
+
+		notified, err := service.CheckLowStock()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, notified)
+		repo.AssertExpectations(t)
+		notificationService.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Notify Error Unmarks The Variant", func(t *testing.T) {
+		repo.On("ResetLowStockAlerts").Return(nil).Once()
+		repo.On("GetLowStockVariants", true).Return(low[:1], nil).Once()
+		repo.On("MarkLowStockNotified", uint64(3), mock.Anything).Return(true, nil).Once()
+		notificationService.On("NotifyAdmins", mock.Anything).Return(errors.New("connection refused")).Once()
+		repo.On("UnmarkLowStockNotified", uint64(3)).Return(nil).Once()
+
+		notified, err := service.CheckLowStock()
+
+		assert.EqualError(t, err, "connection refused")
+		assert.Equal(t, 0, notified)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Failed Case - Mark Error Notifies Nobody", func(t *testing.T) {
+		repo.On("ResetLowStockAlerts").Return(nil).Once()
+		repo.On("GetLowStockVariants", true).Return(low[:1], nil).Once()
+		repo.On("MarkLowStockNotified", uint64(3), mock.Anything).Return(false, errors.New("connection refused")).Once()
+
+		notified, err := service.CheckLowStock()
+
+		assert.EqualError(t, err, "connection refused")
+		assert.Equal(t, 0, notified)
+		// Only the runs above notified the admins.
+		notificationService.AssertNumberOfCalls(t, "NotifyAdmins", 2)
+	})
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "errors"
+
+// ErrOrderItemNotFound is returned when a review is written for an order item that doesn't exist
+// or isn't of the reviewed product.
+var ErrOrderItemNotFound = errors.New("order item not found")
+
+// ErrOrderItemNotOwned is returned when a customer reviews an item of another customer's order.
+var ErrOrderItemNotOwned = errors.New("order item belongs to another user")
+
+// ErrReviewNotOpen is returned when the order of the item isn't completed yet, so its review
+// window hasn't opened.
+var ErrReviewNotOpen = errors.New("order item can't be reviewed before the order is completed")
+
+// ErrAlreadyReviewed is returned when an order item is reviewed twice.
+var ErrAlreadyReviewed = errors.New("order item is already reviewed")
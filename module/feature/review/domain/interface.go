@@ -13,6 +13,8 @@ type ReviewRepositoryInterface interface {
 	CreateReviewImages(newData *entities.ReviewPhotoModels) (*entities.ReviewPhotoModels, error)
 	CountAverageRating(productID uint64) (float64, error)
 	SetIsReviewed(orderDetailsID, productID uint64) error
+	GetOrderDetailsByID(orderDetailsID uint64) (*entities.OrderDetailsModels, error)
+	GetOrderByID(orderID string) (*entities.OrderModels, error)
 }
 
 type ReviewServiceInterface interface {
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"github.com/gofiber/fiber/v2"
 	"mime/multipart"
 	"ruti-store/module/entities"
@@ -86,6 +87,15 @@ func (h *ReviewHandler) CreateReview(c *fiber.Ctx) error {
 	}
 
 	result, err := h.service.CreateReview(currentUser.ID, req)
+	if errors.Is(err, domain.ErrOrderItemNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrOrderItemNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrReviewNotOpen) || errors.Is(err, domain.ErrAlreadyReviewed) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
 	}
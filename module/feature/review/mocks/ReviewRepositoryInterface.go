@@ -0,0 +1,282 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewRepositoryInterface is an autogenerated mock type for the ReviewRepositoryInterface type
+type ReviewRepositoryInterface struct {
+	mock.Mock
+}
+
+// CountAverageRating provides a mock function with given fields: productID
+func (_m *ReviewRepositoryInterface) CountAverageRating(productID uint64) (float64, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAverageRating")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (float64, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) float64); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReview provides a mock function with given fields: newData
+func (_m *ReviewRepositoryInterface) CreateReview(newData *entities.ReviewModels) (*entities.ReviewModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReview")
+	}
+
+	var r0 *entities.ReviewModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ReviewModels) (*entities.ReviewModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ReviewModels) *entities.ReviewModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ReviewModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReviewImages provides a mock function with given fields: newData
+func (_m *ReviewRepositoryInterface) CreateReviewImages(newData *entities.ReviewPhotoModels) (*entities.ReviewPhotoModels, error) {
+	ret := _m.Called(newData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReviewImages")
+	}
+
+	var r0 *entities.ReviewPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.ReviewPhotoModels) (*entities.ReviewPhotoModels, error)); ok {
+		return rf(newData)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.ReviewPhotoModels) *entities.ReviewPhotoModels); ok {
+		r0 = rf(newData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.ReviewPhotoModels) error); ok {
+		r1 = rf(newData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: orderID
+func (_m *ReviewRepositoryInterface) GetOrderByID(orderID string) (*entities.OrderModels, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 *entities.OrderModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.OrderModels, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.OrderModels); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderDetailsByID provides a mock function with given fields: orderDetailsID
+func (_m *ReviewRepositoryInterface) GetOrderDetailsByID(orderDetailsID uint64) (*entities.OrderDetailsModels, error) {
+	ret := _m.Called(orderDetailsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderDetailsByID")
+	}
+
+	var r0 *entities.OrderDetailsModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.OrderDetailsModels, error)); ok {
+		return rf(orderDetailsID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.OrderDetailsModels); ok {
+		r0 = rf(orderDetailsID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderDetailsModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(orderDetailsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedReviewsByProductID provides a mock function with given fields: productID, page, pageSize
+func (_m *ReviewRepositoryInterface) GetPaginatedReviewsByProductID(productID uint64, page int, pageSize int) ([]*entities.ReviewModels, error) {
+	ret := _m.Called(productID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedReviewsByProductID")
+	}
+
+	var r0 []*entities.ReviewModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.ReviewModels, error)); ok {
+		return rf(productID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.ReviewModels); ok {
+		r0 = rf(productID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) error); ok {
+		r1 = rf(productID, page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewsById provides a mock function with given fields: reviewID
+func (_m *ReviewRepositoryInterface) GetReviewsById(reviewID uint64) (*entities.ReviewModels, error) {
+	ret := _m.Called(reviewID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewsById")
+	}
+
+	var r0 *entities.ReviewModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ReviewModels, error)); ok {
+		return rf(reviewID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ReviewModels); ok {
+		r0 = rf(reviewID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(reviewID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalReviewsByProductID provides a mock function with given fields: productID
+func (_m *ReviewRepositoryInterface) GetTotalReviewsByProductID(productID uint64) (int64, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalReviewsByProductID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (int64, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) int64); ok {
+		r0 = rf(productID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetIsReviewed provides a mock function with given fields: orderDetailsID, productID
+func (_m *ReviewRepositoryInterface) SetIsReviewed(orderDetailsID uint64, productID uint64) error {
+	ret := _m.Called(orderDetailsID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIsReviewed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(orderDetailsID, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReviewRepositoryInterface creates a new instance of ReviewRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewRepositoryInterface {
+	mock := &ReviewRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/review/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewServiceInterface is an autogenerated mock type for the ReviewServiceInterface type
+type ReviewServiceInterface struct {
+	mock.Mock
+}
+
+// CreateReview provides a mock function with given fields: userID, req
+func (_m *ReviewServiceInterface) CreateReview(userID uint64, req *domain.CreateReviewRequest) (*entities.ReviewModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReview")
+	}
+
+	var r0 *entities.ReviewModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReviewRequest) (*entities.ReviewModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateReviewRequest) *entities.ReviewModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateReviewRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReviewImages provides a mock function with given fields: req
+func (_m *ReviewServiceInterface) CreateReviewImages(req *domain.CreatePhotoReviewRequest) (*entities.ReviewPhotoModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReviewImages")
+	}
+
+	var r0 *entities.ReviewPhotoModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreatePhotoReviewRequest) (*entities.ReviewPhotoModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreatePhotoReviewRequest) *entities.ReviewPhotoModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewPhotoModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreatePhotoReviewRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewById provides a mock function with given fields: reviewID
+func (_m *ReviewServiceInterface) GetReviewById(reviewID uint64) (*entities.ReviewModels, error) {
+	ret := _m.Called(reviewID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewById")
+	}
+
+	var r0 *entities.ReviewModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ReviewModels, error)); ok {
+		return rf(reviewID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ReviewModels); ok {
+		r0 = rf(reviewID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(reviewID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewsByProductID provides a mock function with given fields: productID, page, pageSize
+func (_m *ReviewServiceInterface) GetReviewsByProductID(productID uint64, page int, pageSize int) ([]*entities.ReviewModels, int64, error) {
+	ret := _m.Called(productID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewsByProductID")
+	}
+
+	var r0 []*entities.ReviewModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) ([]*entities.ReviewModels, int64, error)); ok {
+		return rf(productID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) []*entities.ReviewModels); ok {
+		r0 = rf(productID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReviewModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int64); ok {
+		r1 = rf(productID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) error); ok {
+		r2 = rf(productID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetReviewsProductPage provides a mock function with given fields: productID, currentPage, pageSize
+func (_m *ReviewServiceInterface) GetReviewsProductPage(productID uint64, currentPage int, pageSize int) (int, int, int, int, error) {
+	ret := _m.Called(productID, currentPage, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewsProductPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 int
+	var r4 error
+	if rf, ok := ret.Get(0).(func(uint64, int, int) (int, int, int, int, error)); ok {
+		return rf(productID, currentPage, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, int, int) int); ok {
+		r0 = rf(productID, currentPage, pageSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, int, int) int); ok {
+		r1 = rf(productID, currentPage, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint64, int, int) int); ok {
+		r2 = rf(productID, currentPage, pageSize)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(uint64, int, int) int); ok {
+		r3 = rf(productID, currentPage, pageSize)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	if rf, ok := ret.Get(4).(func(uint64, int, int) error); ok {
+		r4 = rf(productID, currentPage, pageSize)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// NewReviewServiceInterface creates a new instance of ReviewServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewServiceInterface {
+	mock := &ReviewServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
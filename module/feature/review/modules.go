@@ -4,6 +4,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 	"ruti-store/module/feature/middleware"
+	notification "ruti-store/module/feature/notification/domain"
+	notificationRepository "ruti-store/module/feature/notification/repository"
+	notificationService "ruti-store/module/feature/notification/service"
 	products "ruti-store/module/feature/product/domain"
 	productsRepo "ruti-store/module/feature/product/repository"
 	productsService "ruti-store/module/feature/product/service"
@@ -23,13 +26,18 @@ var (
 	productServ products.ProductServiceInterface
 	productRepo products.ProductRepositoryInterface
 	openAi      assistant.AssistantServiceInterface
+
+	notificationRepo notification.NotificationRepositoryInterface
+	notificationServ notification.NotificationServiceInterface
 )
 
 func InitializeReviews(db *gorm.DB) {
 	openAi = assistant.NewAssistantService()
 	reviewRepo = repository.NewReviewRepository(db)
 	productRepo = productsRepo.NewProductRepository(db, openAi)
-	productServ = productsService.NewProductService(productRepo)
+	notificationRepo = notificationRepository.NewNotificationRepository(db)
+	notificationServ = notificationService.NewNotificationService(notificationRepo)
+	productServ = productsService.NewProductService(productRepo, notificationServ)
 	reviewServ = service.NewReviewService(reviewRepo, productServ)
 	reviewHand = handler.NewReviewHandler(reviewServ)
 }
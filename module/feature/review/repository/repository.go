@@ -95,3 +95,21 @@ func (r *ReviewRepository) SetIsReviewed(orderDetailsID, productID uint64) error
 
 	return nil
 }
+
+func (r *ReviewRepository) GetOrderDetailsByID(orderDetailsID uint64) (*entities.OrderDetailsModels, error) {
+	var details *entities.OrderDetailsModels
+	if err := r.db.Where("id = ?", orderDetailsID).First(&details).Error; err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+func (r *ReviewRepository) GetOrderByID(orderID string) (*entities.OrderModels, error) {
+	var orders *entities.OrderModels
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", orderID).First(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
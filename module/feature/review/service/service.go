@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math"
 	"ruti-store/module/entities"
+	order "ruti-store/module/feature/order/domain"
 	product "ruti-store/module/feature/product/domain"
 	"ruti-store/module/feature/review/domain"
 	"time"
@@ -74,6 +75,10 @@ func (s *ReviewService) CreateReview(userID uint64, req *domain.CreateReviewRequ
 		return nil, errors.New("rating should not exceed 5")
 	}
 
+	if err := s.checkReviewable(userID, products.ID, req.OrderDetailsID); err != nil {
+		return nil, err
+	}
+
 	value := &entities.ReviewModels{
 		UserID:         userID,
 		ProductID:      products.ID,
@@ -111,6 +116,31 @@ func (s *ReviewService) CreateReview(userID uint64, req *domain.CreateReviewRequ
 	return createdReview, nil
 }
 
+// checkReviewable makes sure the user bought the product in the order item, and that the item's
+// review window was opened when its order was completed.
+func (s *ReviewService) checkReviewable(userID, productID, orderDetailsID uint64) error {
+	details, err := s.repo.GetOrderDetailsByID(orderDetailsID)
+	if err != nil || details.ProductID != productID {
+		return domain.ErrOrderItemNotFound
+	}
+
+	orders, err := s.repo.GetOrderByID(details.OrderID)
+	if err != nil {
+		return domain.ErrOrderItemNotFound
+	}
+	if orders.UserID != userID {
+		return domain.ErrOrderItemNotOwned
+	}
+
+	if details.IsReviewed {
+		return domain.ErrAlreadyReviewed
+	}
+	if order.OrderStatus(orders.OrderStatus) != order.OrderStatusCompleted || details.ReviewableAt == nil || details.ReviewableAt.After(time.Now()) {
+		return domain.ErrReviewNotOpen
+	}
+	return nil
+}
+
 func (s *ReviewService) CreateReviewImages(req *domain.CreatePhotoReviewRequest) (*entities.ReviewPhotoModels, error) {
 	review, err := s.repo.GetReviewsById(req.ReviewID)
 	if err != nil {
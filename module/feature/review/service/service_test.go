@@ -0,0 +1,106 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	order "ruti-store/module/feature/order/domain"
+	productMocks "ruti-store/module/feature/product/mocks"
+	"ruti-store/module/feature/review/domain"
+	"ruti-store/module/feature/review/mocks"
+)
+
+func TestReviewService_CreateReview(t *testing.T) {
+	repo := mocks.NewReviewRepositoryInterface(t)
+	productService := productMocks.NewProductServiceInterface(t)
+	service := NewReviewService(repo, productService)
+
+	openedAt := time.Now().Add(-time.Hour)
+	products := &entities.ProductModels{ID: 5, Name: "Kemeja Linen"}
+	completed := &entities.OrderModels{ID: "order-1", UserID: 2, OrderStatus: string(order.OrderStatusCompleted)}
+	req := &domain.CreateReviewRequest{ProductID: 5, OrderDetailsID: 10, Rating: 5, Description: "Bahannya adem"}
+
+	t.Run("Failed Case - Item Of Another Product", func(t *testing.T) {
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-1", ProductID: 6}, nil).Once()
+
+		result, err := service.CreateReview(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrOrderItemNotFound))
+		repo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Failed Case - Order Of Another User", func(t *testing.T) {
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-1", ProductID: 5, ReviewableAt: &openedAt}, nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(completed, nil).Once()
+
+		result, err := service.CreateReview(3, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrOrderItemNotOwned))
+		repo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Failed Case - Order Not Completed", func(t *testing.T) {
+		shipped := &entities.OrderModels{ID: "order-2", UserID: 2, OrderStatus: string(order.OrderStatusShipped)}
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-2", ProductID: 5}, nil).Once()
+		repo.On("GetOrderByID", "order-2").Return(shipped, nil).Once()
+
+		result, err := service.CreateReview(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrReviewNotOpen))
+		repo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Failed Case - Review Window Not Opened", func(t *testing.T) {
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-1", ProductID: 5}, nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(completed, nil).Once()
+
+		result, err := service.CreateReview(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrReviewNotOpen))
+		repo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Failed Case - Already Reviewed", func(t *testing.T) {
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-1", ProductID: 5, ReviewableAt: &openedAt, IsReviewed: true}, nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(completed, nil).Once()
+
+		result, err := service.CreateReview(2, req)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrAlreadyReviewed))
+		repo.AssertNotCalled(t, "CreateReview", mock.Anything)
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		productService.On("GetProductByID", uint64(5)).Return(products, nil).Once()
+		repo.On("GetOrderDetailsByID", uint64(10)).Return(&entities.OrderDetailsModels{ID: 10, OrderID: "order-1", ProductID: 5, ReviewableAt: &openedAt}, nil).Once()
+		repo.On("GetOrderByID", "order-1").Return(completed, nil).Once()
+		repo.On("CreateReview", mock.MatchedBy(func(review *entities.ReviewModels) bool {
+			return review.UserID == 2 && review.ProductID == 5 && review.OrderDetailsID == 10
+		})).Return(&entities.ReviewModels{ID: 1, UserID: 2, ProductID: 5, OrderDetailsID: 10, Rating: 5}, nil).Once()
+		productService.On("UpdateTotalReview", uint64(5)).Return(nil).Once()
+		repo.On("CountAverageRating", uint64(5)).Return(4.5, nil).Once()
+		productService.On("UpdateProductRating", uint64(5), 4.5).Return(nil).Once()
+		repo.On("SetIsReviewed", uint64(10), uint64(5)).Return(nil).Once()
+
+		result, err := service.CreateReview(2, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), result.ID)
+		repo.AssertExpectations(t)
+		productService.AssertExpectations(t)
+	})
+}
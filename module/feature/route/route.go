@@ -2,13 +2,13 @@ package route
 
 import (
 	"github.com/gofiber/fiber/v2"
-	"github.com/midtrans/midtrans-go/coreapi"
-	"github.com/midtrans/midtrans-go/snap"
 	"gorm.io/gorm"
+	"ruti-store/config"
 	"ruti-store/module/feature/address"
 	"ruti-store/module/feature/article"
 	"ruti-store/module/feature/auth"
 	"ruti-store/module/feature/category"
+	"ruti-store/module/feature/flashsale"
 	"ruti-store/module/feature/home"
 	"ruti-store/module/feature/notification"
 	"ruti-store/module/feature/order"
@@ -16,17 +16,20 @@ import (
 	"ruti-store/module/feature/review"
 	users "ruti-store/module/feature/user"
 	user "ruti-store/module/feature/user/domain"
+	"ruti-store/module/feature/voucher"
+	"ruti-store/module/feature/wishlist"
+	"ruti-store/utils/payment"
 	"ruti-store/utils/token"
 )
 
 func SetupRoutes(app *fiber.App, db *gorm.DB, jwt token.JWTInterface,
-	snapClient snap.Client, userService user.UserServiceInterface, coreClient coreapi.Client) {
-	auth.InitializeAuth(db)
+	paymentGateway payment.PaymentGatewayInterface, userService user.UserServiceInterface, initConfig *config.Config) {
+	order.InitializeOrder(db, paymentGateway, initConfig)
+	order.SetupOrderRoutes(app, jwt, userService)
+	auth.InitializeAuth(db, order.OrderService())
 	auth.SetupRoutesAuth(app)
 	product.InitializeProduct(db)
 	product.SetupRoutesProduct(app, jwt, userService)
-	order.InitializeOrder(db, snapClient, coreClient)
-	order.SetupOrderRoutes(app, jwt, userService)
 	address.InitializeAddress(db)
 	address.SetupRoutesAddress(app, jwt, userService)
 	home.InitializeHome(db)
@@ -41,4 +44,10 @@ func SetupRoutes(app *fiber.App, db *gorm.DB, jwt token.JWTInterface,
 	article.SetupRoutesArticle(app, jwt, userService)
 	notification.InitializeNotification(db)
 	notification.SetupRoutesNotification(app, jwt, userService)
+	voucher.InitializeVoucher(db)
+	voucher.SetupRoutesVoucher(app, jwt, userService)
+	flashsale.InitializeFlashSale(db)
+	flashsale.SetupRoutesFlashSale(app, jwt, userService)
+	wishlist.InitializeWishlist(db, order.OrderService())
+	wishlist.SetupRoutesWishlist(app, jwt, userService)
 }
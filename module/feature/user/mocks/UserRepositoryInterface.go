@@ -0,0 +1,181 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/user/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserRepositoryInterface is an autogenerated mock type for the UserRepositoryInterface type
+type UserRepositoryInterface struct {
+	mock.Mock
+}
+
+// ChatBotAI provides a mock function with given fields: req
+func (_m *UserRepositoryInterface) ChatBotAI(req *domain.CreateChatBotRequest) (string, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChatBotAI")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateChatBotRequest) (string, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateChatBotRequest) string); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateChatBotRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUser provides a mock function with given fields: userID
+func (_m *UserRepositoryInterface) DeleteUser(userID uint64) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditProfile provides a mock function with given fields: userID, req
+func (_m *UserRepositoryInterface) EditProfile(userID uint64, req *entities.UserModels) error {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *entities.UserModels) error); ok {
+		r0 = rf(userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPaginatedUsers provides a mock function with given fields: page, pageSize
+func (_m *UserRepositoryInterface) GetPaginatedUsers(page int, pageSize int) ([]*entities.UserModels, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedUsers")
+	}
+
+	var r0 []*entities.UserModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.UserModels, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.UserModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalUserItems provides a mock function with no fields
+func (_m *UserRepositoryInterface) GetTotalUserItems() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalUserItems")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByID provides a mock function with given fields: userID
+func (_m *UserRepositoryInterface) GetUserByID(userID uint64) (*entities.UserModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *entities.UserModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.UserModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.UserModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserRepositoryInterface creates a new instance of UserRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserRepositoryInterface {
+	mock := &UserRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,209 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/user/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserServiceInterface is an autogenerated mock type for the UserServiceInterface type
+type UserServiceInterface struct {
+	mock.Mock
+}
+
+// ChatBot provides a mock function with given fields: req
+func (_m *UserServiceInterface) ChatBot(req *domain.CreateChatBotRequest) (string, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChatBot")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateChatBotRequest) (string, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateChatBotRequest) string); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateChatBotRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUser provides a mock function with given fields: userID
+func (_m *UserServiceInterface) DeleteUser(userID uint64) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditProfile provides a mock function with given fields: userID, req
+func (_m *UserServiceInterface) EditProfile(userID uint64, req *domain.EditProfileRequest) error {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.EditProfileRequest) error); ok {
+		r0 = rf(userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllUserItems provides a mock function with given fields: page, pageSize
+func (_m *UserServiceInterface) GetAllUserItems(page int, pageSize int) ([]*entities.UserModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllUserItems")
+	}
+
+	var r0 []*entities.UserModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.UserModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.UserModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUserByID provides a mock function with given fields: userID
+func (_m *UserServiceInterface) GetUserByID(userID uint64) (*entities.UserModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *entities.UserModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.UserModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.UserModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserPage provides a mock function with given fields: currentPage, pageSize
+func (_m *UserServiceInterface) GetUserPage(currentPage int, pageSize int) (int, int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 int
+	var r4 error
+	if rf, ok := ret.Get(0).(func(int, int) (int, int, int, int, error)); ok {
+		return rf(currentPage, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) int); ok {
+		r0 = rf(currentPage, pageSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(currentPage, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) int); ok {
+		r2 = rf(currentPage, pageSize)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int) int); ok {
+		r3 = rf(currentPage, pageSize)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	if rf, ok := ret.Get(4).(func(int, int) error); ok {
+		r4 = rf(currentPage, pageSize)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// NewUserServiceInterface creates a new instance of UserServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserServiceInterface {
+	mock := &UserServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	"ruti-store/module/entities"
+	"time"
+)
+
+const (
+	DiscountTypePercentage = "percentage"
+	DiscountTypeFixed      = "fixed"
+)
+
+const (
+	UsageStatusUsed     = "used"
+	UsageStatusReleased = "released"
+)
+
+// DiscountItem is an order line a voucher may discount.
+type DiscountItem struct {
+	ProductID   uint64
+	CategoryIDs []uint64
+	Subtotal    uint64
+}
+
+// CheckUsage returns an error wrapping ErrInvalidVoucher when the voucher has been used up,
+// globally or by a user who already used it userUsage times.
+func CheckUsage(voucher *entities.VoucherModels, userUsage uint64) error {
+	if voucher.UsageLimit > 0 && voucher.UsedCount >= voucher.UsageLimit {
+		return fmt.Errorf("%w: voucher %s has been fully used", ErrInvalidVoucher, voucher.Code)
+	}
+	if voucher.UsageLimitPerUser > 0 && userUsage >= voucher.UsageLimitPerUser {
+		return fmt.Errorf("%w: you have already used voucher %s", ErrInvalidVoucher, voucher.Code)
+	}
+	return nil
+}
+
+// CalculateDiscount returns the discount the voucher gives on items at time now. Only items of
+// the voucher's products or categories are discounted when the voucher is scoped to any, while
+// the minimum spend applies to all items.
+func CalculateDiscount(voucher *entities.VoucherModels, items []DiscountItem, now time.Time) (uint64, error) {
+	if !voucher.IsActive || voucher.DeletedAt != nil {
+		return 0, fmt.Errorf("%w: voucher %s is not active", ErrInvalidVoucher, voucher.Code)
+	}
+	if now.Before(voucher.StartAt) {
+		return 0, fmt.Errorf("%w: voucher %s is not valid yet", ErrInvalidVoucher, voucher.Code)
+	}
+	if now.After(voucher.EndAt) {
+		return 0, fmt.Errorf("%w: voucher %s has expired", ErrInvalidVoucher, voucher.Code)
+	}
+
+	var subtotal, eligible uint64
+	for _, item := range items {
+		subtotal += item.Subtotal
+		if appliesTo(voucher, item) {
+			eligible += item.Subtotal
+		}
+	}
+	if subtotal < voucher.MinSpend {
+		return 0, fmt.Errorf("%w: voucher %s needs a minimum spend of %d", ErrInvalidVoucher, voucher.Code, voucher.MinSpend)
+	}
+	if eligible == 0 {
+		return 0, fmt.Errorf("%w: voucher %s doesn't apply to these products", ErrInvalidVoucher, voucher.Code)
+	}
+
+	var discount uint64
+	switch voucher.DiscountType {
+	case DiscountTypePercentage:
+		discount = eligible * voucher.DiscountValue / 100
+		if voucher.MaxDiscount > 0 && discount > voucher.MaxDiscount {
+			discount = voucher.MaxDiscount
+		}
+	case DiscountTypeFixed:
+		discount = voucher.DiscountValue
+	default:
+		return 0, fmt.Errorf("%w: voucher %s has an unknown discount type", ErrInvalidVoucher, voucher.Code)
+	}
+
+	if discount > eligible {
+		discount = eligible
+	}
+	return discount, nil
+}
+
+// AllocateDiscount spreads a discount the voucher gave on items over the items it applies to, by
+// their subtotals, and returns the share of each item. Items outside the voucher's products and
+// categories get no share.
+func AllocateDiscount(voucher *entities.VoucherModels, items []DiscountItem, discount uint64) []uint64 {
+	shares := make([]uint64, len(items))
+
+	var eligible uint64
+	last := -1
+	for i, item := range items {
+		if appliesTo(voucher, item) {
+			eligible += item.Subtotal
+			last = i
+		}
+	}
+	if eligible == 0 {
+		return shares
+	}
+
+	// The last eligible item takes what rounding left over, so the shares add up to the discount.
+	var allocated uint64
+	for i, item := range items {
+		if i == last || !appliesTo(voucher, item) {
+			continue
+		}
+		shares[i] = discount * item.Subtotal / eligible
+		allocated += shares[i]
+	}
+	shares[last] = discount - allocated
+	return shares
+}
+
+func appliesTo(voucher *entities.VoucherModels, item DiscountItem) bool {
+	if len(voucher.Products) == 0 && len(voucher.Categories) == 0 {
+		return true
+	}
+	for _, product := range voucher.Products {
+		if product.ID == item.ProductID {
+			return true
+		}
+	}
+	for _, category := range voucher.Categories {
+		for _, categoryID := range item.CategoryIDs {
+			if category.ID == categoryID {
+				return true
+			}
+		}
+	}
+	return false
+}
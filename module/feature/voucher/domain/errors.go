@@ -0,0 +1,8 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidVoucher  = errors.New("voucher can't be used")
+	ErrVoucherNotFound = errors.New("voucher not found")
+)
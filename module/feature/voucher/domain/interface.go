@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	"time"
+)
+
+type VoucherRepositoryInterface interface {
+	GetPaginatedVouchers(page, pageSize int) ([]*entities.VoucherModels, int64, error)
+	GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error)
+	GetVoucherByCode(code string) (*entities.VoucherModels, error)
+	GetActiveVouchers(now time.Time) ([]*entities.VoucherModels, error)
+	CreateVoucher(voucher *entities.VoucherModels, categoryIDs, productIDs []uint64) (*entities.VoucherModels, error)
+	UpdateVoucher(voucher *entities.VoucherModels, categoryIDs, productIDs []uint64) error
+	DeleteVoucher(voucherID uint64) error
+	LockVoucherByCode(code string) (*entities.VoucherModels, error)
+	CountUserUsage(voucherID, userID uint64) (uint64, error)
+	RedeemVoucher(usage *entities.VoucherUsageModels) error
+	ReleaseVoucher(orderID string) error
+}
+
+type VoucherServiceInterface interface {
+	GetAllVouchers(page, pageSize int) ([]*entities.VoucherModels, int64, error)
+	GetVoucherPage(currentPage, pageSize, totalItems int) (int, int, int, error)
+	GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error)
+	GetActiveVouchers() ([]*entities.VoucherModels, error)
+	CreateVoucher(req *CreateVoucherRequest) (*entities.VoucherModels, error)
+	UpdateVoucher(voucherID uint64, req *UpdateVoucherRequest) (*entities.VoucherModels, error)
+	DeleteVoucher(voucherID uint64) error
+}
+
+type VoucherHandlerInterface interface {
+	GetAllVouchers(c *fiber.Ctx) error
+	GetVoucherByID(c *fiber.Ctx) error
+	GetActiveVouchers(c *fiber.Ctx) error
+	CreateVoucher(c *fiber.Ctx) error
+	UpdateVoucher(c *fiber.Ctx) error
+	DeleteVoucher(c *fiber.Ctx) error
+}
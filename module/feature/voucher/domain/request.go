@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type CreateVoucherRequest struct {
+	Code              string    `json:"code" validate:"required"`
+	Name              string    `json:"name" validate:"required"`
+	Description       string    `json:"description"`
+	DiscountType      string    `json:"discount_type" validate:"required,oneof=percentage fixed"`
+	DiscountValue     uint64    `json:"discount_value" validate:"required"`
+	MinSpend          uint64    `json:"min_spend"`
+	MaxDiscount       uint64    `json:"max_discount"`
+	UsageLimit        uint64    `json:"usage_limit"`
+	UsageLimitPerUser uint64    `json:"usage_limit_per_user"`
+	IsActive          bool      `json:"is_active"`
+	StartAt           time.Time `json:"start_at" validate:"required"`
+	EndAt             time.Time `json:"end_at" validate:"required,gtfield=StartAt"`
+	CategoryIDs       []uint64  `json:"category_ids"`
+	ProductIDs        []uint64  `json:"product_ids"`
+}
+
+type UpdateVoucherRequest struct {
+	Name              string    `json:"name" validate:"required"`
+	Description       string    `json:"description"`
+	DiscountType      string    `json:"discount_type" validate:"required,oneof=percentage fixed"`
+	DiscountValue     uint64    `json:"discount_value" validate:"required"`
+	MinSpend          uint64    `json:"min_spend"`
+	MaxDiscount       uint64    `json:"max_discount"`
+	UsageLimit        uint64    `json:"usage_limit"`
+	UsageLimitPerUser uint64    `json:"usage_limit_per_user"`
+	IsActive          bool      `json:"is_active"`
+	StartAt           time.Time `json:"start_at" validate:"required"`
+	EndAt             time.Time `json:"end_at" validate:"required,gtfield=StartAt"`
+	CategoryIDs       []uint64  `json:"category_ids"`
+	ProductIDs        []uint64  `json:"product_ids"`
+}
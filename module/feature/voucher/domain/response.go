@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"ruti-store/module/entities"
+	"time"
+)
+
+type VoucherResponse struct {
+	ID                uint64                    `json:"id"`
+	Code              string                    `json:"code"`
+	Name              string                    `json:"name"`
+	Description       string                    `json:"description"`
+	DiscountType      string                    `json:"discount_type"`
+	DiscountValue     uint64                    `json:"discount_value"`
+	MinSpend          uint64                    `json:"min_spend"`
+	MaxDiscount       uint64                    `json:"max_discount"`
+	UsageLimit        uint64                    `json:"usage_limit"`
+	UsageLimitPerUser uint64                    `json:"usage_limit_per_user"`
+	UsedCount         uint64                    `json:"used_count"`
+	IsActive          bool                      `json:"is_active"`
+	StartAt           time.Time                 `json:"start_at"`
+	EndAt             time.Time                 `json:"end_at"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	Categories        []VoucherCategoryResponse `json:"categories"`
+	Products          []VoucherProductResponse  `json:"products"`
+}
+
+type VoucherCategoryResponse struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+type VoucherProductResponse struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+func VoucherFormatter(voucher *entities.VoucherModels) *VoucherResponse {
+	voucherResponse := &VoucherResponse{
+		ID:                voucher.ID,
+		Code:              voucher.Code,
+		Name:              voucher.Name,
+		Description:       voucher.Description,
+		DiscountType:      voucher.DiscountType,
+		DiscountValue:     voucher.DiscountValue,
+		MinSpend:          voucher.MinSpend,
+		MaxDiscount:       voucher.MaxDiscount,
+		UsageLimit:        voucher.UsageLimit,
+		UsageLimitPerUser: voucher.UsageLimitPerUser,
+		UsedCount:         voucher.UsedCount,
+		IsActive:          voucher.IsActive,
+		StartAt:           voucher.StartAt,
+		EndAt:             voucher.EndAt,
+		CreatedAt:         voucher.CreatedAt,
+		Categories:        make([]VoucherCategoryResponse, 0),
+		Products:          make([]VoucherProductResponse, 0),
+	}
+
+	for _, category := range voucher.Categories {
+		voucherResponse.Categories = append(voucherResponse.Categories, VoucherCategoryResponse{
+			ID:   category.ID,
+			Name: category.Name,
+		})
+	}
+
+	for _, product := range voucher.Products {
+		voucherResponse.Products = append(voucherResponse.Products, VoucherProductResponse{
+			ID:   product.ID,
+			Name: product.Name,
+		})
+	}
+
+	return voucherResponse
+}
+
+func ResponseArrayVouchers(data []*entities.VoucherModels) []*VoucherResponse {
+	res := make([]*VoucherResponse, 0)
+
+	for _, voucher := range data {
+		res = append(res, VoucherFormatter(voucher))
+	}
+
+	return res
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/voucher/domain"
+	"ruti-store/utils/response"
+	"ruti-store/utils/validator"
+	"strconv"
+)
+
+type VoucherHandler struct {
+	service domain.VoucherServiceInterface
+}
+
+func NewVoucherHandler(service domain.VoucherServiceInterface) domain.VoucherHandlerInterface {
+	return &VoucherHandler{
+		service: service,
+	}
+}
+
+func (h *VoucherHandler) GetAllVouchers(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	currentPage, err := strconv.Atoi(c.Query("page"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page number")
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("page_size"))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid page size")
+	}
+
+	result, totalItems, err := h.service.GetAllVouchers(currentPage, pageSize)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	totalPages, nextPage, prevPage, err := h.service.GetVoucherPage(currentPage, pageSize, int(totalItems))
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Failed to get page info: "+err.Error())
+	}
+
+	return response.PaginationBuildResponse(c, fiber.StatusOK, "Success get pagination",
+		domain.ResponseArrayVouchers(result), currentPage, int(totalItems), totalPages, nextPage, prevPage)
+}
+
+func (h *VoucherHandler) GetVoucherByID(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	voucherID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	result, err := h.service.GetVoucherByID(voucherID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get voucher", domain.VoucherFormatter(result))
+}
+
+func (h *VoucherHandler) GetActiveVouchers(c *fiber.Ctx) error {
+	result, err := h.service.GetActiveVouchers()
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get active vouchers", domain.ResponseArrayVouchers(result))
+}
+
+func (h *VoucherHandler) CreateVoucher(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	req := new(domain.CreateVoucherRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateVoucher(req)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create voucher", domain.VoucherFormatter(result))
+}
+
+func (h *VoucherHandler) UpdateVoucher(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	voucherID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.UpdateVoucherRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.UpdateVoucher(voucherID, req)
+	if errors.Is(err, domain.ErrVoucherNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success update voucher", domain.VoucherFormatter(result))
+}
+
+func (h *VoucherHandler) DeleteVoucher(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "admin" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only admin users can access this resource.")
+	}
+
+	voucherID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	if err := h.service.DeleteVoucher(voucherID); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success delete voucher")
+}
@@ -0,0 +1,317 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// VoucherRepositoryInterface is an autogenerated mock type for the VoucherRepositoryInterface type
+type VoucherRepositoryInterface struct {
+	mock.Mock
+}
+
+// CountUserUsage provides a mock function with given fields: voucherID, userID
+func (_m *VoucherRepositoryInterface) CountUserUsage(voucherID uint64, userID uint64) (uint64, error) {
+	ret := _m.Called(voucherID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUserUsage")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) (uint64, error)); ok {
+		return rf(voucherID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64) uint64); ok {
+		r0 = rf(voucherID, userID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(voucherID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVoucher provides a mock function with given fields: voucher, categoryIDs, productIDs
+func (_m *VoucherRepositoryInterface) CreateVoucher(voucher *entities.VoucherModels, categoryIDs []uint64, productIDs []uint64) (*entities.VoucherModels, error) {
+	ret := _m.Called(voucher, categoryIDs, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVoucher")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.VoucherModels, []uint64, []uint64) (*entities.VoucherModels, error)); ok {
+		return rf(voucher, categoryIDs, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.VoucherModels, []uint64, []uint64) *entities.VoucherModels); ok {
+		r0 = rf(voucher, categoryIDs, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.VoucherModels, []uint64, []uint64) error); ok {
+		r1 = rf(voucher, categoryIDs, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVoucher provides a mock function with given fields: voucherID
+func (_m *VoucherRepositoryInterface) DeleteVoucher(voucherID uint64) error {
+	ret := _m.Called(voucherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVoucher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(voucherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveVouchers provides a mock function with given fields: now
+func (_m *VoucherRepositoryInterface) GetActiveVouchers(now time.Time) ([]*entities.VoucherModels, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveVouchers")
+	}
+
+	var r0 []*entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*entities.VoucherModels, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*entities.VoucherModels); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaginatedVouchers provides a mock function with given fields: page, pageSize
+func (_m *VoucherRepositoryInterface) GetPaginatedVouchers(page int, pageSize int) ([]*entities.VoucherModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaginatedVouchers")
+	}
+
+	var r0 []*entities.VoucherModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.VoucherModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.VoucherModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetVoucherByCode provides a mock function with given fields: code
+func (_m *VoucherRepositoryInterface) GetVoucherByCode(code string) (*entities.VoucherModels, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoucherByCode")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.VoucherModels, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.VoucherModels); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherByID provides a mock function with given fields: voucherID
+func (_m *VoucherRepositoryInterface) GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error) {
+	ret := _m.Called(voucherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoucherByID")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.VoucherModels, error)); ok {
+		return rf(voucherID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.VoucherModels); ok {
+		r0 = rf(voucherID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(voucherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockVoucherByCode provides a mock function with given fields: code
+func (_m *VoucherRepositoryInterface) LockVoucherByCode(code string) (*entities.VoucherModels, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockVoucherByCode")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.VoucherModels, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.VoucherModels); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedeemVoucher provides a mock function with given fields: usage
+func (_m *VoucherRepositoryInterface) RedeemVoucher(usage *entities.VoucherUsageModels) error {
+	ret := _m.Called(usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemVoucher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.VoucherUsageModels) error); ok {
+		r0 = rf(usage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseVoucher provides a mock function with given fields: orderID
+func (_m *VoucherRepositoryInterface) ReleaseVoucher(orderID string) error {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseVoucher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVoucher provides a mock function with given fields: voucher, categoryIDs, productIDs
+func (_m *VoucherRepositoryInterface) UpdateVoucher(voucher *entities.VoucherModels, categoryIDs []uint64, productIDs []uint64) error {
+	ret := _m.Called(voucher, categoryIDs, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVoucher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.VoucherModels, []uint64, []uint64) error); ok {
+		r0 = rf(voucher, categoryIDs, productIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewVoucherRepositoryInterface creates a new instance of VoucherRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoucherRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoucherRepositoryInterface {
+	mock := &VoucherRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,246 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/voucher/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VoucherServiceInterface is an autogenerated mock type for the VoucherServiceInterface type
+type VoucherServiceInterface struct {
+	mock.Mock
+}
+
+// CreateVoucher provides a mock function with given fields: req
+func (_m *VoucherServiceInterface) CreateVoucher(req *domain.CreateVoucherRequest) (*entities.VoucherModels, error) {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVoucher")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.CreateVoucherRequest) (*entities.VoucherModels, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.CreateVoucherRequest) *entities.VoucherModels); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.CreateVoucherRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVoucher provides a mock function with given fields: voucherID
+func (_m *VoucherServiceInterface) DeleteVoucher(voucherID uint64) error {
+	ret := _m.Called(voucherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVoucher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(voucherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveVouchers provides a mock function with no fields
+func (_m *VoucherServiceInterface) GetActiveVouchers() ([]*entities.VoucherModels, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveVouchers")
+	}
+
+	var r0 []*entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*entities.VoucherModels, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*entities.VoucherModels); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllVouchers provides a mock function with given fields: page, pageSize
+func (_m *VoucherServiceInterface) GetAllVouchers(page int, pageSize int) ([]*entities.VoucherModels, int64, error) {
+	ret := _m.Called(page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllVouchers")
+	}
+
+	var r0 []*entities.VoucherModels
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.VoucherModels, int64, error)); ok {
+		return rf(page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.VoucherModels); ok {
+		r0 = rf(page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) int64); ok {
+		r1 = rf(page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetVoucherByID provides a mock function with given fields: voucherID
+func (_m *VoucherServiceInterface) GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error) {
+	ret := _m.Called(voucherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoucherByID")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.VoucherModels, error)); ok {
+		return rf(voucherID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.VoucherModels); ok {
+		r0 = rf(voucherID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(voucherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherPage provides a mock function with given fields: currentPage, pageSize, totalItems
+func (_m *VoucherServiceInterface) GetVoucherPage(currentPage int, pageSize int, totalItems int) (int, int, int, error) {
+	ret := _m.Called(currentPage, pageSize, totalItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoucherPage")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(int, int, int) (int, int, int, error)); ok {
+		return rf(currentPage, pageSize, totalItems)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, int) int); ok {
+		r0 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, int) int); ok {
+		r1 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, int, int) int); ok {
+		r2 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(int, int, int) error); ok {
+		r3 = rf(currentPage, pageSize, totalItems)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// UpdateVoucher provides a mock function with given fields: voucherID, req
+func (_m *VoucherServiceInterface) UpdateVoucher(voucherID uint64, req *domain.UpdateVoucherRequest) (*entities.VoucherModels, error) {
+	ret := _m.Called(voucherID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateVoucher")
+	}
+
+	var r0 *entities.VoucherModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateVoucherRequest) (*entities.VoucherModels, error)); ok {
+		return rf(voucherID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.UpdateVoucherRequest) *entities.VoucherModels); ok {
+		r0 = rf(voucherID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VoucherModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.UpdateVoucherRequest) error); ok {
+		r1 = rf(voucherID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewVoucherServiceInterface creates a new instance of VoucherServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoucherServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoucherServiceInterface {
+	mock := &VoucherServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
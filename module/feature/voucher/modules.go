@@ -0,0 +1,35 @@
+package voucher
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"ruti-store/module/feature/middleware"
+	user "ruti-store/module/feature/user/domain"
+	"ruti-store/module/feature/voucher/domain"
+	"ruti-store/module/feature/voucher/handler"
+	"ruti-store/module/feature/voucher/repository"
+	"ruti-store/module/feature/voucher/service"
+	"ruti-store/utils/token"
+)
+
+var (
+	repo domain.VoucherRepositoryInterface
+	serv domain.VoucherServiceInterface
+	hand domain.VoucherHandlerInterface
+)
+
+func InitializeVoucher(db *gorm.DB) {
+	repo = repository.NewVoucherRepository(db)
+	serv = service.NewVoucherService(repo)
+	hand = handler.NewVoucherHandler(serv)
+}
+
+func SetupRoutesVoucher(app *fiber.App, jwt token.JWTInterface, userService user.UserServiceInterface) {
+	api := app.Group("/api/v1/voucher")
+	api.Get("/active", hand.GetActiveVouchers)
+	api.Get("/list", middleware.AuthMiddleware(jwt, userService), hand.GetAllVouchers)
+	api.Get("/details/:id", middleware.AuthMiddleware(jwt, userService), hand.GetVoucherByID)
+	api.Post("/create", middleware.AuthMiddleware(jwt, userService), hand.CreateVoucher)
+	api.Put("/update/:id", middleware.AuthMiddleware(jwt, userService), hand.UpdateVoucher)
+	api.Delete("/delete/:id", middleware.AuthMiddleware(jwt, userService), hand.DeleteVoucher)
+}
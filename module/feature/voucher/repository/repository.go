@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/voucher/domain"
+	"strings"
+	"time"
+)
+
+type VoucherRepository struct {
+	db *gorm.DB
+}
+
+func NewVoucherRepository(db *gorm.DB) domain.VoucherRepositoryInterface {
+	return &VoucherRepository{
+		db: db,
+	}
+}
+
+func (r *VoucherRepository) GetPaginatedVouchers(page, pageSize int) ([]*entities.VoucherModels, int64, error) {
+	var vouchers []*entities.VoucherModels
+	var totalItems int64
+
+	offset := (page - 1) * pageSize
+
+	query := r.db.Model(&entities.VoucherModels{}).Where("deleted_at IS NULL")
+	if err := query.Count(&totalItems).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Preload("Categories").Preload("Products").
+		Find(&vouchers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return vouchers, totalItems, nil
+}
+
+func (r *VoucherRepository) GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error) {
+	var voucher *entities.VoucherModels
+
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", voucherID).
+		Preload("Categories").Preload("Products").
+		First(&voucher).Error; err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+func (r *VoucherRepository) GetVoucherByCode(code string) (*entities.VoucherModels, error) {
+	var voucher *entities.VoucherModels
+
+	if err := r.db.Where("code = ? AND deleted_at IS NULL", strings.ToUpper(code)).
+		Preload("Categories").Preload("Products").
+		First(&voucher).Error; err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+func (r *VoucherRepository) GetActiveVouchers(now time.Time) ([]*entities.VoucherModels, error) {
+	var vouchers []*entities.VoucherModels
+
+	if err := r.db.Where("deleted_at IS NULL AND is_active = ? AND start_at <= ? AND end_at >= ?", true, now, now).
+		Where("usage_limit = 0 OR used_count < usage_limit").
+		Order("end_at ASC").
+		Preload("Categories").Preload("Products").
+		Find(&vouchers).Error; err != nil {
+		return nil, err
+	}
+	return vouchers, nil
+}
+
+func (r *VoucherRepository) CreateVoucher(voucher *entities.VoucherModels, categoryIDs, productIDs []uint64) (*entities.VoucherModels, error) {
+	voucher.Categories = categoryRefs(categoryIDs)
+	voucher.Products = productRefs(productIDs)
+
+	if err := r.db.Omit("Categories.*", "Products.*").Create(voucher).Error; err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+func (r *VoucherRepository) UpdateVoucher(voucher *entities.VoucherModels, categoryIDs, productIDs []uint64) error {
+	if err := r.db.Model(voucher).Select("*").
+		Omit(clause.Associations, "id", "code", "used_count", "created_at", "deleted_at").
+		Updates(voucher).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(voucher).Omit("Categories.*").Association("Categories").Replace(categoryRefs(categoryIDs)); err != nil {
+		return err
+	}
+	if err := r.db.Model(voucher).Omit("Products.*").Association("Products").Replace(productRefs(productIDs)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *VoucherRepository) DeleteVoucher(voucherID uint64) error {
+	voucher := &entities.VoucherModels{}
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", voucherID).First(voucher).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(voucher).Update("deleted_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *VoucherRepository) LockVoucherByCode(code string) (*entities.VoucherModels, error) {
+	var voucher entities.VoucherModels
+
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ? AND deleted_at IS NULL", strings.ToUpper(code)).
+		First(&voucher).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&voucher).Association("Categories").Find(&voucher.Categories); err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&voucher).Association("Products").Find(&voucher.Products); err != nil {
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+func (r *VoucherRepository) CountUserUsage(voucherID, userID uint64) (uint64, error) {
+	var count int64
+
+	if err := r.db.Model(&entities.VoucherUsageModels{}).
+		Where("voucher_id = ? AND user_id = ? AND status = ?", voucherID, userID, domain.UsageStatusUsed).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return uint64(count), nil
+}
+
+// RedeemVoucher records the usage and counts it on the voucher. The voucher row is expected to
+// be locked by LockVoucherByCode in the same transaction.
+func (r *VoucherRepository) RedeemVoucher(usage *entities.VoucherUsageModels) error {
+	if err := r.db.Create(usage).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&entities.VoucherModels{}).
+		Where("id = ?", usage.VoucherID).
+		Update("used_count", gorm.Expr("used_count + 1")).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReleaseVoucher gives back the voucher used by an order that didn't go through. Orders without
+// a voucher, or whose voucher was already released, are left alone.
+func (r *VoucherRepository) ReleaseVoucher(orderID string) error {
+	var usage entities.VoucherUsageModels
+
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("order_id = ? AND status = ?", orderID, domain.UsageStatusUsed).
+		First(&usage).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&usage).Updates(map[string]interface{}{
+		"status":      domain.UsageStatusReleased,
+		"released_at": time.Now(),
+	}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.Model(&entities.VoucherModels{}).
+		Where("id = ? AND used_count > 0", usage.VoucherID).
+		Update("used_count", gorm.Expr("used_count - 1")).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func categoryRefs(categoryIDs []uint64) []*entities.CategoryModels {
+	categories := make([]*entities.CategoryModels, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		categories[i] = &entities.CategoryModels{ID: categoryID}
+	}
+	return categories
+}
+
+func productRefs(productIDs []uint64) []*entities.ProductModels {
+	products := make([]*entities.ProductModels, len(productIDs))
+	for i, productID := range productIDs {
+		products[i] = &entities.ProductModels{ID: productID}
+	}
+	return products
+}
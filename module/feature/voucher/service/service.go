@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"math"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/voucher/domain"
+	"strings"
+	"time"
+)
+
+type VoucherService struct {
+	repo domain.VoucherRepositoryInterface
+}
+
+func NewVoucherService(repo domain.VoucherRepositoryInterface) domain.VoucherServiceInterface {
+	return &VoucherService{
+		repo: repo,
+	}
+}
+
+func (s *VoucherService) GetAllVouchers(page, pageSize int) ([]*entities.VoucherModels, int64, error) {
+	result, totalItems, err := s.repo.GetPaginatedVouchers(page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, totalItems, nil
+}
+
+func (s *VoucherService) GetVoucherPage(currentPage, pageSize, totalItems int) (int, int, int, error) {
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+	nextPage := currentPage + 1
+	prevPage := currentPage - 1
+
+	if nextPage > totalPages {
+		nextPage = 0
+	}
+
+	if prevPage < 1 {
+		prevPage = 0
+	}
+
+	return totalPages, nextPage, prevPage, nil
+}
+
+func (s *VoucherService) GetVoucherByID(voucherID uint64) (*entities.VoucherModels, error) {
+	result, err := s.repo.GetVoucherByID(voucherID)
+	if err != nil {
+		return nil, domain.ErrVoucherNotFound
+	}
+	return result, nil
+}
+
+func (s *VoucherService) GetActiveVouchers() ([]*entities.VoucherModels, error) {
+	result, err := s.repo.GetActiveVouchers(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *VoucherService) CreateVoucher(req *domain.CreateVoucherRequest) (*entities.VoucherModels, error) {
+	if err := validateDiscount(req.DiscountType, req.DiscountValue); err != nil {
+		return nil, err
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	existing, err := s.repo.GetVoucherByCode(code)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("voucher code already exists")
+	}
+
+	newData := &entities.VoucherModels{
+		Code:              code,
+		Name:              req.Name,
+		Description:       req.Description,
+		DiscountType:      req.DiscountType,
+		DiscountValue:     req.DiscountValue,
+		MinSpend:          req.MinSpend,
+		MaxDiscount:       req.MaxDiscount,
+		UsageLimit:        req.UsageLimit,
+		UsageLimitPerUser: req.UsageLimitPerUser,
+		IsActive:          req.IsActive,
+		StartAt:           req.StartAt,
+		EndAt:             req.EndAt,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+
+	result, err := s.repo.CreateVoucher(newData, req.CategoryIDs, req.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetVoucherByID(result.ID)
+}
+
+func (s *VoucherService) UpdateVoucher(voucherID uint64, req *domain.UpdateVoucherRequest) (*entities.VoucherModels, error) {
+	if err := validateDiscount(req.DiscountType, req.DiscountValue); err != nil {
+		return nil, err
+	}
+
+	voucher, err := s.repo.GetVoucherByID(voucherID)
+	if err != nil {
+		return nil, domain.ErrVoucherNotFound
+	}
+
+	voucher.Name = req.Name
+	voucher.Description = req.Description
+	voucher.DiscountType = req.DiscountType
+	voucher.DiscountValue = req.DiscountValue
+	voucher.MinSpend = req.MinSpend
+	voucher.MaxDiscount = req.MaxDiscount
+	voucher.UsageLimit = req.UsageLimit
+	voucher.UsageLimitPerUser = req.UsageLimitPerUser
+	voucher.IsActive = req.IsActive
+	voucher.StartAt = req.StartAt
+	voucher.EndAt = req.EndAt
+	voucher.UpdatedAt = time.Now()
+
+	if err := s.repo.UpdateVoucher(voucher, req.CategoryIDs, req.ProductIDs); err != nil {
+		return nil, err
+	}
+	return s.repo.GetVoucherByID(voucherID)
+}
+
+func (s *VoucherService) DeleteVoucher(voucherID uint64) error {
+	if err := s.repo.DeleteVoucher(voucherID); err != nil {
+		return domain.ErrVoucherNotFound
+	}
+	return nil
+}
+
+func validateDiscount(discountType string, discountValue uint64) error {
+	if discountType == domain.DiscountTypePercentage && discountValue > 100 {
+		return errors.New("percentage discount can't exceed 100")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/voucher/domain"
+	"ruti-store/module/feature/voucher/mocks"
+)
+
+func TestVoucherService_CreateVoucher(t *testing.T) {
+	repo := mocks.NewVoucherRepositoryInterface(t)
+	service := NewVoucherService(repo)
+
+	req := &domain.CreateVoucherRequest{
+		Code:          " hemat10 ",
+		Name:          "Hemat 10%",
+		DiscountType:  domain.DiscountTypePercentage,
+		DiscountValue: 10,
+		IsActive:      true,
+		StartAt:       time.Now(),
+		EndAt:         time.Now().Add(24 * time.Hour),
+	}
+
+	t.Run("Failed Case - Percentage Above 100", func(t *testing.T) {
+		invalid := *req
+		invalid.DiscountValue = 110
+
+		result, err := service.CreateVoucher(&invalid)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		repo.AssertNotCalled(t, "CreateVoucher", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed Case - Code Already Exists", func(t *testing.T) {
+		repo.On("GetVoucherByCode", "HEMAT10").Return(&entities.VoucherModels{ID: 1, Code: "HEMAT10"}, nil).Once()
+
+		result, err := service.CreateVoucher(req)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "voucher code already exists")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		created := &entities.VoucherModels{ID: 2, Code: "HEMAT10"}
+		repo.On("GetVoucherByCode", "HEMAT10").Return(nil, gorm.ErrRecordNotFound).Once()
+		repo.On("CreateVoucher", mock.MatchedBy(func(v *entities.VoucherModels) bool {
+			return v.Code == "HEMAT10" && v.DiscountValue == 10
+		}), []uint64(nil), []uint64(nil)).Return(created, nil).Once()
+		repo.On("GetVoucherByID", uint64(2)).Return(created, nil).Once()
+
+		result, err := service.CreateVoucher(req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, created, result)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestCalculateDiscount(t *testing.T) {
+	now := time.Now()
+	voucher := &entities.VoucherModels{
+		Code:          "HEMAT10",
+		DiscountType:  domain.DiscountTypePercentage,
+		DiscountValue: 10,
+		MinSpend:      100000,
+		MaxDiscount:   15000,
+		IsActive:      true,
+		StartAt:       now.Add(-time.Hour),
+		EndAt:         now.Add(time.Hour),
+	}
+	items := []domain.DiscountItem{
+		{ProductID: 1, CategoryIDs: []uint64{7}, Subtotal: 80000},
+		{ProductID: 2, CategoryIDs: []uint64{8}, Subtotal: 40000},
+	}
+
+	t.Run("Percentage Of All Items", func(t *testing.T) {
+		discount, err := domain.CalculateDiscount(voucher, items, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(12000), discount)
+	})
+
+	t.Run("Capped At Max Discount", func(t *testing.T) {
+		big := []domain.DiscountItem{{ProductID: 1, Subtotal: 500000}}
+
+		discount, err := domain.CalculateDiscount(voucher, big, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(15000), discount)
+	})
+
+	t.Run("Only Items Of The Voucher Categories", func(t *testing.T) {
+		scoped := *voucher
+		scoped.Categories = []*entities.CategoryModels{{ID: 8}}
+
+		discount, err := domain.CalculateDiscount(&scoped, items, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(4000), discount)
+	})
+
+	t.Run("Fixed Amount Never Exceeds The Eligible Items", func(t *testing.T) {
+		fixed := *voucher
+		fixed.DiscountType = domain.DiscountTypeFixed
+		fixed.DiscountValue = 50000
+		fixed.Products = []*entities.ProductModels{{ID: 2}}
+
+		discount, err := domain.CalculateDiscount(&fixed, items, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(40000), discount)
+	})
+
+	t.Run("Below Minimum Spend", func(t *testing.T) {
+		_, err := domain.CalculateDiscount(voucher, items[1:], now)
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidVoucher))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		_, err := domain.CalculateDiscount(voucher, items, now.Add(2*time.Hour))
+
+		assert.True(t, errors.Is(err, domain.ErrInvalidVoucher))
+	})
+
+	t.Run("Usage Limits", func(t *testing.T) {
+		limited := *voucher
+		limited.UsageLimit = 5
+		limited.UsedCount = 5
+		assert.True(t, errors.Is(domain.CheckUsage(&limited, 0), domain.ErrInvalidVoucher))
+
+		limited.UsedCount = 1
+		limited.UsageLimitPerUser = 1
+		assert.True(t, errors.Is(domain.CheckUsage(&limited, 1), domain.ErrInvalidVoucher))
+		assert.Nil(t, domain.CheckUsage(&limited, 0))
+	})
+}
+
+func TestAllocateDiscount(t *testing.T) {
+	voucher := &entities.VoucherModels{Code: "HEMAT10"}
+	items := []domain.DiscountItem{
+		{ProductID: 1, CategoryIDs: []uint64{7}, Subtotal: 80000},
+		{ProductID: 2, CategoryIDs: []uint64{8}, Subtotal: 40000},
+		{ProductID: 3, CategoryIDs: []uint64{8}, Subtotal: 20000},
+	}
+
+	t.Run("Spread Over All Items By Subtotal", func(t *testing.T) {
+		shares := domain.AllocateDiscount(voucher, items, 10000)
+
+		assert.Equal(t, []uint64{5714, 2857, 1429}, shares)
+	})
+
+	t.Run("Only Items Of The Voucher Categories", func(t *testing.T) {
+		scoped := *voucher
+		scoped.Categories = []*entities.CategoryModels{{ID: 8}}
+
+		shares := domain.AllocateDiscount(&scoped, items, 6000)
+
+		assert.Equal(t, []uint64{0, 4000, 2000}, shares)
+	})
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrWishlistNotFound = errors.New("wishlist item not found")
+	ErrWishlistNotOwned = errors.New("wishlist item does not belong to this user")
+	ErrVariantRequired  = errors.New("choose a variant of the product first")
+	ErrVariantInStock   = errors.New("variant is still in stock")
+	ErrAlertNotFound    = errors.New("stock alert not found")
+)
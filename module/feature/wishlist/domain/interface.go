@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	order "ruti-store/module/feature/order/domain"
+)
+
+type WishlistRepositoryInterface interface {
+	GetWishlistByUserID(userID uint64) ([]*entities.WishlistModels, error)
+	GetWishlistByID(wishlistID uint64) (*entities.WishlistModels, error)
+	GetWishlistItem(userID, productID, variantID uint64) (*entities.WishlistModels, error)
+	CreateWishlist(wishlist *entities.WishlistModels) (*entities.WishlistModels, error)
+	DeleteWishlist(wishlistID uint64) error
+	GetProductByID(productID uint64) (*entities.ProductModels, error)
+	GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error)
+	GetStockAlertsByUserID(userID uint64) ([]*entities.StockAlertModels, error)
+	GetStockAlertByID(alertID uint64) (*entities.StockAlertModels, error)
+	GetPendingStockAlert(userID, variantID uint64) (*entities.StockAlertModels, error)
+	CreateStockAlert(alert *entities.StockAlertModels) (*entities.StockAlertModels, error)
+	DeleteStockAlert(alertID uint64) error
+}
+
+type WishlistServiceInterface interface {
+	GetWishlistUser(userID uint64) ([]*entities.WishlistModels, error)
+	AddWishlist(userID uint64, req *CreateWishlistRequest) (*entities.WishlistModels, error)
+	DeleteWishlist(userID, wishlistID uint64) error
+	MoveToCart(userID, wishlistID uint64, req *MoveToCartRequest) (*entities.CartModels, error)
+	GetStockAlertsUser(userID uint64) ([]*entities.StockAlertModels, error)
+	CreateStockAlert(userID uint64, req *CreateStockAlertRequest) (*entities.StockAlertModels, error)
+	DeleteStockAlert(userID, alertID uint64) error
+}
+
+type WishlistHandlerInterface interface {
+	GetWishlistUser(c *fiber.Ctx) error
+	AddWishlist(c *fiber.Ctx) error
+	DeleteWishlist(c *fiber.Ctx) error
+	MoveToCart(c *fiber.Ctx) error
+	GetStockAlertsUser(c *fiber.Ctx) error
+	CreateStockAlert(c *fiber.Ctx) error
+	DeleteStockAlert(c *fiber.Ctx) error
+}
+
+// CartInterface adds products to the cart of a user, which is owned by the order module.
+type CartInterface interface {
+	CreateCart(userID uint64, req *order.CreateCartRequest) (*entities.CartModels, error)
+}
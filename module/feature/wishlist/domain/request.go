@@ -0,0 +1,15 @@
+package domain
+
+type CreateWishlistRequest struct {
+	ProductID uint64 `json:"product_id" validate:"required"`
+	VariantID uint64 `json:"variant_id"`
+}
+
+type MoveToCartRequest struct {
+	VariantID uint64 `json:"variant_id"`
+	Quantity  uint64 `json:"quantity" validate:"omitempty,min=1"`
+}
+
+type CreateStockAlertRequest struct {
+	VariantID uint64 `json:"variant_id" validate:"required"`
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"ruti-store/module/entities"
+	product "ruti-store/module/feature/product/domain"
+	"time"
+)
+
+type WishlistVariantResponse struct {
+	ID    uint64 `json:"id"`
+	Size  string `json:"size"`
+	Color string `json:"color"`
+	Price uint64 `json:"price"`
+	Stock uint64 `json:"stock"`
+}
+
+type WishlistResponse struct {
+	ID        uint64                     `json:"id"`
+	ProductID uint64                     `json:"product_id"`
+	VariantID uint64                     `json:"variant_id"`
+	Name      string                     `json:"name"`
+	Photo     string                     `json:"photo"`
+	Price     uint64                     `json:"price"`
+	MinPrice  uint64                     `json:"min_price"`
+	MaxPrice  uint64                     `json:"max_price"`
+	Discount  uint64                     `json:"discount"`
+	Stock     uint64                     `json:"stock"`
+	Available bool                       `json:"available"`
+	Variants  []*WishlistVariantResponse `json:"variants"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+// WishlistFormatter shows a wishlist item with the product's current price and stock. Both are
+// the saved variant's, or the price range and total stock of all variants when no variant was
+// picked.
+func WishlistFormatter(wishlist *entities.WishlistModels) *WishlistResponse {
+	products := &wishlist.Product
+	res := &WishlistResponse{
+		ID:        wishlist.ID,
+		ProductID: wishlist.ProductID,
+		VariantID: wishlist.VariantID,
+		Name:      products.Name,
+		Discount:  products.Discount,
+		Variants:  make([]*WishlistVariantResponse, 0, len(products.Variants)),
+		CreatedAt: wishlist.CreatedAt,
+	}
+	if len(products.Photos) > 0 {
+		res.Photo = products.Photos[0].URL
+	}
+
+	res.MinPrice, res.MaxPrice = product.PriceRange(products)
+	res.Price = res.MinPrice
+
+	for i := range products.Variants {
+		variant := &products.Variants[i]
+		res.Variants = append(res.Variants, &WishlistVariantResponse{
+			ID:    variant.ID,
+			Size:  variant.Size,
+			Color: variant.Color,
+			Price: product.VariantPrice(products, variant),
+			Stock: variant.Stock,
+		})
+		if wishlist.VariantID == 0 || wishlist.VariantID == variant.ID {
+			res.Stock += variant.Stock
+		}
+		if wishlist.VariantID == variant.ID {
+			res.Price = product.VariantPrice(products, variant)
+			res.MinPrice, res.MaxPrice = res.Price, res.Price
+			res.Discount = product.VariantDiscount(products, variant)
+		}
+	}
+
+	res.Available = res.Stock > 0 && products.DeletedAt == nil && products.Status != product.ProductStatusInactive
+	return res
+}
+
+func ResponseArrayWishlist(data []*entities.WishlistModels) []*WishlistResponse {
+	res := make([]*WishlistResponse, 0, len(data))
+	for _, wishlist := range data {
+		res = append(res, WishlistFormatter(wishlist))
+	}
+	return res
+}
+
+type StockAlertResponse struct {
+	ID         uint64     `json:"id"`
+	ProductID  uint64     `json:"product_id"`
+	VariantID  uint64     `json:"variant_id"`
+	Name       string     `json:"name"`
+	Size       string     `json:"size"`
+	Color      string     `json:"color"`
+	Stock      uint64     `json:"stock"`
+	Notified   bool       `json:"notified"`
+	NotifiedAt *time.Time `json:"notified_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func StockAlertFormatter(alert *entities.StockAlertModels) *StockAlertResponse {
+	return &StockAlertResponse{
+		ID:         alert.ID,
+		ProductID:  alert.ProductID,
+		VariantID:  alert.VariantID,
+		Name:       alert.Product.Name,
+		Size:       alert.Variant.Size,
+		Color:      alert.Variant.Color,
+		Stock:      alert.Variant.Stock,
+		Notified:   alert.NotifiedAt != nil,
+		NotifiedAt: alert.NotifiedAt,
+		CreatedAt:  alert.CreatedAt,
+	}
+}
+
+func ResponseArrayStockAlerts(data []*entities.StockAlertModels) []*StockAlertResponse {
+	res := make([]*StockAlertResponse, 0, len(data))
+	for _, alert := range data {
+		res = append(res, StockAlertFormatter(alert))
+	}
+	return res
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"errors"
+	"github.com/gofiber/fiber/v2"
+	"ruti-store/module/entities"
+	product "ruti-store/module/feature/product/domain"
+	"ruti-store/module/feature/wishlist/domain"
+	"ruti-store/utils/response"
+	"ruti-store/utils/validator"
+	"strconv"
+)
+
+type WishlistHandler struct {
+	service domain.WishlistServiceInterface
+}
+
+func NewWishlistHandler(service domain.WishlistServiceInterface) domain.WishlistHandlerInterface {
+	return &WishlistHandler{
+		service: service,
+	}
+}
+
+func (h *WishlistHandler) GetWishlistUser(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	result, err := h.service.GetWishlistUser(currentUser.ID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get wishlist", domain.ResponseArrayWishlist(result))
+}
+
+func (h *WishlistHandler) AddWishlist(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.CreateWishlistRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.AddWishlist(currentUser.ID, req)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success add wishlist", domain.WishlistFormatter(result))
+}
+
+func (h *WishlistHandler) DeleteWishlist(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	wishlistID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	err = h.service.DeleteWishlist(currentUser.ID, wishlistID)
+	if errors.Is(err, domain.ErrWishlistNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrWishlistNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success delete wishlist")
+}
+
+func (h *WishlistHandler) MoveToCart(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	wishlistID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	req := new(domain.MoveToCartRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.MoveToCart(currentUser.ID, wishlistID, req)
+	if errors.Is(err, domain.ErrWishlistNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrWishlistNotOwned) {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: "+err.Error())
+	}
+	if errors.Is(err, domain.ErrVariantRequired) {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+	if errors.Is(err, product.ErrOutOfStock) || errors.Is(err, product.ErrProductUnavailable) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success move wishlist to cart", result)
+}
+
+func (h *WishlistHandler) GetStockAlertsUser(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	result, err := h.service.GetStockAlertsUser(currentUser.ID)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusOK, "Success get stock alerts", domain.ResponseArrayStockAlerts(result))
+}
+
+func (h *WishlistHandler) CreateStockAlert(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	req := new(domain.CreateStockAlertRequest)
+	if err := c.BodyParser(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Failed to parse request body")
+	}
+
+	if err := validator.ValidateStruct(req); err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.service.CreateStockAlert(currentUser.ID, req)
+	if errors.Is(err, domain.ErrVariantInStock) {
+		return response.ErrorBuildResponse(c, fiber.StatusConflict, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildResponse(c, fiber.StatusCreated, "Success create stock alert", domain.StockAlertFormatter(result))
+}
+
+func (h *WishlistHandler) DeleteStockAlert(c *fiber.Ctx) error {
+	currentUser, ok := c.Locals("currentUser").(*entities.UserModels)
+	if !ok || currentUser == nil {
+		return response.ErrorBuildResponse(c, fiber.StatusUnauthorized, "Unauthorized: Missing or invalid user information.")
+	}
+
+	if currentUser.Role != "customer" {
+		return response.ErrorBuildResponse(c, fiber.StatusForbidden, "Forbidden: Only customer users can access this resource.")
+	}
+
+	alertID, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusBadRequest, "Invalid input format.")
+	}
+
+	err = h.service.DeleteStockAlert(currentUser.ID, alertID)
+	if errors.Is(err, domain.ErrAlertNotFound) {
+		return response.ErrorBuildResponse(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return response.ErrorBuildResponse(c, fiber.StatusInternalServerError, "Internal server error occurred: "+err.Error())
+	}
+	return response.SuccessBuildWithoutResponse(c, fiber.StatusOK, "Success delete stock alert")
+}
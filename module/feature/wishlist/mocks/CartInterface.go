@@ -0,0 +1,59 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/order/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartInterface is an autogenerated mock type for the CartInterface type
+type CartInterface struct {
+	mock.Mock
+}
+
+// CreateCart provides a mock function with given fields: userID, req
+func (_m *CartInterface) CreateCart(userID uint64, req *domain.CreateCartRequest) (*entities.CartModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCart")
+	}
+
+	var r0 *entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateCartRequest) (*entities.CartModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateCartRequest) *entities.CartModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateCartRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCartInterface creates a new instance of CartInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartInterface {
+	mock := &CartInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,364 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistRepositoryInterface is an autogenerated mock type for the WishlistRepositoryInterface type
+type WishlistRepositoryInterface struct {
+	mock.Mock
+}
+
+// CreateStockAlert provides a mock function with given fields: alert
+func (_m *WishlistRepositoryInterface) CreateStockAlert(alert *entities.StockAlertModels) (*entities.StockAlertModels, error) {
+	ret := _m.Called(alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockAlert")
+	}
+
+	var r0 *entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.StockAlertModels) (*entities.StockAlertModels, error)); ok {
+		return rf(alert)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.StockAlertModels) *entities.StockAlertModels); ok {
+		r0 = rf(alert)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.StockAlertModels) error); ok {
+		r1 = rf(alert)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWishlist provides a mock function with given fields: wishlist
+func (_m *WishlistRepositoryInterface) CreateWishlist(wishlist *entities.WishlistModels) (*entities.WishlistModels, error) {
+	ret := _m.Called(wishlist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWishlist")
+	}
+
+	var r0 *entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.WishlistModels) (*entities.WishlistModels, error)); ok {
+		return rf(wishlist)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.WishlistModels) *entities.WishlistModels); ok {
+		r0 = rf(wishlist)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.WishlistModels) error); ok {
+		r1 = rf(wishlist)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStockAlert provides a mock function with given fields: alertID
+func (_m *WishlistRepositoryInterface) DeleteStockAlert(alertID uint64) error {
+	ret := _m.Called(alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStockAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWishlist provides a mock function with given fields: wishlistID
+func (_m *WishlistRepositoryInterface) DeleteWishlist(wishlistID uint64) error {
+	ret := _m.Called(wishlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWishlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(wishlistID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPendingStockAlert provides a mock function with given fields: userID, variantID
+func (_m *WishlistRepositoryInterface) GetPendingStockAlert(userID uint64, variantID uint64) (*entities.StockAlertModels, error) {
+	ret := _m.Called(userID, variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingStockAlert")
+	}
+
+	var r0 *entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) (*entities.StockAlertModels, error)); ok {
+		return rf(userID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64) *entities.StockAlertModels); ok {
+		r0 = rf(userID, variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(userID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByID provides a mock function with given fields: productID
+func (_m *WishlistRepositoryInterface) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	ret := _m.Called(productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *entities.ProductModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductModels, error)); ok {
+		return rf(productID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductModels); ok {
+		r0 = rf(productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockAlertByID provides a mock function with given fields: alertID
+func (_m *WishlistRepositoryInterface) GetStockAlertByID(alertID uint64) (*entities.StockAlertModels, error) {
+	ret := _m.Called(alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockAlertByID")
+	}
+
+	var r0 *entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.StockAlertModels, error)); ok {
+		return rf(alertID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.StockAlertModels); ok {
+		r0 = rf(alertID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(alertID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStockAlertsByUserID provides a mock function with given fields: userID
+func (_m *WishlistRepositoryInterface) GetStockAlertsByUserID(userID uint64) ([]*entities.StockAlertModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockAlertsByUserID")
+	}
+
+	var r0 []*entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.StockAlertModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.StockAlertModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariantByID provides a mock function with given fields: variantID
+func (_m *WishlistRepositoryInterface) GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error) {
+	ret := _m.Called(variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariantByID")
+	}
+
+	var r0 *entities.ProductVariantModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.ProductVariantModels, error)); ok {
+		return rf(variantID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.ProductVariantModels); ok {
+		r0 = rf(variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ProductVariantModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistByID provides a mock function with given fields: wishlistID
+func (_m *WishlistRepositoryInterface) GetWishlistByID(wishlistID uint64) (*entities.WishlistModels, error) {
+	ret := _m.Called(wishlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlistByID")
+	}
+
+	var r0 *entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (*entities.WishlistModels, error)); ok {
+		return rf(wishlistID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) *entities.WishlistModels); ok {
+		r0 = rf(wishlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(wishlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistByUserID provides a mock function with given fields: userID
+func (_m *WishlistRepositoryInterface) GetWishlistByUserID(userID uint64) ([]*entities.WishlistModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlistByUserID")
+	}
+
+	var r0 []*entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.WishlistModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.WishlistModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistItem provides a mock function with given fields: userID, productID, variantID
+func (_m *WishlistRepositoryInterface) GetWishlistItem(userID uint64, productID uint64, variantID uint64) (*entities.WishlistModels, error) {
+	ret := _m.Called(userID, productID, variantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlistItem")
+	}
+
+	var r0 *entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) (*entities.WishlistModels, error)); ok {
+		return rf(userID, productID, variantID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) *entities.WishlistModels); ok {
+		r0 = rf(userID, productID, variantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, uint64) error); ok {
+		r1 = rf(userID, productID, variantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWishlistRepositoryInterface creates a new instance of WishlistRepositoryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistRepositoryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistRepositoryInterface {
+	mock := &WishlistRepositoryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "ruti-store/module/entities"
+	domain "ruti-store/module/feature/wishlist/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WishlistServiceInterface is an autogenerated mock type for the WishlistServiceInterface type
+type WishlistServiceInterface struct {
+	mock.Mock
+}
+
+// AddWishlist provides a mock function with given fields: userID, req
+func (_m *WishlistServiceInterface) AddWishlist(userID uint64, req *domain.CreateWishlistRequest) (*entities.WishlistModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWishlist")
+	}
+
+	var r0 *entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateWishlistRequest) (*entities.WishlistModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateWishlistRequest) *entities.WishlistModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateWishlistRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateStockAlert provides a mock function with given fields: userID, req
+func (_m *WishlistServiceInterface) CreateStockAlert(userID uint64, req *domain.CreateStockAlertRequest) (*entities.StockAlertModels, error) {
+	ret := _m.Called(userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockAlert")
+	}
+
+	var r0 *entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateStockAlertRequest) (*entities.StockAlertModels, error)); ok {
+		return rf(userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, *domain.CreateStockAlertRequest) *entities.StockAlertModels); ok {
+		r0 = rf(userID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, *domain.CreateStockAlertRequest) error); ok {
+		r1 = rf(userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteStockAlert provides a mock function with given fields: userID, alertID
+func (_m *WishlistServiceInterface) DeleteStockAlert(userID uint64, alertID uint64) error {
+	ret := _m.Called(userID, alertID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStockAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(userID, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWishlist provides a mock function with given fields: userID, wishlistID
+func (_m *WishlistServiceInterface) DeleteWishlist(userID uint64, wishlistID uint64) error {
+	ret := _m.Called(userID, wishlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWishlist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(userID, wishlistID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetStockAlertsUser provides a mock function with given fields: userID
+func (_m *WishlistServiceInterface) GetStockAlertsUser(userID uint64) ([]*entities.StockAlertModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStockAlertsUser")
+	}
+
+	var r0 []*entities.StockAlertModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.StockAlertModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.StockAlertModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.StockAlertModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWishlistUser provides a mock function with given fields: userID
+func (_m *WishlistServiceInterface) GetWishlistUser(userID uint64) ([]*entities.WishlistModels, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWishlistUser")
+	}
+
+	var r0 []*entities.WishlistModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) ([]*entities.WishlistModels, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) []*entities.WishlistModels); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WishlistModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveToCart provides a mock function with given fields: userID, wishlistID, req
+func (_m *WishlistServiceInterface) MoveToCart(userID uint64, wishlistID uint64, req *domain.MoveToCartRequest) (*entities.CartModels, error) {
+	ret := _m.Called(userID, wishlistID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToCart")
+	}
+
+	var r0 *entities.CartModels
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.MoveToCartRequest) (*entities.CartModels, error)); ok {
+		return rf(userID, wishlistID, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, *domain.MoveToCartRequest) *entities.CartModels); ok {
+		r0 = rf(userID, wishlistID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CartModels)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, *domain.MoveToCartRequest) error); ok {
+		r1 = rf(userID, wishlistID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWishlistServiceInterface creates a new instance of WishlistServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWishlistServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WishlistServiceInterface {
+	mock := &WishlistServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,36 @@
+package wishlist
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"ruti-store/module/feature/middleware"
+	user "ruti-store/module/feature/user/domain"
+	"ruti-store/module/feature/wishlist/domain"
+	"ruti-store/module/feature/wishlist/handler"
+	"ruti-store/module/feature/wishlist/repository"
+	"ruti-store/module/feature/wishlist/service"
+	"ruti-store/utils/token"
+)
+
+var (
+	repo domain.WishlistRepositoryInterface
+	serv domain.WishlistServiceInterface
+	hand domain.WishlistHandlerInterface
+)
+
+func InitializeWishlist(db *gorm.DB, cart domain.CartInterface) {
+	repo = repository.NewWishlistRepository(db)
+	serv = service.NewWishlistService(repo, cart)
+	hand = handler.NewWishlistHandler(serv)
+}
+
+func SetupRoutesWishlist(app *fiber.App, jwt token.JWTInterface, userService user.UserServiceInterface) {
+	api := app.Group("/api/v1/wishlist")
+	api.Get("/list", middleware.AuthMiddleware(jwt, userService), hand.GetWishlistUser)
+	api.Post("/create", middleware.AuthMiddleware(jwt, userService), hand.AddWishlist)
+	api.Delete("/delete/:id", middleware.AuthMiddleware(jwt, userService), hand.DeleteWishlist)
+	api.Post("/move-to-cart/:id", middleware.AuthMiddleware(jwt, userService), hand.MoveToCart)
+	api.Get("/stock-alert/list", middleware.AuthMiddleware(jwt, userService), hand.GetStockAlertsUser)
+	api.Post("/stock-alert/create", middleware.AuthMiddleware(jwt, userService), hand.CreateStockAlert)
+	api.Delete("/stock-alert/delete/:id", middleware.AuthMiddleware(jwt, userService), hand.DeleteStockAlert)
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"ruti-store/module/entities"
+	"ruti-store/module/feature/wishlist/domain"
+)
+
+type WishlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWishlistRepository(db *gorm.DB) domain.WishlistRepositoryInterface {
+	return &WishlistRepository{
+		db: db,
+	}
+}
+
+func (r *WishlistRepository) GetWishlistByUserID(userID uint64) ([]*entities.WishlistModels, error) {
+	var wishlists []*entities.WishlistModels
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("user_id = ?", userID).Order("created_at DESC").Find(&wishlists).Error; err != nil {
+		return nil, err
+	}
+	return wishlists, nil
+}
+
+func (r *WishlistRepository) GetWishlistByID(wishlistID uint64) (*entities.WishlistModels, error) {
+	var wishlist *entities.WishlistModels
+	if err := r.db.Preload("Product.Photos").Preload("Product.Variants", "deleted_at IS NULL").
+		Where("id = ?", wishlistID).First(&wishlist).Error; err != nil {
+		return nil, err
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) GetWishlistItem(userID, productID, variantID uint64) (*entities.WishlistModels, error) {
+	var wishlist *entities.WishlistModels
+	if err := r.db.Where("user_id = ? AND product_id = ? AND variant_id = ?", userID, productID, variantID).
+		First(&wishlist).Error; err != nil {
+		return nil, err
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) CreateWishlist(wishlist *entities.WishlistModels) (*entities.WishlistModels, error) {
+	if err := r.db.Omit(clause.Associations).Create(wishlist).Error; err != nil {
+		return nil, err
+	}
+	return wishlist, nil
+}
+
+func (r *WishlistRepository) DeleteWishlist(wishlistID uint64) error {
+	if err := r.db.Where("id = ?", wishlistID).Delete(&entities.WishlistModels{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *WishlistRepository) GetProductByID(productID uint64) (*entities.ProductModels, error) {
+	var product *entities.ProductModels
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", productID).
+		Preload("Variants", "deleted_at IS NULL").
+		First(&product).Error; err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (r *WishlistRepository) GetVariantByID(variantID uint64) (*entities.ProductVariantModels, error) {
+	var variant *entities.ProductVariantModels
+	if err := r.db.Where("id = ? AND deleted_at IS NULL", variantID).First(&variant).Error; err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+func (r *WishlistRepository) GetStockAlertsByUserID(userID uint64) ([]*entities.StockAlertModels, error) {
+	var alerts []*entities.StockAlertModels
+	if err := r.db.Preload("Product").Preload("Variant").
+		Where("user_id = ?", userID).Order("created_at DESC").Find(&alerts).Error; err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+func (r *WishlistRepository) GetStockAlertByID(alertID uint64) (*entities.StockAlertModels, error) {
+	var alert *entities.StockAlertModels
+	if err := r.db.Where("id = ?", alertID).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// GetPendingStockAlert returns the user's alert on the variant that hasn't been sent yet.
+func (r *WishlistRepository) GetPendingStockAlert(userID, variantID uint64) (*entities.StockAlertModels, error) {
+	var alert *entities.StockAlertModels
+	if err := r.db.Where("user_id = ? AND variant_id = ? AND notified_at IS NULL", userID, variantID).
+		First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+func (r *WishlistRepository) CreateStockAlert(alert *entities.StockAlertModels) (*entities.StockAlertModels, error) {
+	if err := r.db.Omit(clause.Associations).Create(alert).Error; err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+func (r *WishlistRepository) DeleteStockAlert(alertID uint64) error {
+	if err := r.db.Where("id = ?", alertID).Delete(&entities.StockAlertModels{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
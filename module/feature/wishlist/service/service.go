@@ -0,0 +1,179 @@
+package service
+
+import (
+	"errors"
+	"ruti-store/module/entities"
+	order "ruti-store/module/feature/order/domain"
+	"ruti-store/module/feature/wishlist/domain"
+	"time"
+)
+
+type WishlistService struct {
+	repo domain.WishlistRepositoryInterface
+	cart domain.CartInterface
+}
+
+func NewWishlistService(repo domain.WishlistRepositoryInterface, cart domain.CartInterface) domain.WishlistServiceInterface {
+	return &WishlistService{
+		repo: repo,
+		cart: cart,
+	}
+}
+
+func (s *WishlistService) GetWishlistUser(userID uint64) ([]*entities.WishlistModels, error) {
+	result, err := s.repo.GetWishlistByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddWishlist saves a product, or one variant of it, to the user's wishlist. Saving an item
+// that is already on the wishlist returns the saved item.
+func (s *WishlistService) AddWishlist(userID uint64, req *domain.CreateWishlistRequest) (*entities.WishlistModels, error) {
+	products, err := s.repo.GetProductByID(req.ProductID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+	if req.VariantID != 0 && findVariant(products, req.VariantID) == nil {
+		return nil, errors.New("variant not found")
+	}
+
+	if existing, err := s.repo.GetWishlistItem(userID, products.ID, req.VariantID); err == nil && existing != nil {
+		return s.repo.GetWishlistByID(existing.ID)
+	}
+
+	newData := &entities.WishlistModels{
+		UserID:    userID,
+		ProductID: products.ID,
+		VariantID: req.VariantID,
+		CreatedAt: time.Now(),
+	}
+	result, err := s.repo.CreateWishlist(newData)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetWishlistByID(result.ID)
+}
+
+func (s *WishlistService) wishlistByID(userID, wishlistID uint64) (*entities.WishlistModels, error) {
+	wishlist, err := s.repo.GetWishlistByID(wishlistID)
+	if err != nil {
+		return nil, domain.ErrWishlistNotFound
+	}
+	if wishlist.UserID != userID {
+		return nil, domain.ErrWishlistNotOwned
+	}
+	return wishlist, nil
+}
+
+func (s *WishlistService) DeleteWishlist(userID, wishlistID uint64) error {
+	wishlist, err := s.wishlistByID(userID, wishlistID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteWishlist(wishlist.ID)
+}
+
+// MoveToCart adds a wishlist item to the user's cart and takes it off the wishlist. An item
+// saved without a variant needs the variant to buy in req.
+func (s *WishlistService) MoveToCart(userID, wishlistID uint64, req *domain.MoveToCartRequest) (*entities.CartModels, error) {
+	wishlist, err := s.wishlistByID(userID, wishlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	variantID := wishlist.VariantID
+	if variantID == 0 {
+		variantID = req.VariantID
+	}
+	if variantID == 0 {
+		return nil, domain.ErrVariantRequired
+	}
+	variant := findVariant(&wishlist.Product, variantID)
+	if variant == nil {
+		return nil, errors.New("variant not found")
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	result, err := s.cart.CreateCart(userID, &order.CreateCartRequest{
+		ProductID: wishlist.ProductID,
+		VariantID: variant.ID,
+		Size:      variant.Size,
+		Color:     variant.Color,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteWishlist(wishlist.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *WishlistService) GetStockAlertsUser(userID uint64) ([]*entities.StockAlertModels, error) {
+	result, err := s.repo.GetStockAlertsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateStockAlert subscribes the user to a notification for when a sold-out variant is back in
+// stock. Subscribing twice to the same variant returns the pending alert.
+func (s *WishlistService) CreateStockAlert(userID uint64, req *domain.CreateStockAlertRequest) (*entities.StockAlertModels, error) {
+	variant, err := s.repo.GetVariantByID(req.VariantID)
+	if err != nil {
+		return nil, errors.New("variant not found")
+	}
+	products, err := s.repo.GetProductByID(variant.ProductID)
+	if err != nil {
+		return nil, errors.New("product not found")
+	}
+	if variant.Stock > 0 {
+		return nil, domain.ErrVariantInStock
+	}
+
+	alert, err := s.repo.GetPendingStockAlert(userID, variant.ID)
+	if err != nil || alert == nil {
+		newData := &entities.StockAlertModels{
+			UserID:    userID,
+			ProductID: products.ID,
+			VariantID: variant.ID,
+			CreatedAt: time.Now(),
+		}
+		if alert, err = s.repo.CreateStockAlert(newData); err != nil {
+			return nil, err
+		}
+	}
+
+	alert.Product = *products
+	alert.Variant = *variant
+	return alert, nil
+}
+
+func (s *WishlistService) DeleteStockAlert(userID, alertID uint64) error {
+	alert, err := s.repo.GetStockAlertByID(alertID)
+	if err != nil {
+		return domain.ErrAlertNotFound
+	}
+	if alert.UserID != userID {
+		return domain.ErrAlertNotFound
+	}
+	return s.repo.DeleteStockAlert(alert.ID)
+}
+
+func findVariant(products *entities.ProductModels, variantID uint64) *entities.ProductVariantModels {
+	for i := range products.Variants {
+		if products.Variants[i].ID == variantID {
+			return &products.Variants[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"ruti-store/module/entities"
+	order "ruti-store/module/feature/order/domain"
+	"ruti-store/module/feature/wishlist/domain"
+	"ruti-store/module/feature/wishlist/mocks"
+)
+
+func TestWishlistService_MoveToCart(t *testing.T) {
+	repo := mocks.NewWishlistRepositoryInterface(t)
+	cart := mocks.NewCartInterface(t)
+	service := NewWishlistService(repo, cart)
+
+	wishlist := func(variantID uint64) *entities.WishlistModels {
+		return &entities.WishlistModels{
+			ID:        7,
+			UserID:    2,
+			ProductID: 1,
+			VariantID: variantID,
+			Product: entities.ProductModels{
+				ID: 1,
+				Variants: []entities.ProductVariantModels{
+					{ID: 11, Size: "M", Color: "Hitam", Stock: 4},
+					{ID: 12, Size: "L", Color: "Putih", Stock: 0},
+					{ID: 13, Size: "M", Color: "Hitam", Stock: 2},
+				},
+			},
+		}
+	}
+
+	t.Run("Failed Case - Not Owned", func(t *testing.T) {
+		repo.On("GetWishlistByID", uint64(7)).Return(wishlist(11), nil).Once()
+
+		result, err := service.MoveToCart(3, 7, &domain.MoveToCartRequest{})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrWishlistNotOwned))
+	})
+
+	t.Run("Failed Case - Variant Required", func(t *testing.T) {
+		repo.On("GetWishlistByID", uint64(7)).Return(wishlist(0), nil).Once()
+
+		result, err := service.MoveToCart(2, 7, &domain.MoveToCartRequest{})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrVariantRequired))
+		cart.AssertNotCalled(t, "CreateCart", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Success Case - Variant Picked On Move", func(t *testing.T) {
+		cartItem := &entities.CartModels{ID: 9, UserID: 2, ProductID: 1, VariantID: 11, Quantity: 2}
+		repo.On("GetWishlistByID", uint64(7)).Return(wishlist(0), nil).Once()
+		cart.On("CreateCart", uint64(2), &order.CreateCartRequest{ProductID: 1, VariantID: 11, Size: "M", Color: "Hitam", Quantity: 2}).
+			Return(cartItem, nil).Once()
+		repo.On("DeleteWishlist", uint64(7)).Return(nil).Once()
+
+		result, err := service.MoveToCart(2, 7, &domain.MoveToCartRequest{VariantID: 11, Quantity: 2})
+
+		assert.Nil(t, err)
+		assert.Equal(t, cartItem, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Success Case - Saved Variant Sharing Size And Color", func(t *testing.T) {
+		cartItem := &entities.CartModels{ID: 10, UserID: 2, ProductID: 1, VariantID: 13, Quantity: 1}
+		repo.On("GetWishlistByID", uint64(7)).Return(wishlist(13), nil).Once()
+		cart.On("CreateCart", uint64(2), &order.CreateCartRequest{ProductID: 1, VariantID: 13, Size: "M", Color: "Hitam", Quantity: 1}).
+			Return(cartItem, nil).Once()
+		repo.On("DeleteWishlist", uint64(7)).Return(nil).Once()
+
+		result, err := service.MoveToCart(2, 7, &domain.MoveToCartRequest{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, cartItem, result)
+		cart.AssertExpectations(t)
+	})
+}
+
+func TestWishlistService_CreateStockAlert(t *testing.T) {
+	repo := mocks.NewWishlistRepositoryInterface(t)
+	service := NewWishlistService(repo, nil)
+
+	products := &entities.ProductModels{ID: 1, Name: "Kemeja Linen"}
+
+	t.Run("Failed Case - Variant In Stock", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(11)).Return(&entities.ProductVariantModels{ID: 11, ProductID: 1, Stock: 3}, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(products, nil).Once()
+
+		result, err := service.CreateStockAlert(2, &domain.CreateStockAlertRequest{VariantID: 11})
+
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, domain.ErrVariantInStock))
+	})
+
+	t.Run("Success Case", func(t *testing.T) {
+		repo.On("GetVariantByID", uint64(12)).Return(&entities.ProductVariantModels{ID: 12, ProductID: 1}, nil).Once()
+		repo.On("GetProductByID", uint64(1)).Return(products, nil).Once()
+		repo.On("GetPendingStockAlert", uint64(2), uint64(12)).Return(nil, errors.New("record not found")).Once()
+		repo.On("CreateStockAlert", mock.MatchedBy(func(alert *entities.StockAlertModels) bool {
+			return alert.UserID == 2 && alert.ProductID == 1 && alert.VariantID == 12
+		})).Return(&entities.StockAlertModels{ID: 4, UserID: 2, ProductID: 1, VariantID: 12}, nil).Once()
+
+		result, err := service.CreateStockAlert(2, &domain.CreateStockAlertRequest{VariantID: 12})
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(4), result.ID)
+		assert.Equal(t, "Kemeja Linen", result.Product.Name)
+		repo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+	"ruti-store/module/entities"
+	product "ruti-store/module/feature/product/domain"
+	"strings"
+)
+
+// prepareVariantSKUs gives every existing variant an SKU before AutoMigrate puts a unique index
+// on the column. Backfilled variants get the SKU a new variant is given when none is set,
+// SKU-<product id>-<variant id>.
+func prepareVariantSKUs(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&entities.ProductVariantModels{}) {
+		return nil
+	}
+	if !migrator.HasColumn(&entities.ProductVariantModels{}, "SKU") {
+		if err := migrator.AddColumn(&entities.ProductVariantModels{}, "SKU"); err != nil {
+			return err
+		}
+	}
+	if migrator.HasIndex(&entities.ProductVariantModels{}, "idx_variants_sku") {
+		if err := migrator.DropIndex(&entities.ProductVariantModels{}, "idx_variants_sku"); err != nil {
+			return err
+		}
+	}
+
+	return db.Exec(`UPDATE variants SET sku = CONCAT('SKU-', product_id, '-', id) WHERE sku IS NULL OR sku = ''`).Error
+}
+
+// dropFullUniqueIndex drops a unique index that still covers soft-deleted rows, so AutoMigrate
+// can recreate it as a partial index under the same name.
+func dropFullUniqueIndex(db *gorm.DB, table, name string) error {
+	var definition string
+	if err := db.Raw("SELECT indexdef FROM pg_indexes WHERE tablename = ? AND indexname = ?", table, name).Scan(&definition).Error; err != nil {
+		return err
+	}
+	if definition == "" || strings.Contains(definition, " WHERE ") {
+		return nil
+	}
+
+	return db.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %q", name)).Error
+}
+
+// prepareLowStockThresholds adds the low-stock columns for the variants created before them. They
+// get the default threshold, and the ones already at or below it count as notified, so admins
+// aren't warned at once about every variant that was low before the warnings existed. They are
+// warned again once such a variant is restocked and runs low.
+func prepareLowStockThresholds(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&entities.ProductVariantModels{}) || migrator.HasColumn(&entities.ProductVariantModels{}, "LowStockThreshold") {
+		return nil
+	}
+	for _, field := range []string{"LowStockThreshold", "LowStockNotifiedAt"} {
+		if !migrator.HasColumn(&entities.ProductVariantModels{}, field) {
+			if err := migrator.AddColumn(&entities.ProductVariantModels{}, field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return db.Exec(`
+		UPDATE variants SET low_stock_threshold = ?,
+			low_stock_notified_at = CASE WHEN stock <= ? THEN NOW() END`,
+		product.DefaultLowStockThreshold, product.DefaultLowStockThreshold).Error
+}
+
+// backfillVariantIDs points the order lines and cart items saved before they kept a variant id
+// at the variant of their product with the same size and color. Nothing is backfilled while a line
+// matches more than one variant, as it can't be told which of them was bought.
+func backfillVariantIDs(db *gorm.DB) error {
+	tables := []string{"order_details", "carts", "guest_carts"}
+	for _, table := range tables {
+		var ambiguous int64
+		if err := db.Raw(fmt.Sprintf(`
+			SELECT COUNT(*) FROM %s t
+			WHERE (t.variant_id IS NULL OR t.variant_id = 0)
+				AND (SELECT COUNT(*) FROM variants v
+					WHERE v.product_id = t.product_id AND v.size = t.size AND v.color = t.color) > 1`, table)).
+			Scan(&ambiguous).Error; err != nil {
+			return err
+		}
+		if ambiguous > 0 {
+			return fmt.Errorf("%d rows of %s match more than one variant of the same size and color", ambiguous, table)
+		}
+	}
+
+	for _, table := range tables {
+		if err := db.Exec(fmt.Sprintf(`
+			UPDATE %s t SET variant_id = v.id
+			FROM variants v
+			WHERE (t.variant_id IS NULL OR t.variant_id = 0)
+				AND v.product_id = t.product_id AND v.size = t.size AND v.color = t.color`, table)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillVariantOptions gives the variants created before the option model their Size and Color
+// option values, creating the option types and values their sizes and colors need.
+func backfillVariantOptions(db *gorm.DB) error {
+	for optionType, column := range map[string]string{"Size": "size", "Color": "color"} {
+		if err := db.Exec(`
+			INSERT INTO option_types (name, created_at, updated_at)
+			VALUES (?, NOW(), NOW())
+			ON CONFLICT (name) DO NOTHING`, optionType).Error; err != nil {
+			return err
+		}
+		if err := db.Exec(fmt.Sprintf(`
+			INSERT INTO option_values (option_type_id, value, created_at, updated_at)
+			SELECT DISTINCT t.id, v.%[1]s, NOW(), NOW()
+			FROM variants v
+			JOIN option_types t ON t.name = ?
+			WHERE v.%[1]s <> ''
+			ON CONFLICT (option_type_id, value) DO NOTHING`, column), optionType).Error; err != nil {
+			return err
+		}
+		if err := db.Exec(fmt.Sprintf(`
+			INSERT INTO variant_option_values (variant_id, option_value_id)
+			SELECT v.id, o.id
+			FROM variants v
+			JOIN option_types t ON t.name = ?
+			JOIN option_values o ON o.option_type_id = t.id AND o.value = v.%s
+			WHERE NOT EXISTS (
+				SELECT 1 FROM variant_option_values vo
+				JOIN option_values ov ON ov.id = vo.option_value_id
+				WHERE vo.variant_id = v.id AND ov.option_type_id = t.id)
+			ON CONFLICT DO NOTHING`, column), optionType).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillInitialStock gives each variant created before the inventory ledger an initial entry
+// for its stock, so the ledger adds up to the stock of every variant.
+func backfillInitialStock(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO inventory_movements (variant_id, delta, stock_after, reason, actor_role, note, created_at)
+		SELECT v.id, v.stock, v.stock, ?, ?, ?, NOW()
+		FROM variants v
+		WHERE NOT EXISTS (SELECT 1 FROM inventory_movements m WHERE m.variant_id = v.id)`,
+		product.MovementInitial, product.MovementActorSystem, "opening stock from before the inventory ledger").Error
+}
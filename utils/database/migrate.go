@@ -1,29 +1,81 @@
 package database
 
 import (
+	"fmt"
 	"gorm.io/gorm"
 	"ruti-store/module/entities"
 )
 
-func Migrate(db *gorm.DB) {
+// Migrate brings the schema up to date and backfills the data of the features added since. It
+// stops at the first step that fails, as the ones after it may depend on it.
+func Migrate(db *gorm.DB) error {
+	if err := prepareVariantSKUs(db); err != nil {
+		return fmt.Errorf("failed to backfill variant SKUs: %w", err)
+	}
+	if err := prepareLowStockThresholds(db); err != nil {
+		return fmt.Errorf("failed to backfill low-stock thresholds: %w", err)
+	}
+	// Soft-deleted rows keep their codes, so the unique indexes only cover the rows in use.
+	for _, index := range [][2]string{
+		{"vouchers", "idx_vouchers_code"},
+		{"variants", "uni_variants_sku"},
+		{"variants", "uni_variants_barcode"},
+	} {
+		if err := dropFullUniqueIndex(db, index[0], index[1]); err != nil {
+			return fmt.Errorf("failed to drop the unique index %s: %w", index[1], err)
+		}
+	}
+
 	err := db.AutoMigrate(
 		entities.UserModels{},
 		entities.AddressModels{},
 		entities.ProductModels{},
 		entities.ProductPhotoModels{},
 		entities.ProductVariantModels{},
+		entities.OptionTypeModels{},
+		entities.OptionValueModels{},
+		entities.StockReservationModels{},
+		entities.InventoryMovementModels{},
 		entities.CategoryModels{},
 		entities.OrderModels{},
 		entities.OrderDetailsModels{},
+		entities.OrderStatusHistoryModels{},
+		entities.OrderCounterModels{},
+		entities.PaymentEventModels{},
+		entities.ReturnRequestModels{},
+		entities.ReturnItemModels{},
+		entities.ReturnPhotoModels{},
+		entities.ShipmentModels{},
+		entities.ShipmentEventModels{},
+		entities.VoucherModels{},
+		entities.VoucherUsageModels{},
+		entities.FlashSaleModels{},
+		entities.FlashSaleItemModels{},
+		entities.FlashSaleClaimModels{},
 		entities.CarouselModels{},
 		entities.ReviewModels{},
 		entities.ReviewPhotoModels{},
 		entities.ArticleModels{},
 		entities.NotificationModels{},
-		entities.CartModels{})
-
+		entities.CartModels{},
+		entities.GuestCartModels{},
+		entities.WishlistModels{},
+		entities.StockAlertModels{})
 	if err != nil {
-		return
+		return err
+	}
+
+	if err := backfillVariantIDs(db); err != nil {
+		return fmt.Errorf("failed to backfill variant ids: %w", err)
+	}
+	if err := backfillVariantOptions(db); err != nil {
+		return fmt.Errorf("failed to backfill variant options: %w", err)
+	}
+	if err := backfillInitialStock(db); err != nil {
+		return fmt.Errorf("failed to backfill initial stock: %w", err)
+	}
+	if err := setupProductSearch(db); err != nil {
+		return fmt.Errorf("failed to set up product search: %w", err)
 	}
-	return
+	return nil
 }
@@ -0,0 +1,25 @@
+package database
+
+import "gorm.io/gorm"
+
+// setupProductSearch indexes the products for the product search: a full-text vector over their
+// name and description, kept up to date by Postgres, and trigrams of their name for matching
+// misspelled queries. The vector isn't a field of the product model, so GORM never writes it.
+func setupProductSearch(db *gorm.DB) error {
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`ALTER TABLE product ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+				setweight(to_tsvector('simple', COALESCE(description, '')), 'B')
+			) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_product_search_vector ON product USING GIN (search_vector)`,
+		`CREATE INDEX IF NOT EXISTS idx_product_name_trgm ON product USING GIN (name gin_trgm_ops)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jung-kurt/gofpdf"
+	"strconv"
+	"time"
+)
+
+type InvoiceItem struct {
+	Name      string
+	Variant   string
+	Quantity  uint64
+	UnitPrice uint64
+	Discount  uint64
+	Total     uint64
+}
+
+type Invoice struct {
+	Number          string
+	Date            time.Time
+	StoreName       string
+	StoreAddress    string
+	BuyerName       string
+	BuyerEmail      string
+	BuyerPhone      string
+	RecipientName   string
+	RecipientPhone  string
+	ShippingAddress string
+	Courier         string
+	Items           []InvoiceItem
+	Subtotal        uint64
+	Discount        uint64
+	VoucherCode     string
+	VoucherDiscount uint64
+	ShipmentFee     uint64
+	AdminFee        uint64
+	TotalPaid       uint64
+	PaymentStatus   string
+	PaymentMethod   string
+}
+
+var invoiceColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"Produk", 70, "L"},
+	{"Varian", 30, "L"},
+	{"Jumlah", 15, "C"},
+	{"Harga", 25, "R"},
+	{"Diskon", 20, "R"},
+	{"Total", 30, "R"},
+}
+
+// GenerateInvoicePdf renders the invoice as an A4 PDF document.
+func GenerateInvoicePdf(invoice *Invoice) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.AddPage()
+	// The core fonts are encoded in cp1252, so the UTF-8 text of the order is translated to it.
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	// Kop toko
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(100, 8, tr(invoice.StoreName), "", 0, "L", false, 0, "")
+	pdf.CellFormat(80, 8, "INVOICE", "", 1, "R", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(100, 5, tr(invoice.StoreAddress), "", 0, "L", false, 0, "")
+	pdf.CellFormat(80, 5, tr(invoice.Number), "", 1, "R", false, 0, "")
+	pdf.CellFormat(100, 5, "", "", 0, "L", false, 0, "")
+	pdf.CellFormat(80, 5, invoice.Date.Format("2 January 2006 15:04"), "", 1, "R", false, 0, "")
+	pdf.Ln(4)
+	pdf.Line(15, pdf.GetY(), 195, pdf.GetY())
+	pdf.Ln(4)
+
+	// Pembeli dan alamat pengiriman
+	top := pdf.GetY()
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(90, 5, "Pembeli", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.MultiCell(90, 5, tr(fmt.Sprintf("%s\n%s\n%s", invoice.BuyerName, invoice.BuyerEmail, invoice.BuyerPhone)), "", "L", false)
+	left := pdf.GetY()
+
+	pdf.SetXY(105, top)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(90, 5, "Dikirim Ke", "", 1, "L", false, 0, "")
+	pdf.SetX(105)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.MultiCell(90, 5, tr(fmt.Sprintf("%s (%s)\n%s\nKurir: %s", invoice.RecipientName, invoice.RecipientPhone, invoice.ShippingAddress, invoice.Courier)), "", "L", false)
+	if left > pdf.GetY() {
+		pdf.SetY(left)
+	}
+	pdf.Ln(6)
+
+	// Daftar produk
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(236, 240, 241)
+	for _, column := range invoiceColumns {
+		pdf.CellFormat(column.width, 7, column.title, "1", 0, column.align, true, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, item := range invoice.Items {
+		values := []string{
+			item.Name,
+			item.Variant,
+			strconv.FormatUint(item.Quantity, 10),
+			formatRupiah(item.UnitPrice),
+			formatRupiah(item.Discount),
+			formatRupiah(item.Total),
+		}
+		for i, column := range invoiceColumns {
+			pdf.CellFormat(column.width, 7, fitText(pdf, tr(values[i]), column.width), "1", 0, column.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.Ln(4)
+
+	// Ringkasan pembayaran
+	summary := [][2]string{
+		{"Subtotal", formatRupiah(invoice.Subtotal)},
+		{"Total Hemat", formatRupiah(invoice.Discount)},
+	}
+	if invoice.VoucherDiscount > 0 {
+		summary = append(summary, [2]string{"Voucher " + invoice.VoucherCode, "-" + formatRupiah(invoice.VoucherDiscount)})
+	}
+	summary = append(summary,
+		[2]string{"Ongkos Kirim", formatRupiah(invoice.ShipmentFee)},
+		[2]string{"Biaya Admin", formatRupiah(invoice.AdminFee)},
+	)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, row := range summary {
+		pdf.CellFormat(130, 6, tr(row[0]), "", 0, "R", false, 0, "")
+		pdf.CellFormat(60, 6, row[1], "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(130, 8, "Total Bayar", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(60, 8, formatRupiah(invoice.TotalPaid), "T", 1, "R", false, 0, "")
+
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(180, 6, tr(fmt.Sprintf("Status Pembayaran: %s", invoice.PaymentStatus)), "", 1, "L", false, 0, "")
+	if invoice.PaymentMethod != "" {
+		pdf.CellFormat(180, 6, tr(fmt.Sprintf("Metode Pembayaran: %s", invoice.PaymentMethod)), "", 1, "L", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func ExportInvoicePdf(c *fiber.Ctx, invoice *Invoice, fileName string) error {
+	content, err := GenerateInvoicePdf(invoice)
+	if err != nil {
+		return err
+	}
+
+	c.Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	c.Set("Content-Type", "application/pdf")
+	return c.Send(content)
+}
+
+// fitText cuts text that doesn't fit in a cell of the given width. text is already translated
+// to the single-byte encoding of the font, so it is cut by bytes.
+func fitText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width-2 {
+		return text
+	}
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width-2 {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
+
+// formatRupiah formats an amount as rupiah with dots between the thousands, e.g. Rp150.000.
+func formatRupiah(amount uint64) string {
+	digits := strconv.FormatUint(amount, 10)
+	var buf bytes.Buffer
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			buf.WriteByte('.')
+		}
+		buf.WriteRune(digit)
+	}
+	return "Rp" + buf.String()
+}
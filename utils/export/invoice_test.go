@@ -0,0 +1,60 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRupiah(t *testing.T) {
+	assert.Equal(t, "Rp0", formatRupiah(0))
+	assert.Equal(t, "Rp999", formatRupiah(999))
+	assert.Equal(t, "Rp1.000", formatRupiah(1000))
+	assert.Equal(t, "Rp150.000", formatRupiah(150000))
+	assert.Equal(t, "Rp12.345.678", formatRupiah(12345678))
+}
+
+func TestGenerateInvoicePdf(t *testing.T) {
+	invoice := &Invoice{
+		Number:          "INV-0001",
+		Date:            time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		StoreName:       "Sander’Store",
+		StoreAddress:    "Purbasari RT01/RW02",
+		BuyerName:       "Budi",
+		RecipientName:   "Budi",
+		ShippingAddress: "Jl. Merdeka 1, Bandung, Jawa Barat",
+		Courier:         "jne REG",
+		Items: []InvoiceItem{
+			{Name: "Kemeja Linen Lengan Panjang Dengan Nama Yang Sangat Panjang Sekali", Variant: "M / Hitam", Quantity: 2, UnitPrice: 150000, Discount: 20000, Total: 280000},
+		},
+		Subtotal:        280000,
+		Discount:        20000,
+		VoucherCode:     "HEMAT10",
+		VoucherDiscount: 10000,
+		ShipmentFee:     18000,
+		AdminFee:        4000,
+		TotalPaid:       292000,
+		PaymentStatus:   "Confirmed",
+	}
+
+	content, err := GenerateInvoicePdf(invoice)
+
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+}
+
+func TestFitText(t *testing.T) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetFont("Helvetica", "", 9)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	assert.Equal(t, "Caf\xe9", fitText(pdf, tr("Café"), 30))
+
+	fitted := fitText(pdf, tr("Kemeja Café Linen Lengan Panjang"), 30)
+	assert.True(t, len(fitted) < len("Kemeja Café Linen Lengan Panjang"))
+	assert.Equal(t, "...", fitted[len(fitted)-3:])
+	assert.NotContains(t, fitted, "\xc3")
+}
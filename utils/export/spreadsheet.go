@@ -0,0 +1,99 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/360EntSecGroup-Skylar/excelize"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedSpreadsheet is returned when a file to import is neither a CSV nor an XLSX file.
+var ErrUnsupportedSpreadsheet = errors.New("unsupported file type, use .csv or .xlsx")
+
+// ReadSpreadsheet reads the rows of a CSV file, or of the first sheet of an XLSX file. The type
+// is told by the extension of fileName.
+func ReadSpreadsheet(file io.Reader, fileName string) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		return reader.ReadAll()
+	case ".xlsx":
+		xlsx, err := excelize.OpenReader(file)
+		if err != nil {
+			return nil, err
+		}
+		first := 0
+		for index := range xlsx.GetSheetMap() {
+			if first == 0 || index < first {
+				first = index
+			}
+		}
+		return xlsx.GetRows(xlsx.GetSheetName(first)), nil
+	default:
+		return nil, ErrUnsupportedSpreadsheet
+	}
+}
+
+// ExportSheet sends the data as a plain XLSX sheet with the headers on its first row, so the
+// file can be edited and imported back.
+func ExportSheet(c *fiber.Ctx, data [][]interface{}, headers []string, fileName string) error {
+	file := excelize.NewFile()
+	sheetName := "Sheet1"
+
+	headerStyle, _ := file.NewStyle(`{"font":{"bold":true},"fill":{"type":"pattern","color":["#ecf0f1"],"pattern":1}}`)
+	for col, header := range headers {
+		cell := fmt.Sprintf("%c%d", 'A'+col, 1)
+		file.SetCellValue(sheetName, cell, header)
+		file.SetCellStyle(sheetName, cell, cell, headerStyle)
+	}
+
+	for row, rowData := range data {
+		for col, value := range rowData {
+			file.SetCellValue(sheetName, fmt.Sprintf("%c%d", 'A'+col, row+2), value)
+		}
+	}
+
+	setColumnWidths(sheetName, file, headers, data)
+
+	buffer, err := file.WriteToBuffer()
+	if err != nil {
+		return err
+	}
+
+	c.Set("Content-Disposition", "attachment; filename="+fileName)
+	c.Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	return c.Send(buffer.Bytes())
+}
+
+// ExportCsv sends the data as a CSV file with the headers on its first row.
+func ExportCsv(c *fiber.Ctx, data [][]interface{}, headers []string, fileName string) error {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	for _, rowData := range data {
+		record := make([]string, len(rowData))
+		for col, value := range rowData {
+			record[col] = fmt.Sprintf("%v", value)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	c.Set("Content-Disposition", "attachment; filename="+fileName)
+	c.Set("Content-Type", "text/csv")
+	return c.Send(buffer.Bytes())
+}
@@ -0,0 +1,44 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSpreadsheet(t *testing.T) {
+	t.Run("CSV", func(t *testing.T) {
+		content := "sku,name,categories\nKMJ-01,Kemeja,\"Atasan, Pria\"\n"
+
+		rows, err := ReadSpreadsheet(strings.NewReader(content), "produk.CSV")
+
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{
+			{"sku", "name", "categories"},
+			{"KMJ-01", "Kemeja", "Atasan, Pria"},
+		}, rows)
+	})
+
+	t.Run("XLSX", func(t *testing.T) {
+		file := excelize.NewFile()
+		file.SetCellValue("Sheet1", "A1", "sku")
+		file.SetCellValue("Sheet1", "B1", "stock")
+		file.SetCellValue("Sheet1", "A2", "KMJ-01")
+		file.SetCellValue("Sheet1", "B2", 12)
+		buffer, err := file.WriteToBuffer()
+		assert.Nil(t, err)
+
+		rows, err := ReadSpreadsheet(buffer, "produk.xlsx")
+
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"sku", "stock"}, {"KMJ-01", "12"}}, rows)
+	})
+
+	t.Run("Unsupported file", func(t *testing.T) {
+		_, err := ReadSpreadsheet(strings.NewReader(""), "produk.pdf")
+
+		assert.Equal(t, ErrUnsupportedSpreadsheet, err)
+	})
+}
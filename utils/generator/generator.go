@@ -1,26 +1,41 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"regexp"
 	"ruti-store/module/entities"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// DefaultOrderNumberFormat numbers orders like INV/2026/10/000123, counting from 1 every month.
+const DefaultOrderNumberFormat = "INV/{YYYY}/{MM}/{SEQ:6}"
+
+var ErrInvalidOrderNumberFormat = errors.New("order number format must contain {SEQ} exactly once")
+
+var orderNumberToken = regexp.MustCompile(`\{(YYYY|YY|MM|DD|SEQ(?::(\d+))?)\}`)
+
 type GeneratorInterface interface {
 	GenerateUUID() (string, error)
 	GenerateOrderID() (string, error)
 }
 
 type Generator struct {
-	currentOrderNumber int
-	db                 *gorm.DB
+	orderNumberFormat string
+	db                *gorm.DB
 }
 
-func NewGeneratorUUID(db *gorm.DB) *Generator {
+func NewGeneratorUUID(db *gorm.DB, orderNumberFormat string) *Generator {
+	if orderNumberFormat == "" {
+		orderNumberFormat = DefaultOrderNumberFormat
+	}
 	return &Generator{
-		currentOrderNumber: 0,
-		db:                 db,
+		orderNumberFormat: orderNumberFormat,
+		db:                db,
 	}
 }
 
@@ -32,27 +47,107 @@ func (g *Generator) GenerateUUID() (string, error) {
 	return id.String(), nil
 }
 
+// GenerateOrderID returns the next human order number. The sequence number is taken from a
+// counter row in the database, so it is unique across concurrent requests and replicas. Every
+// distinct prefix of the format, like INV/2026/10/, has a counter of its own.
 func (g *Generator) GenerateOrderID() (string, error) {
-	prefix := "ORDER"
-	g.currentOrderNumber++
-	orderNumber := fmt.Sprintf("%03d", g.currentOrderNumber)
-	orderID := prefix + orderNumber
+	prefix, suffix, width, err := orderNumberLayout(g.orderNumberFormat, time.Now())
+	if err != nil {
+		return "", err
+	}
 
-	exists, err := g.checkIDExists(orderID)
+	number, err := g.nextOrderNumber(prefix, suffix)
 	if err != nil {
 		return "", err
 	}
-	if exists {
-		return g.GenerateOrderID()
+	return prefix + fmt.Sprintf("%0*d", width, number) + suffix, nil
+}
+
+func (g *Generator) nextOrderNumber(prefix, suffix string) (uint64, error) {
+	period := prefix + "{SEQ}" + suffix
+
+	var counter entities.OrderCounterModels
+	result := g.db.Raw(`UPDATE order_counters SET value = value + 1, updated_at = ? WHERE period = ? RETURNING value`,
+		time.Now(), period).Scan(&counter)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return counter.Value, nil
 	}
 
-	return orderID, nil
+	// The first order of a period starts after the highest number already used with the same
+	// prefix, so orders numbered before the counter existed, like ORDER001, are never reused.
+	seed, err := g.highestOrderNumber(prefix, suffix)
+	if err != nil {
+		return 0, err
+	}
+	if err := g.db.Raw(`INSERT INTO order_counters (period, value, updated_at) VALUES (?, ?, ?)
+		ON CONFLICT (period) DO UPDATE SET value = order_counters.value + 1, updated_at = EXCLUDED.updated_at
+		RETURNING value`, period, seed+1, time.Now()).Scan(&counter).Error; err != nil {
+		return 0, err
+	}
+	return counter.Value, nil
+}
+
+func (g *Generator) highestOrderNumber(prefix, suffix string) (uint64, error) {
+	pattern := "^" + regexp.QuoteMeta(prefix) + "([0-9]+)" + regexp.QuoteMeta(suffix) + "$"
+
+	var highest uint64
+	if err := g.db.Model(&entities.OrderModels{}).
+		Select("COALESCE(MAX(CAST(SUBSTRING(id_order FROM ?) AS BIGINT)), 0)", pattern).
+		Where("id_order ~ ?", pattern).
+		Scan(&highest).Error; err != nil {
+		return 0, err
+	}
+	return highest, nil
+}
+
+// ValidateOrderNumberFormat checks the configured order number format, so a bad format stops
+// the server at boot instead of failing every checkout. An empty format uses the default one.
+func ValidateOrderNumberFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	_, _, _, err := orderNumberLayout(format, time.Now())
+	return err
 }
 
-func (g *Generator) checkIDExists(orderID string) (bool, error) {
-	var count int64
-	if err := g.db.Model(&entities.OrderModels{}).Where("id_order = ?", orderID).Count(&count).Error; err != nil {
-		return false, err
+// orderNumberLayout renders the date parts of the format at time now and splits the result
+// around the sequence number, which is padded with zeros to width digits.
+func orderNumberLayout(format string, now time.Time) (string, string, int, error) {
+	var prefix, suffix strings.Builder
+	width, seqCount := 0, 0
+
+	last := 0
+	for _, match := range orderNumberToken.FindAllStringSubmatchIndex(format, -1) {
+		out := &prefix
+		if seqCount > 0 {
+			out = &suffix
+		}
+		out.WriteString(format[last:match[0]])
+		last = match[1]
+
+		switch token := format[match[2]:match[3]]; {
+		case token == "YYYY":
+			out.WriteString(now.Format("2006"))
+		case token == "YY":
+			out.WriteString(now.Format("06"))
+		case token == "MM":
+			out.WriteString(now.Format("01"))
+		case token == "DD":
+			out.WriteString(now.Format("02"))
+		default:
+			seqCount++
+			if match[4] >= 0 {
+				width, _ = strconv.Atoi(format[match[4]:match[5]])
+			}
+		}
+	}
+
+	if seqCount != 1 {
+		return "", "", 0, ErrInvalidOrderNumberFormat
 	}
-	return count > 0, nil
+	suffix.WriteString(format[last:])
+	return prefix.String(), suffix.String(), width, nil
 }
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderNumberLayout(t *testing.T) {
+	now := time.Date(2026, 10, 7, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		format string
+		prefix string
+		suffix string
+		width  int
+	}{
+		{DefaultOrderNumberFormat, "INV/2026/10/", "", 6},
+		{"ORDER{SEQ:3}", "ORDER", "", 3},
+		{"{YY}{MM}{DD}-{SEQ}-RS", "261007-", "-RS", 0},
+	}
+
+	for _, tt := range tests {
+		prefix, suffix, width, err := orderNumberLayout(tt.format, now)
+
+		assert.Nil(t, err, tt.format)
+		assert.Equal(t, tt.prefix, prefix, tt.format)
+		assert.Equal(t, tt.suffix, suffix, tt.format)
+		assert.Equal(t, tt.width, width, tt.format)
+	}
+
+	for _, format := range []string{"INV/{YYYY}", "{SEQ}-{SEQ}"} {
+		_, _, _, err := orderNumberLayout(format, now)
+
+		assert.True(t, errors.Is(err, ErrInvalidOrderNumberFormat), format)
+	}
+}
+
+func TestValidateOrderNumberFormat(t *testing.T) {
+	assert.Nil(t, ValidateOrderNumberFormat(""))
+	assert.Nil(t, ValidateOrderNumberFormat("ORDER{SEQ:3}"))
+	assert.True(t, errors.Is(ValidateOrderNumberFormat("INV/{YYYY}/{MM}"), ErrInvalidOrderNumberFormat))
+}
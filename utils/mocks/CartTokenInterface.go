@@ -0,0 +1,80 @@
+// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// CartTokenInterface is an autogenerated mock type for the CartTokenInterface type
+type CartTokenInterface struct {
+	mock.Mock
+}
+
+// GenerateCartToken provides a mock function with no fields
+func (_m *CartTokenInterface) GenerateCartToken() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateCartToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateCartToken provides a mock function with given fields: cartToken
+func (_m *CartTokenInterface) ValidateCartToken(cartToken string) (string, error) {
+	ret := _m.Called(cartToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateCartToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(cartToken)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(cartToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(cartToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCartTokenInterface creates a new instance of CartTokenInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartTokenInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartTokenInterface {
+	mock := &CartTokenInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,167 @@
+package payment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// FakeGateway is an in-process payment gateway for tests and local development. Charges never
+// leave the process; Settle, Expire and Deny change a charge's state and return the signed
+// webhook payload a real provider would have sent, ready to be fed to the callback.
+type FakeGateway struct {
+	mu        sync.Mutex
+	serverKey string
+	charges   map[string]*fakeCharge
+}
+
+type fakeCharge struct {
+	amount            uint64
+	refunded          uint64
+	refundKeys        map[string]uint64
+	transactionStatus string
+}
+
+func NewFakeGateway(serverKey string) *FakeGateway {
+	return &FakeGateway{
+		serverKey: serverKey,
+		charges:   make(map[string]*fakeCharge),
+	}
+}
+
+func (g *FakeGateway) CreateCharge(req ChargeRequest) (*Charge, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, exists := g.charges[req.OrderID]; exists {
+		return nil, fmt.Errorf("order %s already has a charge", req.OrderID)
+	}
+	g.charges[req.OrderID] = &fakeCharge{
+		amount:            req.Amount,
+		refundKeys:        make(map[string]uint64),
+		transactionStatus: "pending",
+	}
+
+	return &Charge{
+		OrderID:     req.OrderID,
+		Token:       "fake-" + req.OrderID,
+		RedirectURL: "/api/v1/order/fake-payment/" + req.OrderID,
+	}, nil
+}
+
+func (g *FakeGateway) GetStatus(orderID string) (*TransactionResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, exists := g.charges[orderID]
+	if !exists {
+		return nil, ErrTransactionNotFound
+	}
+
+	return &TransactionResult{
+		OrderID:           orderID,
+		TransactionID:     "fake-" + orderID,
+		TransactionStatus: charge.transactionStatus,
+		GrossAmount:       formatAmount(charge.amount),
+		Status:            midtransStatus(charge.transactionStatus, ""),
+	}, nil
+}
+
+func (g *FakeGateway) Refund(req RefundRequest) (*RefundResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, exists := g.charges[req.OrderID]
+	if !exists {
+		return nil, ErrTransactionNotFound
+	}
+	// Like Midtrans, a refund key that was already used returns the earlier refund.
+	if amount, refunded := charge.refundKeys[req.RefundKey]; refunded {
+		return &RefundResult{
+			OrderID:   req.OrderID,
+			RefundKey: req.RefundKey,
+			Amount:    amount,
+			Status:    StatusRefunded,
+		}, nil
+	}
+	if charge.transactionStatus != "settlement" && charge.transactionStatus != "partial_refund" {
+		return nil, fmt.Errorf("can't refund a %s transaction", charge.transactionStatus)
+	}
+	if charge.refunded+req.Amount > charge.amount {
+		return nil, errors.New("refund amount exceeds the paid amount")
+	}
+
+	charge.refunded += req.Amount
+	charge.refundKeys[req.RefundKey] = req.Amount
+	charge.transactionStatus = "partial_refund"
+	if charge.refunded == charge.amount {
+		charge.transactionStatus = "refund"
+	}
+
+	return &RefundResult{
+		OrderID:   req.OrderID,
+		RefundKey: req.RefundKey,
+		Amount:    req.Amount,
+		Status:    StatusRefunded,
+	}, nil
+}
+
+func (g *FakeGateway) Cancel(orderID string) error {
+	_, err := g.transition(orderID, "cancel")
+	return err
+}
+
+func (g *FakeGateway) ParseWebhook(payload []byte) (*Notification, error) {
+	return (&MidtransGateway{serverKey: g.serverKey}).ParseWebhook(payload)
+}
+
+// Settle marks a pending charge as paid and returns the matching webhook payload.
+func (g *FakeGateway) Settle(orderID string) ([]byte, error) {
+	return g.transition(orderID, "settlement")
+}
+
+// Expire marks a pending charge as expired and returns the matching webhook payload.
+func (g *FakeGateway) Expire(orderID string) ([]byte, error) {
+	return g.transition(orderID, "expire")
+}
+
+// Deny marks a pending charge as denied and returns the matching webhook payload.
+func (g *FakeGateway) Deny(orderID string) ([]byte, error) {
+	return g.transition(orderID, "deny")
+}
+
+func (g *FakeGateway) transition(orderID, transactionStatus string) ([]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	charge, exists := g.charges[orderID]
+	if !exists {
+		return nil, ErrTransactionNotFound
+	}
+	if charge.transactionStatus != "pending" && charge.transactionStatus != "deny" {
+		return nil, fmt.Errorf("can't %s a %s transaction", transactionStatus, charge.transactionStatus)
+	}
+	charge.transactionStatus = transactionStatus
+
+	statusCode := "200"
+	if transactionStatus != "settlement" {
+		statusCode = "202"
+	}
+	grossAmount := formatAmount(charge.amount)
+	sum := signature(orderID, statusCode, grossAmount, g.serverKey)
+
+	return json.Marshal(map[string]string{
+		"order_id":           orderID,
+		"transaction_id":     "fake-" + orderID,
+		"transaction_status": transactionStatus,
+		"status_code":        statusCode,
+		"gross_amount":       grossAmount,
+		"signature_key":      sum,
+	})
+}
+
+func formatAmount(amount uint64) string {
+	return strconv.FormatUint(amount, 10) + ".00"
+}
@@ -0,0 +1,86 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeGateway_Settle(t *testing.T) {
+	gateway := NewFakeGateway("server-key")
+
+	_, err := gateway.CreateCharge(ChargeRequest{OrderID: "order-1", Amount: 12000})
+	assert.Nil(t, err)
+
+	payload, err := gateway.Settle("order-1")
+	assert.Nil(t, err)
+
+	notification, err := gateway.ParseWebhook(payload)
+	assert.Nil(t, err)
+	assert.True(t, notification.SignatureValid)
+	assert.Equal(t, StatusPaid, notification.Status)
+	assert.Equal(t, "12000.00", notification.GrossAmount)
+
+	result, err := gateway.GetStatus("order-1")
+	assert.Nil(t, err)
+	assert.Equal(t, StatusPaid, result.Status)
+
+	_, err = gateway.Expire("order-1")
+	assert.Error(t, err)
+}
+
+func TestFakeGateway_ExpireAndDeny(t *testing.T) {
+	gateway := NewFakeGateway("server-key")
+
+	_, err := gateway.CreateCharge(ChargeRequest{OrderID: "order-1", Amount: 5000})
+	assert.Nil(t, err)
+
+	payload, err := gateway.Deny("order-1")
+	assert.Nil(t, err)
+	notification, err := gateway.ParseWebhook(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusDenied, notification.Status)
+
+	payload, err = gateway.Expire("order-1")
+	assert.Nil(t, err)
+	notification, err = gateway.ParseWebhook(payload)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusFailed, notification.Status)
+
+	_, err = gateway.GetStatus("order-2")
+	assert.Equal(t, ErrTransactionNotFound, err)
+}
+
+func TestFakeGateway_Refund(t *testing.T) {
+	gateway := NewFakeGateway("server-key")
+
+	_, err := gateway.CreateCharge(ChargeRequest{OrderID: "order-1", Amount: 10000})
+	assert.Nil(t, err)
+
+	_, err = gateway.Refund(RefundRequest{OrderID: "order-1", RefundKey: "return-1", Amount: 4000})
+	assert.Error(t, err)
+
+	_, err = gateway.Settle("order-1")
+	assert.Nil(t, err)
+
+	_, err = gateway.Refund(RefundRequest{OrderID: "order-1", RefundKey: "return-1", Amount: 4000})
+	assert.Nil(t, err)
+
+	_, err = gateway.Refund(RefundRequest{OrderID: "order-1", RefundKey: "return-1", Amount: 4000})
+	assert.Nil(t, err)
+
+	_, err = gateway.Refund(RefundRequest{OrderID: "order-1", RefundKey: "return-2", Amount: 7000})
+	assert.Error(t, err)
+
+	result, err := gateway.Refund(RefundRequest{OrderID: "order-1", RefundKey: "return-2", Amount: 6000})
+	assert.Nil(t, err)
+	assert.Equal(t, StatusRefunded, result.Status)
+}
+
+func TestVerifySignature(t *testing.T) {
+	signatureKey := signature("order-1", "200", "12000.00", "server-key")
+
+	assert.True(t, VerifySignature("order-1", "200", "12000.00", "server-key", signatureKey))
+	assert.False(t, VerifySignature("order-1", "200", "99000.00", "server-key", signatureKey))
+	assert.False(t, VerifySignature("order-1", "200", "12000.00", "server-key", ""))
+}
@@ -0,0 +1,86 @@
+package payment
+
+import (
+	"errors"
+	"ruti-store/config"
+	"strings"
+)
+
+// Status is the provider-neutral state of a payment.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusPaid      Status = "paid"
+	StatusChallenge Status = "challenge"
+	StatusDenied    Status = "denied"
+	StatusFailed    Status = "failed"
+	StatusRefunded  Status = "refunded"
+	StatusUnknown   Status = "unknown"
+)
+
+var ErrTransactionNotFound = errors.New("payment transaction not found")
+
+type ChargeRequest struct {
+	OrderID       string
+	Amount        uint64
+	CustomerName  string
+	CustomerEmail string
+}
+
+type Charge struct {
+	OrderID     string
+	Token       string
+	RedirectURL string
+}
+
+type TransactionResult struct {
+	OrderID           string
+	TransactionID     string
+	TransactionStatus string
+	GrossAmount       string
+	Status            Status
+}
+
+type RefundRequest struct {
+	OrderID   string
+	RefundKey string
+	Amount    uint64
+	Reason    string
+}
+
+type RefundResult struct {
+	OrderID   string
+	RefundKey string
+	Amount    uint64
+	Status    Status
+}
+
+// Notification is a parsed payment webhook. SignatureValid tells whether it was really sent
+// by the provider; invalid notifications are still returned so they can be recorded.
+type Notification struct {
+	OrderID           string
+	TransactionID     string
+	TransactionStatus string
+	StatusCode        string
+	FraudStatus       string
+	GrossAmount       string
+	Status            Status
+	SignatureValid    bool
+}
+
+type PaymentGatewayInterface interface {
+	CreateCharge(req ChargeRequest) (*Charge, error)
+	GetStatus(orderID string) (*TransactionResult, error)
+	Refund(req RefundRequest) (*RefundResult, error)
+	Cancel(orderID string) error
+	ParseWebhook(payload []byte) (*Notification, error)
+}
+
+// NewPaymentGateway returns the gateway selected by config.PaymentProvider, Midtrans by default.
+func NewPaymentGateway(config config.Config) PaymentGatewayInterface {
+	if strings.EqualFold(config.PaymentProvider, "fake") {
+		return NewFakeGateway(config.ServerKey)
+	}
+	return NewMidtransGateway(config)
+}
@@ -1,20 +1,171 @@
 package payment
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com/midtrans/midtrans-go"
 	"github.com/midtrans/midtrans-go/coreapi"
 	"github.com/midtrans/midtrans-go/snap"
+	"net/http"
 	"ruti-store/config"
+	"strings"
 )
 
-func InitSnapMidtrans(config config.Config) snap.Client {
-	var snapClient snap.Client
-	snapClient.New(config.ServerKey, midtrans.Sandbox)
-	return snapClient
+type MidtransGateway struct {
+	snap      snap.Client
+	core      coreapi.Client
+	serverKey string
 }
 
-func InitCoreMidtrans(config config.Config) coreapi.Client {
-	var coreClient coreapi.Client
-	coreClient.New(config.ServerKey, midtrans.Sandbox)
-	return coreClient
+func NewMidtransGateway(config config.Config) PaymentGatewayInterface {
+	env := midtrans.Sandbox
+	if strings.EqualFold(config.MidtransEnv, "production") {
+		env = midtrans.Production
+	}
+
+	gateway := &MidtransGateway{serverKey: config.ServerKey}
+	gateway.snap.New(config.ServerKey, env)
+	gateway.core.New(config.ServerKey, env)
+	return gateway
+}
+
+// CreateCharge creates a Snap transaction and returns its redirect URL.
+func (g *MidtransGateway) CreateCharge(req ChargeRequest) (*Charge, error) {
+	snapReq := &snap.Request{
+		TransactionDetails: midtrans.TransactionDetails{
+			OrderID:  req.OrderID,
+			GrossAmt: int64(req.Amount),
+		},
+		CustomerDetail: &midtrans.CustomerDetails{
+			FName: req.CustomerName,
+			Email: req.CustomerEmail,
+		},
+	}
+
+	resp, err := g.snap.CreateTransaction(snapReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Charge{
+		OrderID:     req.OrderID,
+		Token:       resp.Token,
+		RedirectURL: resp.RedirectURL,
+	}, nil
+}
+
+func (g *MidtransGateway) GetStatus(orderID string) (*TransactionResult, error) {
+	resp, err := g.core.CheckTransaction(orderID)
+	if err != nil {
+		if err.StatusCode == http.StatusNotFound {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+	if resp == nil || resp.StatusCode == "404" {
+		return nil, ErrTransactionNotFound
+	}
+
+	return &TransactionResult{
+		OrderID:           resp.OrderID,
+		TransactionID:     resp.TransactionID,
+		TransactionStatus: resp.TransactionStatus,
+		GrossAmount:       resp.GrossAmount,
+		Status:            midtransStatus(resp.TransactionStatus, resp.FraudStatus),
+	}, nil
+}
+
+func (g *MidtransGateway) Refund(req RefundRequest) (*RefundResult, error) {
+	refundReq := &coreapi.RefundReq{
+		RefundKey: req.RefundKey,
+		Amount:    int64(req.Amount),
+		Reason:    req.Reason,
+	}
+
+	resp, err := g.core.RefundTransaction(req.OrderID, refundReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefundResult{
+		OrderID:   req.OrderID,
+		RefundKey: req.RefundKey,
+		Amount:    req.Amount,
+		Status:    midtransStatus(resp.TransactionStatus, ""),
+	}, nil
+}
+
+// Cancel voids an unpaid transaction. Pending Snap transactions can only be expired, anything
+// else is cancelled.
+func (g *MidtransGateway) Cancel(orderID string) error {
+	result, err := g.GetStatus(orderID)
+	if err != nil {
+		return err
+	}
+
+	if result.Status == StatusPending {
+		if _, err := g.core.ExpireTransaction(orderID); err != nil {
+			return err
+		}
+		return nil
+	}
+	if _, err := g.core.CancelTransaction(orderID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *MidtransGateway) ParseWebhook(payload []byte) (*Notification, error) {
+	var req struct {
+		OrderID           string `json:"order_id"`
+		TransactionID     string `json:"transaction_id"`
+		TransactionStatus string `json:"transaction_status"`
+		StatusCode        string `json:"status_code"`
+		FraudStatus       string `json:"fraud_status"`
+		GrossAmount       string `json:"gross_amount"`
+		SignatureKey      string `json:"signature_key"`
+	}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	if req.OrderID == "" {
+		return nil, errors.New("invalid notification payload")
+	}
+
+	return &Notification{
+		OrderID:           req.OrderID,
+		TransactionID:     req.TransactionID,
+		TransactionStatus: req.TransactionStatus,
+		StatusCode:        req.StatusCode,
+		FraudStatus:       req.FraudStatus,
+		GrossAmount:       req.GrossAmount,
+		Status:            midtransStatus(req.TransactionStatus, req.FraudStatus),
+		SignatureValid:    VerifySignature(req.OrderID, req.StatusCode, req.GrossAmount, g.serverKey, req.SignatureKey),
+	}, nil
+}
+
+// midtransStatus maps a Midtrans transaction_status and fraud_status to a Status.
+func midtransStatus(transactionStatus, fraudStatus string) Status {
+	switch transactionStatus {
+	case "capture":
+		switch fraudStatus {
+		case "challenge":
+			return StatusChallenge
+		case "deny":
+			return StatusDenied
+		}
+		return StatusPaid
+	case "settlement":
+		return StatusPaid
+	case "deny":
+		// A denied attempt can still be retried within the same transaction.
+		return StatusDenied
+	case "cancel", "expire", "failure":
+		return StatusFailed
+	case "pending":
+		return StatusPending
+	case "refund", "partial_refund":
+		return StatusRefunded
+	}
+	return StatusUnknown
 }
@@ -0,0 +1,23 @@
+package payment
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// VerifySignature checks the signature_key Midtrans sends with every notification, which is the
+// SHA512 hex digest of order_id + status_code + gross_amount + server key.
+func VerifySignature(orderID, statusCode, grossAmount, serverKey, signatureKey string) bool {
+	if signatureKey == "" {
+		return false
+	}
+	expected := signature(orderID, statusCode, grossAmount, serverKey)
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(signatureKey))) == 1
+}
+
+func signature(orderID, statusCode, grossAmount, serverKey string) string {
+	sum := sha512.Sum512([]byte(orderID + statusCode + grossAmount + serverKey))
+	return hex.EncodeToString(sum[:])
+}
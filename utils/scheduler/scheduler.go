@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// Job is a task the scheduler runs every Interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func() error
+}
+
+type SchedulerInterface interface {
+	Register(job Job)
+	Start()
+	Stop()
+}
+
+// Scheduler runs background jobs inside the server process. Each run takes a Postgres advisory
+// lock named after the job, so when several replicas are running only one of them runs a given
+// job at a time and the others skip that tick.
+type Scheduler struct {
+	db   *gorm.DB
+	jobs []Job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewScheduler(db *gorm.DB) SchedulerInterface {
+	return &Scheduler{
+		db:   db,
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start() {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.runOnce(job)
+		}
+	}
+}
+
+// runOnce runs job while holding its advisory lock. pg_try_advisory_xact_lock is released when
+// the surrounding transaction ends, so a crashed replica never keeps the lock.
+func (s *Scheduler) runOnce(job Job) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", lockKey(job.Name)).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return job.Run()
+	})
+	if err != nil {
+		log.Errorf("scheduler: job %s failed: %v", job.Name, err)
+	}
+}
+
+func lockKey(name string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(name))
+	return int64(hash.Sum64())
+}
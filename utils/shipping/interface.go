@@ -2,6 +2,7 @@ package shipping
 
 type ShippingServiceInterface interface {
 	GetAllShippingCost(request RajaOngkirRequest) (map[string]interface{}, error)
+	GetShippingOptions(request RajaOngkirRequest) ([]ShippingOption, error)
 	GetProvince() (map[string]interface{}, error)
 	GetCity(province string) (map[string]interface{}, error)
 }
@@ -3,33 +3,38 @@ package shipping
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"ruti-store/config"
 )
 
 type RajaOngkirShippingService struct {
-	apiKey string
+	baseURL string
+	apiKey  string
 }
 
 func NewShippingService() ShippingServiceInterface {
+	initConfig := config.InitConfig()
 	return &RajaOngkirShippingService{
-		apiKey: config.InitConfig().OngkirKey,
+		baseURL: initConfig.OngkirURL,
+		apiKey:  initConfig.OngkirKey,
 	}
 }
 
 func (s *RajaOngkirShippingService) GetAllShippingCost(request RajaOngkirRequest) (map[string]interface{}, error) {
-	url := "https://api.rajaongkir.com/starter/cost"
-	apiKey := config.InitConfig().OngkirKey
-	couriers := []string{"jne", "pos", "tiki"}
+	url := s.baseURL + "/cost"
+	apiKey := s.apiKey
 	allResults := make(map[string]interface{})
 
-	for _, courier := range couriers {
+	for _, courier := range Couriers {
 		requestData := map[string]interface{}{
-			"origin":      "256",
-			"destination": request.Destination,
-			"weight":      request.Weight,
-			"courier":     courier,
+			"origin":          "256",
+			"originType":      "city",
+			"destination":     request.Destination,
+			"destinationType": "city",
+			"weight":          request.Weight,
+			"courier":         courier,
 		}
 
 		requestDataJSON, err := json.Marshal(requestData)
@@ -67,9 +72,92 @@ func (s *RajaOngkirShippingService) GetAllShippingCost(request RajaOngkirRequest
 	return allResults, nil
 }
 
+// GetShippingOptions returns the services of every supported courier, or only of
+// request.Courier when it is set, that can deliver to request.Destination. It returns
+// ErrShippingUnavailable when none of them can.
+func (s *RajaOngkirShippingService) GetShippingOptions(request RajaOngkirRequest) ([]ShippingOption, error) {
+	couriers := Couriers
+	if request.Courier != "" {
+		couriers = []string{request.Courier}
+	}
+
+	options := make([]ShippingOption, 0)
+	for _, courier := range couriers {
+		result, err := s.getCost(request.Destination, request.Weight, courier)
+		if err != nil {
+			return nil, err
+		}
+		if result.RajaOngkir.Status.Code != http.StatusOK {
+			return nil, fmt.Errorf("rajaongkir: %s", result.RajaOngkir.Status.Description)
+		}
+
+		for _, res := range result.RajaOngkir.Results {
+			for _, cost := range res.Costs {
+				if len(cost.Cost) == 0 {
+					continue
+				}
+				options = append(options, ShippingOption{
+					Courier:     res.Code,
+					CourierName: res.Name,
+					Service:     cost.Service,
+					Description: cost.Description,
+					Cost:        cost.Cost[0].Value,
+					ETD:         cost.Cost[0].Etd,
+				})
+			}
+		}
+	}
+	if len(options) == 0 {
+		return nil, ErrShippingUnavailable
+	}
+
+	return options, nil
+}
+
+// getCost asks RajaOngkir for the cost of a delivery. The origin and destination types are only
+// read by the pro tier, which can also deliver to subdistricts.
+func (s *RajaOngkirShippingService) getCost(destination string, weight int, courier string) (*costResponse, error) {
+	requestData := map[string]interface{}{
+		"origin":          "256",
+		"originType":      "city",
+		"destination":     destination,
+		"destinationType": "city",
+		"weight":          weight,
+		"courier":         courier,
+	}
+
+	requestDataJSON, err := json.Marshal(requestData)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", s.baseURL+"/cost", bytes.NewBuffer(requestDataJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("key", s.apiKey)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(res.Body)
+
+	var result costResponse
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (s *RajaOngkirShippingService) GetProvince() (map[string]interface{}, error) {
-	url := "https://api.rajaongkir.com/starter/province"
-	apiKey := config.InitConfig().OngkirKey
+	url := s.baseURL + "/province"
+	apiKey := s.apiKey
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -99,8 +187,8 @@ func (s *RajaOngkirShippingService) GetProvince() (map[string]interface{}, error
 }
 
 func (s *RajaOngkirShippingService) GetCity(province string) (map[string]interface{}, error) {
-	url := "https://api.rajaongkir.com/starter/city"
-	apiKey := config.InitConfig().OngkirKey
+	url := s.baseURL + "/city"
+	apiKey := s.apiKey
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
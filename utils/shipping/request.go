@@ -1,6 +1,44 @@
 package shipping
 
+import "errors"
+
+var Couriers = []string{"jne", "pos", "tiki"}
+
+var ErrShippingUnavailable = errors.New("shipping service is not available for this destination")
+
 type RajaOngkirRequest struct {
 	Destination string
 	Weight      int
+	Courier     string
+}
+
+// ShippingOption is a single courier service quote for a destination and weight.
+type ShippingOption struct {
+	Courier     string `json:"courier"`
+	CourierName string `json:"courier_name"`
+	Service     string `json:"service"`
+	Description string `json:"description"`
+	Cost        uint64 `json:"cost"`
+	ETD         string `json:"etd"`
+}
+
+type costResponse struct {
+	RajaOngkir struct {
+		Status struct {
+			Code        int    `json:"code"`
+			Description string `json:"description"`
+		} `json:"status"`
+		Results []struct {
+			Code  string `json:"code"`
+			Name  string `json:"name"`
+			Costs []struct {
+				Service     string `json:"service"`
+				Description string `json:"description"`
+				Cost        []struct {
+					Value uint64 `json:"value"`
+					Etd   string `json:"etd"`
+				} `json:"cost"`
+			} `json:"costs"`
+		} `json:"results"`
+	} `json:"rajaongkir"`
 }
@@ -0,0 +1,59 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCartToken = errors.New("invalid cart token")
+
+// CartTokenInterface issues and checks the tokens that identify the cart of a guest. A token is
+// a random guest id signed with the app secret, so guests can't guess each other's carts.
+type CartTokenInterface interface {
+	GenerateCartToken() (string, error)
+	ValidateCartToken(cartToken string) (string, error)
+}
+
+type CartToken struct {
+	Secret string
+}
+
+func NewCartToken(secret string) CartTokenInterface {
+	return &CartToken{
+		Secret: secret,
+	}
+}
+
+func (t *CartToken) GenerateCartToken() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	guestID := hex.EncodeToString(id)
+	return guestID + "." + t.sign(guestID), nil
+}
+
+// ValidateCartToken returns the guest id of a cart token issued by GenerateCartToken.
+func (t *CartToken) ValidateCartToken(cartToken string) (string, error) {
+	guestID, signature, found := strings.Cut(cartToken, ".")
+	if !found || guestID == "" {
+		return "", ErrInvalidCartToken
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(t.sign(guestID))) {
+		return "", ErrInvalidCartToken
+	}
+	return guestID, nil
+}
+
+func (t *CartToken) sign(guestID string) string {
+	mac := hmac.New(sha256.New, []byte(t.Secret))
+	mac.Write([]byte(guestID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
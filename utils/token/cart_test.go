@@ -0,0 +1,27 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartToken(t *testing.T) {
+	cartToken := NewCartToken("secret")
+
+	token, err := cartToken.GenerateCartToken()
+	assert.Nil(t, err)
+
+	guestID, err := cartToken.ValidateCartToken(token)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, guestID)
+
+	_, err = NewCartToken("other").ValidateCartToken(token)
+	assert.Equal(t, ErrInvalidCartToken, err)
+
+	_, err = cartToken.ValidateCartToken(guestID + ".forged")
+	assert.Equal(t, ErrInvalidCartToken, err)
+
+	_, err = cartToken.ValidateCartToken(guestID)
+	assert.Equal(t, ErrInvalidCartToken, err)
+}
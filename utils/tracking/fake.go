@@ -0,0 +1,56 @@
+package tracking
+
+import (
+	"sync"
+	"time"
+)
+
+// fakeSteps are the checkpoints the fake provider walks through, one per Track call.
+var fakeSteps = []Event{
+	{Description: "Paket diterima di gudang pengirim", Location: "Sragen"},
+	{Description: "Paket dalam perjalanan menuju kota tujuan", Location: "Hub Transit"},
+	{Description: "Paket dibawa kurir menuju alamat penerima", Location: "Kota Tujuan"},
+	{Description: "Paket telah diterima", Location: "Kota Tujuan"},
+}
+
+// FakeProvider is an in-process tracking provider for tests and local development. Every airway
+// bill moves one checkpoint further each time it is tracked and is delivered at the last one.
+type FakeProvider struct {
+	mu     sync.Mutex
+	events map[string][]Event
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		events: make(map[string][]Event),
+	}
+}
+
+func (p *FakeProvider) Track(courier, waybill string) (*Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := courier + "/" + waybill
+	if len(p.events[key]) < len(fakeSteps) {
+		step := fakeSteps[len(p.events[key])]
+		step.OccurredAt = time.Now()
+		p.events[key] = append(p.events[key], step)
+	}
+
+	events := make([]Event, len(p.events[key]))
+	copy(events, p.events[key])
+
+	delivered := len(events) == len(fakeSteps)
+	result := &Result{
+		Courier:   courier,
+		Waybill:   waybill,
+		Status:    "ON PROCESS",
+		Delivered: delivered,
+		Events:    events,
+	}
+	if delivered {
+		result.Status = "DELIVERED"
+		result.Receiver = "Penerima"
+	}
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package tracking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeProvider_Track(t *testing.T) {
+	provider := NewFakeProvider()
+
+	for i := 1; i < len(fakeSteps); i++ {
+		result, err := provider.Track("jne", "RESI-1")
+		assert.Nil(t, err)
+		assert.Len(t, result.Events, i)
+		assert.False(t, result.Delivered)
+	}
+
+	result, err := provider.Track("jne", "RESI-1")
+	assert.Nil(t, err)
+	assert.True(t, result.Delivered)
+	assert.Len(t, result.Events, len(fakeSteps))
+
+	result, err = provider.Track("jne", "RESI-1")
+	assert.Nil(t, err)
+	assert.Len(t, result.Events, len(fakeSteps))
+
+	result, err = provider.Track("jne", "RESI-2")
+	assert.Nil(t, err)
+	assert.Len(t, result.Events, 1)
+}
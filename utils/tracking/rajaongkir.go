@@ -0,0 +1,110 @@
+package tracking
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type RajaOngkirProvider struct {
+	baseURL string
+	apiKey  string
+}
+
+func NewRajaOngkirProvider(baseURL, apiKey string) *RajaOngkirProvider {
+	return &RajaOngkirProvider{baseURL: baseURL, apiKey: apiKey}
+}
+
+type waybillResponse struct {
+	RajaOngkir struct {
+		Status struct {
+			Code        int    `json:"code"`
+			Description string `json:"description"`
+		} `json:"status"`
+		Result struct {
+			Delivered bool `json:"delivered"`
+			Summary   struct {
+				Status string `json:"status"`
+			} `json:"summary"`
+			DeliveryStatus struct {
+				Status      string `json:"status"`
+				PodReceiver string `json:"pod_receiver"`
+			} `json:"delivery_status"`
+			Manifest []struct {
+				Description string `json:"manifest_description"`
+				Date        string `json:"manifest_date"`
+				Time        string `json:"manifest_time"`
+				CityName    string `json:"city_name"`
+			} `json:"manifest"`
+		} `json:"result"`
+	} `json:"rajaongkir"`
+}
+
+func (p *RajaOngkirProvider) Track(courier, waybill string) (*Result, error) {
+	form := url.Values{}
+	form.Set("waybill", waybill)
+	form.Set("courier", courier)
+
+	req, err := http.NewRequest("POST", p.baseURL+"/waybill", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("key", p.apiKey)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(res.Body)
+
+	var response waybillResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	switch response.RajaOngkir.Status.Code {
+	case http.StatusOK:
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("%w: %s", ErrWaybillNotFound, response.RajaOngkir.Status.Description)
+	default:
+		return nil, fmt.Errorf("rajaongkir: %s", response.RajaOngkir.Status.Description)
+	}
+
+	location, _ := time.LoadLocation("Asia/Jakarta")
+	if location == nil {
+		location = time.Local
+	}
+
+	result := &Result{
+		Courier:   courier,
+		Waybill:   waybill,
+		Status:    response.RajaOngkir.Result.Summary.Status,
+		Delivered: response.RajaOngkir.Result.Delivered,
+		Receiver:  response.RajaOngkir.Result.DeliveryStatus.PodReceiver,
+	}
+	// RajaOngkir lists the newest checkpoint first.
+	manifest := response.RajaOngkir.Result.Manifest
+	for i := len(manifest) - 1; i >= 0; i-- {
+		occurredAt, err := time.ParseInLocation("2006-01-02 15:04", manifest[i].Date+" "+manifest[i].Time, location)
+		if err != nil {
+			occurredAt, err = time.ParseInLocation("2006-01-02 15:04:05", manifest[i].Date+" "+manifest[i].Time, location)
+			if err != nil {
+				return nil, fmt.Errorf("rajaongkir: invalid manifest date %q", manifest[i].Date+" "+manifest[i].Time)
+			}
+		}
+		result.Events = append(result.Events, Event{
+			Description: manifest[i].Description,
+			Location:    manifest[i].CityName,
+			OccurredAt:  occurredAt,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package tracking
+
+import (
+	"errors"
+	"ruti-store/config"
+	"strings"
+	"time"
+)
+
+var ErrWaybillNotFound = errors.New("airway bill not found")
+
+// Event is a single checkpoint reported by the courier.
+type Event struct {
+	Description string
+	Location    string
+	OccurredAt  time.Time
+}
+
+// Result is the tracking state of an airway bill. Events are ordered from oldest to newest.
+type Result struct {
+	Courier   string
+	Waybill   string
+	Status    string
+	Delivered bool
+	Receiver  string
+	Events    []Event
+}
+
+type TrackingProviderInterface interface {
+	Track(courier, waybill string) (*Result, error)
+}
+
+// NewTrackingProvider returns the fake provider when TRACKINGPROVIDER is "fake" and the
+// RajaOngkir waybill provider otherwise.
+func NewTrackingProvider(config config.Config) TrackingProviderInterface {
+	if strings.EqualFold(config.TrackingProvider, "fake") {
+		return NewFakeProvider()
+	}
+	return NewRajaOngkirProvider(config.OngkirURL, config.OngkirKey)
+}
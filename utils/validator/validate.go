@@ -17,6 +17,9 @@ func ValidateStruct(s interface{}) error {
 		password := fl.Field().String()
 		return !regexp.MustCompile(`\s`).MatchString(password)
 	})
+	validate.RegisterValidation("ean13", func(fl validator.FieldLevel) bool {
+		return IsEAN13(fl.Field().String())
+	})
 
 	err := validate.Struct(s)
 	if err != nil {
@@ -32,6 +35,10 @@ func ValidateStruct(s interface{}) error {
 				customErrors = append(customErrors, fmt.Sprintf("Field '%s' must be a valid email address", err.Field()))
 			case "noSpace":
 				customErrors = append(customErrors, fmt.Sprintf("Field '%s' cannot contain spaces", err.Field()))
+			case "oneof":
+				customErrors = append(customErrors, fmt.Sprintf("Field '%s' must be one of %s", err.Field(), err.Param()))
+			case "ean13":
+				customErrors = append(customErrors, fmt.Sprintf("Field '%s' must be a valid EAN-13 barcode", err.Field()))
 			default:
 				customErrors = append(customErrors, fmt.Sprintf("Field '%s' validation failed with tag '%s'", err.Field(), err.Tag()))
 			}
@@ -41,3 +48,26 @@ func ValidateStruct(s interface{}) error {
 	}
 	return nil
 }
+
+// IsEAN13 reports whether code is a valid EAN-13 barcode: 13 digits, the last of which is the
+// check digit of the others.
+func IsEAN13(code string) bool {
+	if len(code) != 13 {
+		return false
+	}
+	sum := 0
+	for i, digit := range code {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+		if i == 12 {
+			break
+		}
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+	return (10-sum%10)%10 == int(code[12]-'0')
+}
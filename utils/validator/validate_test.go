@@ -0,0 +1,15 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsEAN13(t *testing.T) {
+	assert.True(t, IsEAN13("4006381333931"))
+	assert.True(t, IsEAN13("5901234123457"))
+	assert.False(t, IsEAN13("5901234123458"))
+	assert.False(t, IsEAN13("590123412345"))
+	assert.False(t, IsEAN13("59012341234a7"))
+}